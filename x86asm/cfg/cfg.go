@@ -0,0 +1,310 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cfg builds control-flow graphs of x86 machine code.
+//
+// Unlike a linear sweep, which decodes every byte of a region in turn
+// and so misreads data embedded in the code, Build decodes only the
+// instructions reachable from a set of entry points, following the
+// targets of direct jumps, branches, and calls (recursive descent).
+// Targets of indirect jumps and calls cannot be known from the code alone,
+// so code reached only that way must be given as an entry point.
+package cfg
+
+import (
+	"fmt"
+	"sort"
+
+	"rsc.io/x86/x86asm"
+)
+
+// A Graph is the control-flow graph of a region of code.
+type Graph struct {
+	// Blocks lists the basic blocks, in order of their start addresses.
+	Blocks []*Block
+
+	// Entries lists the blocks at the entry points given to Build,
+	// in the same order.
+	Entries []*Block
+
+	// Unreachable lists the byte ranges of the code that are not
+	// part of any instruction reached from the entry points,
+	// in increasing order.
+	// Bytes at which decoding failed are not part of any instruction.
+	Unreachable []Range
+
+	byStart map[uint64]*Block
+}
+
+// Block returns the basic block starting at pc, or nil if there is none.
+func (g *Graph) Block(pc uint64) *Block {
+	return g.byStart[pc]
+}
+
+// A Block is a basic block: a sequence of instructions
+// entered only at the first and left only after the last.
+// A block ends at a jump, branch, call, or return,
+// or just before an instruction that is the target of one.
+type Block struct {
+	Start uint64 // address of the first instruction
+	End   uint64 // address just past the last instruction
+
+	Insts []x86asm.Inst
+
+	// Err, if not nil, is the error decoding the instruction at End,
+	// which ends the block without successors.
+	Err error
+
+	Succs []*Edge // edges to the blocks that may execute next
+	Preds []*Edge // edges from the blocks that may execute before
+}
+
+// An Edge is a transfer of control from the end of one block to another.
+type Edge struct {
+	Kind   EdgeKind
+	From   *Block
+	To     *Block // nil if Target is outside the code
+	Target uint64
+}
+
+// An EdgeKind describes how control passes along an Edge.
+type EdgeKind uint8
+
+const (
+	FallThrough EdgeKind = iota // execution continues with the next instruction
+	Taken                       // a jump or conditional branch is taken
+	Call                        // a call; the return is a FallThrough edge
+)
+
+func (k EdgeKind) String() string {
+	switch k {
+	case FallThrough:
+		return "FallThrough"
+	case Taken:
+		return "Taken"
+	case Call:
+		return "Call"
+	}
+	return fmt.Sprintf("EdgeKind(%d)", int(k))
+}
+
+// A Range is the byte range [Start, End) of addresses.
+type Range struct {
+	Start uint64
+	End   uint64
+}
+
+// Build builds the control-flow graph of code, whose first byte is at
+// address pc, by recursive descent from the given entry addresses.
+// Dec decodes each instruction, with its processor mode and options;
+// an instruction it reports as unsupported is a decoding failure.
+func Build(code []byte, pc uint64, dec *x86asm.Decoder, entries []uint64) (*Graph, error) {
+	switch dec.Mode {
+	case 16, 32, 64:
+	default:
+		return nil, x86asm.ErrInvalidMode
+	}
+	b := &builder{
+		code:    code,
+		base:    pc,
+		dec:     *dec,
+		insts:   map[uint64]x86asm.Inst{},
+		errs:    map[uint64]error{},
+		leaders: map[uint64]bool{},
+	}
+	for _, e := range entries {
+		if !b.inCode(e) {
+			return nil, fmt.Errorf("cfg: entry %#x outside code [%#x, %#x)", e, pc, pc+uint64(len(code)))
+		}
+		b.leaders[e] = true
+		b.work = append(b.work, e)
+	}
+	b.explore()
+
+	g := b.blocks()
+	for _, e := range entries {
+		g.Entries = append(g.Entries, g.byStart[e])
+	}
+	g.Unreachable = b.unreachable()
+	return g, nil
+}
+
+// A builder holds the state of Build.
+type builder struct {
+	code []byte
+	base uint64
+	dec  x86asm.Decoder
+
+	insts   map[uint64]x86asm.Inst // decoded instructions, by address
+	errs    map[uint64]error       // decoding errors, by address
+	leaders map[uint64]bool        // addresses that start blocks
+	work    []uint64               // addresses left to explore
+}
+
+func (b *builder) inCode(pc uint64) bool {
+	return pc-b.base < uint64(len(b.code))
+}
+
+// explore decodes the instructions reachable from the addresses in b.work,
+// marking each address that must start a block as a leader.
+func (b *builder) explore() {
+	for len(b.work) > 0 {
+		pc := b.work[len(b.work)-1]
+		b.work = b.work[:len(b.work)-1]
+		for b.inCode(pc) {
+			if _, ok := b.insts[pc]; ok {
+				// Reached code already decoded, from elsewhere.
+				b.leaders[pc] = true
+				break
+			}
+			if _, ok := b.errs[pc]; ok {
+				b.leaders[pc] = true
+				break
+			}
+			inst, err := b.dec.Decode(b.code[pc-b.base:])
+			if err != nil {
+				b.errs[pc] = err
+				break
+			}
+			b.insts[pc] = inst
+			next := pc + uint64(inst.Len)
+			f := flowOf(&inst)
+			if f.kind == none {
+				pc = next
+				continue
+			}
+			if t, ok := target(pc, &inst); ok && b.inCode(t) {
+				b.leaders[t] = true
+				b.work = append(b.work, t)
+			}
+			if f.fallThrough && b.inCode(next) {
+				b.leaders[next] = true
+				b.work = append(b.work, next)
+			}
+			break
+		}
+	}
+}
+
+// target returns the target of the direct jump, branch, or call inst at pc.
+func target(pc uint64, inst *x86asm.Inst) (uint64, bool) {
+	c := inst.Control()
+	if c&(x86asm.ControlJump|x86asm.ControlCond|x86asm.ControlCall) == 0 || c&x86asm.ControlIndirect != 0 {
+		return 0, false
+	}
+	return inst.Target(pc)
+}
+
+// blocks splits the decoded instructions into blocks at the leaders
+// and connects the blocks with edges.
+func (b *builder) blocks() *Graph {
+	g := &Graph{byStart: map[uint64]*Block{}}
+	var starts []uint64
+	for pc := range b.leaders {
+		if _, ok := b.insts[pc]; ok {
+			starts = append(starts, pc)
+		} else if _, ok := b.errs[pc]; ok {
+			starts = append(starts, pc)
+		}
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+
+	for _, start := range starts {
+		blk := &Block{Start: start}
+		pc := start
+		for {
+			if err, ok := b.errs[pc]; ok {
+				blk.Err = err
+				break
+			}
+			inst, ok := b.insts[pc]
+			if !ok {
+				break
+			}
+			blk.Insts = append(blk.Insts, inst)
+			pc += uint64(inst.Len)
+			if flowOf(&inst).kind != none || b.leaders[pc] {
+				break
+			}
+		}
+		blk.End = pc
+		g.Blocks = append(g.Blocks, blk)
+		g.byStart[start] = blk
+	}
+
+	for _, blk := range g.Blocks {
+		if blk.Err != nil || len(blk.Insts) == 0 {
+			continue
+		}
+		last := blk.Insts[len(blk.Insts)-1]
+		f := flowOf(&last)
+		if f.kind != none {
+			if t, ok := target(blk.End-uint64(last.Len), &last); ok {
+				g.addEdge(f.kind, blk, t)
+			}
+		}
+		if f.kind == none || f.fallThrough {
+			g.addEdge(FallThrough, blk, blk.End)
+		}
+	}
+	return g
+}
+
+func (g *Graph) addEdge(kind EdgeKind, from *Block, target uint64) {
+	e := &Edge{Kind: kind, From: from, To: g.byStart[target], Target: target}
+	from.Succs = append(from.Succs, e)
+	if e.To != nil {
+		e.To.Preds = append(e.To.Preds, e)
+	}
+}
+
+// unreachable returns the byte ranges not covered by decoded instructions.
+func (b *builder) unreachable() []Range {
+	covered := make([]bool, len(b.code))
+	for pc, inst := range b.insts {
+		off := int(pc - b.base)
+		for i := off; i < off+inst.Len && i < len(covered); i++ {
+			covered[i] = true
+		}
+	}
+	var r []Range
+	for i := 0; i < len(covered); {
+		if covered[i] {
+			i++
+			continue
+		}
+		j := i
+		for j < len(covered) && !covered[j] {
+			j++
+		}
+		r = append(r, Range{b.base + uint64(i), b.base + uint64(j)})
+		i = j
+	}
+	return r
+}
+
+// A flow describes how an instruction passes control onward.
+type flow struct {
+	kind        EdgeKind // how control leaves, or none
+	fallThrough bool     // whether control may also reach the next instruction
+}
+
+// none is the kind of an instruction that does not end a block.
+const none EdgeKind = 255
+
+// flowOf returns the flow of inst, from inst.Control.
+// An instruction with no direct target, such as RET or an indirect JMP,
+// still ends its block, with kind Taken but no Taken edge.
+// A trap, such as SYSCALL, returns to the next instruction
+// and does not end its block.
+func flowOf(inst *x86asm.Inst) flow {
+	c := inst.Control()
+	switch {
+	case c&x86asm.ControlCall != 0:
+		return flow{Call, true}
+	case c&(x86asm.ControlJump|x86asm.ControlCond|x86asm.ControlReturn|x86asm.ControlTerminator) != 0:
+		return flow{Taken, c&x86asm.ControlTerminator == 0}
+	}
+	return flow{none, false}
+}
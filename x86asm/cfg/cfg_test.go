@@ -0,0 +1,155 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cfg
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"rsc.io/x86/x86asm"
+)
+
+var buildTests = []struct {
+	mode    int
+	pc      uint64
+	code    string
+	entries []uint64
+	blocks  string
+	unreach string
+}{
+	{
+		// push ebp; test eax, eax; je 0x100a; call 0x1010; ret; data; nop; ret
+		32, 0x1000,
+		"5585c07405e806000000c30b0b0b0b0b90c3",
+		[]uint64{0x1000},
+		"1000-1005 Taken:100a FallThrough:1005; 1005-100a Call:1010 FallThrough:100a; 100a-100b; 1010-1012",
+		"100b-1010",
+	},
+	{
+		// xor eax, eax; inc eax; jne 0x1002; ud2
+		32, 0x1000,
+		"31c04075fd0f0b",
+		[]uint64{0x1000},
+		"1000-1002 FallThrough:1002; 1002-1005 Taken:1002 FallThrough:1005; 1005-1007",
+		"",
+	},
+	{
+		// jmp 0x2000; bad opcode
+		32, 0x1000,
+		"e9fb0f00000fff",
+		[]uint64{0x1000, 0x1005},
+		"1000-1005 Taken:2000?; 1005-1005 error",
+		"1005-1007",
+	},
+	{
+		// mov ax, 0xfeeb; jmp 0x1001, into the middle of the mov,
+		// where the bytes decode as mov eax, 0xfbebfeeb; ret
+		32, 0x1000,
+		"66b8ebfeebfbc3",
+		[]uint64{0x1000},
+		"1000-1006 Taken:1001; 1001-1007",
+		"",
+	},
+	{
+		// jmp 0x0, wrapping around at the 16-bit operand size
+		32, 0x10000,
+		"66e9fcff",
+		[]uint64{0x10000},
+		"10000-10004 Taken:0?",
+		"",
+	},
+	{
+		// call 0x11006, with a 32-bit operand size in 16-bit mode
+		16, 0x1000,
+		"66e800000100",
+		[]uint64{0x1000},
+		"1000-1006 Call:11006? FallThrough:1006?",
+		"",
+	},
+}
+
+func TestBuild(t *testing.T) {
+	for _, tt := range buildTests {
+		code, err := hex.DecodeString(tt.code)
+		if err != nil {
+			t.Fatal(err)
+		}
+		g, err := Build(code, tt.pc, &x86asm.Decoder{Mode: tt.mode}, tt.entries)
+		if err != nil {
+			t.Errorf("Build(%s): %v", tt.code, err)
+			continue
+		}
+		var blocks []string
+		for _, b := range g.Blocks {
+			s := fmt.Sprintf("%x-%x", b.Start, b.End)
+			for _, e := range b.Succs {
+				s += fmt.Sprintf(" %v:%x", e.Kind, e.Target)
+				if e.To == nil {
+					s += "?"
+				} else if !hasEdge(e.To.Preds, e) {
+					t.Errorf("Build(%s): edge %x->%x missing from Preds", tt.code, b.Start, e.Target)
+				}
+			}
+			if b.Err != nil {
+				s += " error"
+			}
+			blocks = append(blocks, s)
+		}
+		if s := strings.Join(blocks, "; "); s != tt.blocks {
+			t.Errorf("Build(%s) blocks:\nhave %s\nwant %s", tt.code, s, tt.blocks)
+		}
+		var unreach []string
+		for _, r := range g.Unreachable {
+			unreach = append(unreach, fmt.Sprintf("%x-%x", r.Start, r.End))
+		}
+		if s := strings.Join(unreach, " "); s != tt.unreach {
+			t.Errorf("Build(%s) unreachable = %q, want %q", tt.code, s, tt.unreach)
+		}
+		for i, e := range tt.entries {
+			if b := g.Entries[i]; b == nil || b.Start != e || g.Block(e) != b {
+				t.Errorf("Build(%s): wrong block for entry %#x", tt.code, e)
+			}
+		}
+	}
+}
+
+func hasEdge(list []*Edge, e *Edge) bool {
+	for _, x := range list {
+		if x == e {
+			return true
+		}
+	}
+	return false
+}
+
+func TestBuildErrors(t *testing.T) {
+	if _, err := Build([]byte{0x90}, 0x1000, &x86asm.Decoder{Mode: 8}, []uint64{0x1000}); err == nil {
+		t.Errorf("Build with mode 8 succeeded")
+	}
+	if _, err := Build([]byte{0x90}, 0x1000, &x86asm.Decoder{Mode: 32}, []uint64{0x1001}); err == nil {
+		t.Errorf("Build with entry outside code succeeded")
+	}
+}
+
+// TestBuildDecoder checks that Build decodes with the options
+// of the Decoder it is given.
+func TestBuildDecoder(t *testing.T) {
+	// nop; vpaddd ymm0, ymm0, ymm0; ret
+	code := []byte{0x90, 0xc5, 0xfd, 0xfe, 0xc0, 0xc3}
+	dec := &x86asm.Decoder{Mode: 64, Allowed: x86asm.NewFeatureSet(x86asm.FeatureSSE, x86asm.FeatureSSE2)}
+	g, err := Build(code, 0x1000, dec, []uint64{0x1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Blocks) != 1 {
+		t.Fatalf("Build with AVX2 not allowed: %d blocks, want 1", len(g.Blocks))
+	}
+	if b := g.Blocks[0]; b.End != 0x1001 || !errors.Is(b.Err, x86asm.ErrUnsupported) {
+		t.Errorf("Build with AVX2 not allowed: block ends at %#x with %v, want 0x1001 with %v", b.End, b.Err, x86asm.ErrUnsupported)
+	}
+}
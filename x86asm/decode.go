@@ -47,8 +47,14 @@ const (
 	xCondDataSize // switch on operand size
 	xCondAddrSize // switch on address size
 	xCondIsMem    // switch on memory vs register argument
+	xCondVex      // switch on VEX or EVEX opcode map and implied prefix
+	xCondVexL     // switch on VEX.L or EVEX.L'L vector length
+	xCondVexW     // switch on VEX.W or EVEX.W
 
-	xSetOp // set instruction opcode
+	xSetOp     // set instruction opcode
+	xRequire   // add CPUID feature to those the instruction requires
+	xSetAccess // set operand access form
+	xSetFlags  // set EFLAGS effects
 
 	xReadSlashR // read /r
 	xReadIb     // read ib
@@ -73,6 +79,7 @@ const (
 	xArgDX           // arg DX
 	xArgEAX          // arg EAX
 	xArgEDX          // arg EDX
+	xArgER           // EVEX {er} decoration: embedded rounding control
 	xArgES           // arg ES
 	xArgFS           // arg FS
 	xArgGS           // arg GS
@@ -82,8 +89,16 @@ const (
 	xArgImm8         // arg imm8
 	xArgImm8u        // arg imm8 but record as unsigned
 	xArgImm16u       // arg imm8 but record as unsigned
+	xArgK1           // arg k1
+	xArgK2           // arg k2
+	xArgK2M16        // arg k2/m16
+	xArgK2M32        // arg k2/m32
+	xArgK2M64        // arg k2/m64
+	xArgK2M8         // arg k2/m8
+	xArgKV           // arg k in VEX.vvvv
 	xArgM            // arg m
 	xArgM128         // arg m128
+	xArgM256         // arg m256
 	xArgM1428byte    // arg m14/28byte
 	xArgM16          // arg m16
 	xArgM16and16     // arg m16&16
@@ -96,10 +111,13 @@ const (
 	xArgM2byte       // arg m2byte
 	xArgM32          // arg m32
 	xArgM32and32     // arg m32&32
+	xArgM32bcst      // EVEX m32bcst decoration: embedded broadcast of 32-bit element
 	xArgM32fp        // arg m32fp
 	xArgM32int       // arg m32int
+	xArgM512         // arg m512
 	xArgM512byte     // arg m512byte
 	xArgM64          // arg m64
+	xArgM64bcst      // EVEX m64bcst decoration: embedded broadcast of 64-bit element
 	xArgM64fp        // arg m64fp
 	xArgM64int       // arg m64int
 	xArgM8           // arg m8
@@ -107,6 +125,7 @@ const (
 	xArgM80dec       // arg m80dec
 	xArgM80fp        // arg m80fp
 	xArgM94108byte   // arg m94/108byte
+	xArgMask         // EVEX {k1} decoration: opmask register
 	xArgMm           // arg mm
 	xArgMm1          // arg mm1
 	xArgMm2          // arg mm2
@@ -126,9 +145,11 @@ const (
 	xArgR32M16       // arg r32/m16
 	xArgR32M8        // arg r32/m8
 	xArgR32op        // arg r32 with +rd in opcode
+	xArgR32V         // arg r32 in VEX.vvvv
 	xArgR64          // arg r64
 	xArgR64M16       // arg r64/m16
 	xArgR64op        // arg r64 with +rd in opcode
+	xArgR64V         // arg r64 in VEX.vvvv
 	xArgR8           // arg r8
 	xArgR8op         // arg r8 with +rb in opcode
 	xArgRAX          // arg RAX
@@ -145,11 +166,18 @@ const (
 	xArgRel16        // arg rel16
 	xArgRel32        // arg rel32
 	xArgRel8         // arg rel8
+	xArgSAE          // EVEX {sae} decoration: suppress all exceptions
 	xArgSS           // arg SS
 	xArgST           // arg ST, aka ST(0)
 	xArgSTi          // arg ST(i) with +i in opcode
 	xArgSreg         // arg Sreg
 	xArgTR0dashTR7   // arg TR0-TR7
+	xArgVm32x        // arg vm32x
+	xArgVm32y        // arg vm32y
+	xArgVm32z        // arg vm32z
+	xArgVm64x        // arg vm64x
+	xArgVm64y        // arg vm64y
+	xArgVm64z        // arg vm64z
 	xArgXmm          // arg xmm
 	xArgXMM0         // arg <XMM0>
 	xArgXmm1         // arg xmm1
@@ -158,23 +186,31 @@ const (
 	xArgXmm2M16      // arg xmm2/m16
 	xArgXmm2M32      // arg xmm2/m32
 	xArgXmm2M64      // arg xmm2/m64
+	xArgXmm2M8       // arg xmm2/m8
+	xArgXmmIH        // arg xmm in imm8[7:4]
 	xArgXmmM128      // arg xmm/m128
 	xArgXmmM32       // arg xmm/m32
 	xArgXmmM64       // arg xmm/m64
+	xArgXmmV         // arg xmm in VEX.vvvv
+	xArgYmm1         // arg ymm1
+	xArgYmm2         // arg ymm2
+	xArgYmm2M256     // arg ymm2/m256
+	xArgYmmIH        // arg ymm in imm8[7:4]
+	xArgYmmV         // arg ymm in VEX.vvvv
+	xArgZ            // EVEX {z} decoration: zeroing-masking
+	xArgZmm1         // arg zmm1
+	xArgZmm2         // arg zmm2
+	xArgZmm2M512     // arg zmm2/m512
+	xArgZmmV         // arg zmm in EVEX.vvvv
 	xArgRmf16        // arg r/m16 but force mod=3
 	xArgRmf32        // arg r/m32 but force mod=3
 	xArgRmf64        // arg r/m64 but force mod=3
 )
 
 // instPrefix returns an Inst describing just one prefix byte.
-// It is only used if there is a prefix followed by an unintelligible
-// or invalid instruction byte sequence.
+// It is only used in gnuCompat mode, if there is a prefix followed
+// by an unintelligible or invalid instruction byte sequence.
 func instPrefix(b byte, mode int) (Inst, error) {
-	// When tracing it is useful to see what called instPrefix to report an error.
-	if trace {
-		_, file, line, _ := runtime.Caller(1)
-		fmt.Printf("%s:%d\n", file, line)
-	}
 	p := Prefix(b)
 	switch p {
 	case PrefixDataSize:
@@ -196,21 +232,76 @@ func instPrefix(b byte, mode int) (Inst, error) {
 	return inst, nil
 }
 
-// truncated reports a truncated instruction.
-// For now we use instPrefix but perhaps later we will return
-// a specific error here.
-func truncated(src []byte, mode int) (Inst, error) {
-	//	return Inst{}, len(src), ErrTruncated
-	return instPrefix(src[0], mode) // too long
-}
-
 // These are the errors returned by Decode.
+// Failures to decode the instruction bytes are reported
+// as a *DecodeError matching ErrTruncated, ErrUnrecognized,
+// or ErrUnsupported.
 var (
 	ErrInvalidMode  = errors.New("invalid x86 mode in Decode")
 	ErrTruncated    = errors.New("truncated instruction")
 	ErrUnrecognized = errors.New("unrecognized instruction")
+	ErrUnsupported  = errors.New("unsupported instruction")
+)
+
+// A DecodeError describes bytes that do not hold
+// a complete, valid instruction.
+type DecodeError struct {
+	Off    int      // offset in src where decoding failed
+	Reason Reason   // why decoding failed
+	Prefix Prefixes // prefixes decoded before the failure
+}
+
+func (e *DecodeError) Error() string {
+	switch e.Reason {
+	case ReasonTruncated, ReasonUnknownOpcode, ReasonUnsupported:
+		return e.Unwrap().Error()
+	}
+	return e.Unwrap().Error() + ": " + e.Reason.String()
+}
+
+// Unwrap returns ErrTruncated if the instruction was cut short,
+// so that more input might complete it, ErrUnsupported if the
+// instruction is valid but needs a feature the Decoder does not allow,
+// and ErrUnrecognized otherwise.
+func (e *DecodeError) Unwrap() error {
+	switch e.Reason {
+	case ReasonTruncated:
+		return ErrTruncated
+	case ReasonUnsupported:
+		return ErrUnsupported
+	}
+	return ErrUnrecognized
+}
+
+// A Reason explains why an instruction could not be decoded.
+type Reason uint8
+
+const (
+	_                   Reason = iota
+	ReasonTruncated            // input ends before the instruction does
+	ReasonTooLong              // instruction exceeds the 15-byte limit
+	ReasonInvalidLock          // LOCK prefix on an instruction that cannot be locked (strict mode)
+	ReasonUnknownOpcode        // opcode bytes do not name a valid instruction
+	ReasonInvalidPrefix        // prefix not allowed with the instruction (strict mode, or before VEX)
+	ReasonUnsupported          // instruction requires a CPUID feature that is not allowed
 )
 
+var reasonNames = [...]string{
+	ReasonTruncated:     "truncated",
+	ReasonTooLong:       "too long",
+	ReasonInvalidLock:   "invalid LOCK prefix",
+	ReasonUnknownOpcode: "unknown opcode",
+	ReasonInvalidPrefix: "invalid prefix",
+	ReasonUnsupported:   "unsupported feature",
+}
+
+func (r Reason) String() string {
+	if int(r) < len(reasonNames) && reasonNames[r] != "" {
+		return reasonNames[r]
+	}
+	return fmt.Sprintf("Reason(%d)", int(r))
+}
+
 // decoderCover records coverage information for which parts
 // of the byte code have been executed.
 // TODO(rsc): This is for testing. Only use this if a flag is given.
@@ -219,19 +310,78 @@ var decoderCover []bool
 // Decode decodes the leading bytes in src as a single instruction.
 // The mode arguments specifies the assumed processor mode:
 // 16, 32, or 64 for 16-, 32-, and 64-bit execution modes.
+// If src does not begin with a complete, valid instruction,
+// Decode returns a *DecodeError, along with an Inst whose Len
+// is the offset at which decoding failed.
+//
+// Decode is shorthand for a Decoder with the given Mode
+// and all other options at their zero values.
 func Decode(src []byte, mode int) (inst Inst, err error) {
-	return decode1(src, mode, false)
+	d := Decoder{Mode: mode}
+	return d.Decode(src)
+}
+
+// A Decoder holds options controlling how instructions are decoded.
+// The zero value of each option other than Mode gives the behavior of Decode.
+type Decoder struct {
+	// Mode is the assumed processor mode:
+	// 16, 32, or 64 for 16-, 32-, and 64-bit execution modes.
+	Mode int
+
+	// Vendor selects the processor vendor whose rules apply
+	// where Intel and AMD processors disagree.
+	Vendor Vendor
+
+	// Strict causes prefixes that would make the processor fault,
+	// such as a LOCK prefix on an instruction that cannot be locked,
+	// to be reported as decoding errors instead of marked PrefixInvalid.
+	Strict bool
+
+	// GNUCompat changes the decoder's behavior to mimic
+	// bugs (or at least unique features) of GNU libopcodes as used
+	// by objdump. We don't believe that logic is the right thing to do
+	// in general, but when comparing against objdump -d it helps
+	// to adjust a few small pieces of logic.
+	// The affected logic is in the conditional branch for "mandatory" prefixes
+	// and in the reporting of prefixes followed by undecodable bytes,
+	// which libopcodes shows as a prefix instruction of their own.
+	GNUCompat bool
+
+	// Allowed, if not empty, is the set of CPUID features supported
+	// by the target processor. An instruction requiring a feature
+	// outside the set is reported as a *DecodeError with Reason
+	// ReasonUnsupported, along with the otherwise complete Inst.
+	// Instructions that need any one of several features are
+	// checked against only one of them; see FeatureSet.
+	Allowed FeatureSet
+}
+
+// A Vendor identifies a processor vendor.
+type Vendor uint8
+
+const (
+	VendorIntel Vendor = iota // Intel 64 rules
+	VendorAMD                 // AMD64 rules
+)
+
+func (v Vendor) String() string {
+	switch v {
+	case VendorIntel:
+		return "Intel"
+	case VendorAMD:
+		return "AMD"
+	}
+	return fmt.Sprintf("Vendor(%d)", int(v))
 }
 
-// decode1 is the implementation of Decode but takes an extra
-// gnuCompat flag to cause it to change its behavior to mimic
-// bugs (or at least unique features) of GNU libopcodes as used
-// by objdump. We don't believe that logic is the right thing to do
-// in general, but when testing against libopcodes it simplifies the
-// comparison if we adjust a few small pieces of logic.
-// The affected logic is in the conditional branch for "mandatory" prefixes,
-// case xCondPrefix.
-func decode1(src []byte, mode int, gnuCompat bool) (Inst, error) {
+// Decode decodes the leading bytes in src as a single instruction,
+// using the options in d. See the top-level Decode function for details.
+//
+// The vendor only matters in 64-bit mode, for near branches with an
+// operand-size (0x66) prefix: Intel processors ignore the prefix,
+// while AMD processors use a 16-bit displacement or target.
+func (d *Decoder) Decode(src []byte) (Inst, error) {
+	mode, gnuCompat := d.Mode, d.GNUCompat
 	switch mode {
 	case 16, 32, 64:
 		// ok
@@ -258,6 +408,23 @@ func decode1(src []byte, mode int, gnuCompat bool) (Inst, error) {
 		rex           Prefix // rex byte if present (or 0)
 		rexUsed       Prefix // bits used in rex byte
 		rexIndex      = -1   // index of rex byte
+		vexIndex      = -1   // index of VEX or EVEX prefix byte
+		vexKey        uint16 // implied prefix and opcode map, as in xCondVex
+		vexL          int    // VEX.L or EVEX.L'L vector length
+		vexW          int    // VEX.W bit
+		vexV          int    // register number in VEX.vvvv (and EVEX.V')
+		vexVUsed      bool   // VEX.vvvv was used by an argument
+		evex          bool   // prefix is EVEX
+		evexBad       bool   // EVEX prefix has invalid fixed bits
+		evexRR        int    // EVEX.R' bit, extending modrm reg to 5 bits
+		evexX         int    // EVEX.X bit, extending modrm r/m register to 5 bits
+		evexZ         bool   // EVEX.z bit: zeroing-masking
+		evexB         bool   // EVEX.b bit: broadcast, rounding, or exception suppression
+		evexRC        int    // EVEX.L'L as rounding control
+		evexAAA       int    // EVEX.aaa opmask register number
+		evexAAAUsed   bool   // EVEX.aaa was used by an opmask decoration
+		evexZUsed     bool   // EVEX.z was used by a zeroing decoration
+		evexBUsed     bool   // EVEX.b was used by a broadcast or rounding decoration
 
 		addrMode = mode // address mode (width in bits)
 		dataMode = mode // operand mode (width in bits)
@@ -287,6 +454,7 @@ func decode1(src []byte, mode int, gnuCompat bool) (Inst, error) {
 		imm8 int8
 		immc int64
 		immcpos int
+		rel16   bool // AMD near branch with 16-bit displacement
 
 		// output
 		opshift int
@@ -298,6 +466,31 @@ func decode1(src []byte, mode int, gnuCompat bool) (Inst, error) {
 		dataMode = 32
 	}
 
+	// fail reports a decoding failure at offset off.
+	// In gnuCompat mode, libopcodes shows a prefix followed by
+	// bytes it cannot decode as an instruction of its own.
+	fail := func(off int, reason Reason) (Inst, error) {
+		// When tracing it is useful to see what called fail to report an error.
+		if trace {
+			_, file, line, _ := runtime.Caller(1)
+			fmt.Printf("%s:%d\n", file, line)
+		}
+		if gnuCompat && (reason != ReasonUnknownOpcode || nprefix > 0) {
+			return instPrefix(src[0], mode)
+		}
+		return Inst{Len: off}, &DecodeError{Off: off, Reason: reason, Prefix: inst.Prefix}
+	}
+
+	// truncated reports that src ends before the instruction does.
+	// Since src holds at most 15 bytes, running out at that length
+	// means the instruction is too long instead.
+	truncated := func() (Inst, error) {
+		if len(src) == 15 {
+			return fail(len(src), ReasonTooLong)
+		}
+		return fail(len(src), ReasonTruncated)
+	}
+
 	// Prefixes are certainly the most complex and underspecified part of
 	// decoding x86 instructions. Although the manuals say things like
 	// up to four prefixes, one from each group, nearly everyone seems to
@@ -401,7 +594,7 @@ ReadPrefixes:
 		}
 
 		if pos >= len(inst.Prefix) {
-			return instPrefix(src[0], mode) // too long
+			return fail(pos, ReasonTooLong)
 		}
 
 		inst.Prefix[pos] = p
@@ -412,7 +605,7 @@ ReadPrefixes:
 		rex = Prefix(src[pos])
 		rexIndex = pos
 		if pos >= len(inst.Prefix) {
-			return instPrefix(src[0], mode) // too long
+			return fail(pos, ReasonTooLong)
 		}
 		inst.Prefix[pos] = rex
 		pos++
@@ -424,6 +617,121 @@ ReadPrefixes:
 		}
 	}
 
+	// Read VEX or EVEX prefix.
+	// In 64-bit mode, C4 and C5 always begin a VEX prefix and 62 an EVEX prefix.
+	// In 16- and 32-bit modes they are LES, LDS, and BOUND unless the next byte
+	// has its top two bits set, which in a ModR/M byte would denote
+	// an invalid register operand. The payload stores the R, X, and B
+	// bits inverted, so outside 64-bit mode R and X must be 0.
+	if pos < len(src) && (src[pos] == 0xC4 || src[pos] == 0xC5 || src[pos] == 0x62) {
+		if pos+1 >= len(src) {
+			return truncated()
+		}
+		if mode == 64 || src[pos+1]&0xC0 == 0xC0 {
+			vexIndex = pos
+			p := Prefix(src[pos])
+			n := 2
+			switch p {
+			case PrefixVEX3:
+				n = 3
+			case PrefixEVEX:
+				n = 4
+			}
+			if pos+n > len(src) {
+				return truncated()
+			}
+			if pos >= len(inst.Prefix) {
+				return fail(pos, ReasonTooLong)
+			}
+			inst.Prefix[pos] = p | PrefixImplicit
+			copy(inst.vex[:], src[pos+1:pos+n])
+
+			// The legacy prefixes that VEX replaces are not allowed
+			// before it, nor is REX, since VEX encodes its own R, X, B,
+			// and W bits: the processor raises #UD if any is present.
+			for _, i := range []int{lockIndex, repIndex, dataSizeIndex, rexIndex} {
+				if i >= 0 {
+					return fail(i, ReasonInvalidPrefix)
+				}
+			}
+
+			var r, x, b, m, last byte
+			switch p {
+			case PrefixVEX2:
+				last = src[pos+1]
+				r = ^last >> 7 & 1
+				m = 1
+			case PrefixVEX3:
+				b1 := src[pos+1]
+				last = src[pos+2]
+				r = ^b1 >> 7 & 1
+				x = ^b1 >> 6 & 1
+				b = ^b1 >> 5 & 1
+				m = b1 & 0x1F
+				vexW = int(last >> 7)
+			case PrefixEVEX:
+				// EVEX is laid out as 62 P0 P1 P2, where P0 is like the
+				// second byte of a 3-byte VEX with the R' bit and the map,
+				// P1 is like the third byte of a 3-byte VEX with a fixed 1
+				// in place of L, and P2 holds the AVX-512 additions.
+				p0, p2 := src[pos+1], src[pos+3]
+				last = src[pos+2]
+				evex = true
+				evexBad = p0&0x0C != 0 || last&0x04 == 0
+				r = ^p0 >> 7 & 1
+				x = ^p0 >> 6 & 1
+				b = ^p0 >> 5 & 1
+				m = p0&0x03 | 0x20
+				vexW = int(last >> 7)
+				evexZ = p2&0x80 != 0
+				evexB = p2&0x10 != 0
+				evexAAA = int(p2 & 7)
+				if mode == 64 {
+					evexRR = int(^p0>>4) & 1
+					evexX = int(x)
+				}
+				vexV = int(^p2>>3&1) << 4
+			}
+			vexV |= int(^last>>3) & 15
+			vexL = int(last>>2) & 1
+			if evex {
+				vexL = int(src[pos+3]>>5) & 3
+				// With EVEX.b set, a register-only instruction uses EVEX.L'L
+				// for rounding control and is implicitly 512 bits wide.
+				// The ModR/M byte follows the single opcode byte.
+				if evexB && pos+5 < len(src) && src[pos+5]>>6 == 3 {
+					evexRC = vexL
+					vexL = 2
+				}
+			}
+			var pp uint16
+			switch last & 3 {
+			case 1:
+				pp = 0x66
+			case 2:
+				pp = 0xF3
+			case 3:
+				pp = 0xF2
+			}
+			vexKey = pp<<8 | uint16(m)
+			rex = 0
+			dataMode = 32
+			if mode == 64 {
+				rex = PrefixREX | Prefix(r<<2|x<<1|b)
+				if vexW != 0 {
+					dataMode = 64
+				}
+			} else {
+				if vexV&16 != 0 {
+					// EVEX.V' must be 1 (unset) outside 64-bit mode.
+					evexBad = true
+				}
+				vexV &= 7
+			}
+			pos += n
+		}
+	}
+
 	// Decode instruction stream, interpreting decoding instructions.
 	// opshift gives the shift to use when saving the next
 	// opcode byte into inst.Opcode.
@@ -453,7 +761,7 @@ Decode:
 			}
 			haveModrm = true
 			if pos >= len(src) {
-				return truncated(src, mode)
+				return truncated()
 			}
 			modrm = int(src[pos])
 			pos++
@@ -480,7 +788,7 @@ Decode:
 					// Consume disp16 if present.
 					if mod == 0 && rm == 6 || mod == 2 {
 						if pos+2 > len(src) {
-							return truncated(src, mode)
+							return truncated()
 						}
 						mem.Disp = int64(binary.LittleEndian.Uint16(src[pos:]))
 						pos += 2
@@ -489,7 +797,7 @@ Decode:
 					// Consume disp8 if present.
 					if mod == 1 {
 						if pos >= len(src) {
-							return truncated(src, mode)
+							return truncated()
 						}
 						mem.Disp = int64(int8(src[pos]))
 						pos++
@@ -503,7 +811,7 @@ Decode:
 				if rm == 4 && mod != 3 {
 					haveSIB = true
 					if pos >= len(src) {
-						return truncated(src, mode)
+						return truncated()
 					}
 					sib = int(src[pos])
 					pos++
@@ -549,7 +857,7 @@ Decode:
 				// Consume disp32 if present.
 				if mod == 0 && (rm&7 == 5 || haveSIB && base&7 == 5) || mod == 2 {
 					if pos+4 > len(src) {
-						return truncated(src, mode)
+						return truncated()
 					}
 					dispoff = pos
 					displen = 4
@@ -560,7 +868,7 @@ Decode:
 				// Consume disp8 if present.
 				if mod == 1 {
 					if pos >= len(src) {
-						return truncated(src, mode)
+						return truncated()
 					}
 					dispoff = pos
 					displen = 1
@@ -604,7 +912,7 @@ Decode:
 
 		case xCondByte:
 			if pos >= len(src) {
-				return truncated(src, mode)
+				return truncated()
 			}
 			b := src[pos]
 			n := int(decoder[pc])
@@ -645,7 +953,7 @@ Decode:
 			mem := haveMem
 			if !haveModrm {
 				if pos >= len(src) {
-					return instPrefix(src[0], mode) // too long
+					return truncated()
 				}
 				mem = src[pos]>>6 != 3
 			}
@@ -655,6 +963,35 @@ Decode:
 				pc = int(decoder[pc])
 			}
 
+		case xCondVex:
+			// Conditional branch based on the VEX or EVEX prefix.
+			// The key is the implied prefix byte in the high 8 bits
+			// and the VEX.mmmmm opcode map in the low 8 bits,
+			// with 0x20 added for EVEX.
+			// Key 0 means no VEX prefix: a legacy instruction.
+			n := int(decoder[pc])
+			pc++
+			for j := 0; j < n; j++ {
+				key := decoder[pc+2*j]
+				if key == vexKey && (key != 0) == (vexIndex >= 0) {
+					pc = int(decoder[pc+2*j+1])
+					continue Decode
+				}
+			}
+			inst.Op = 0
+			break Decode
+
+		case xCondVexL:
+			if vexL > 2 {
+				// EVEX.L'L = 3 is reserved.
+				inst.Op = 0
+				break Decode
+			}
+			pc = int(decoder[pc+vexL])
+
+		case xCondVexW:
+			pc = int(decoder[pc+vexW])
+
 		case xCondDataSize:
 			switch dataMode {
 			case 16:
@@ -859,35 +1196,35 @@ Decode:
 
 		case xReadIb:
 			if pos >= len(src) {
-				return truncated(src, mode)
+				return truncated()
 			}
 			imm8 = int8(src[pos])
 			pos++
 
 		case xReadIw:
 			if pos+2 > len(src) {
-				return truncated(src, mode)
+				return truncated()
 			}
 			imm = int64(binary.LittleEndian.Uint16(src[pos:]))
 			pos += 2
 
 		case xReadId:
 			if pos+4 > len(src) {
-				return truncated(src, mode)
+				return truncated()
 			}
 			imm = int64(binary.LittleEndian.Uint32(src[pos:]))
 			pos += 4
 
 		case xReadIo:
 			if pos+8 > len(src) {
-				return truncated(src, mode)
+				return truncated()
 			}
 			imm = int64(binary.LittleEndian.Uint64(src[pos:]))
 			pos += 8
 
 		case xReadCb:
 			if pos >= len(src) {
-				return truncated(src, mode)
+				return truncated()
 			}
 			immcpos = pos
 			immc = int64(src[pos])
@@ -895,7 +1232,7 @@ Decode:
 
 		case xReadCw:
 			if pos+2 > len(src) {
-				return truncated(src, mode)
+				return truncated()
 			}
 			immcpos = pos
 			immc = int64(binary.LittleEndian.Uint16(src[pos:]))
@@ -905,27 +1242,39 @@ Decode:
 			immcpos = pos
 			if addrMode == 16 {
 				if pos+2 > len(src) {
-					return truncated(src, mode)
+					return truncated()
 				}
 				immc = int64(binary.LittleEndian.Uint16(src[pos:]))
 				pos += 2
 			} else if addrMode == 32 {
 				if pos+4 > len(src) {
-					return truncated(src, mode)
+					return truncated()
 				}
 				immc = int64(binary.LittleEndian.Uint32(src[pos:]))
 				pos += 4
 			} else {
 				if pos+8 > len(src) {
-					return truncated(src, mode)
+					return truncated()
 				}
 				immc = int64(binary.LittleEndian.Uint64(src[pos:]))
 				pos += 8
 			}
 		case xReadCd:
 			immcpos = pos
+			if d.Vendor == VendorAMD && mode == 64 && dataMode == 16 &&
+				(inst.Op == CALL || inst.Op == JMP || isCondJmp[inst.Op]) {
+				// AMD processors honor the operand-size prefix
+				// on near branches, truncating the displacement.
+				if pos+2 > len(src) {
+					return truncated()
+				}
+				immc = int64(binary.LittleEndian.Uint16(src[pos:]))
+				pos += 2
+				rel16 = true
+				break
+			}
 			if pos+4 > len(src) {
-				return truncated(src, mode)
+				return truncated()
 			}
 			immc = int64(binary.LittleEndian.Uint32(src[pos:]))
 			pos += 4
@@ -933,7 +1282,7 @@ Decode:
 		case xReadCp:
 			immcpos = pos
 			if pos+6 > len(src) {
-				return truncated(src, mode)
+				return truncated()
 			}
 			w := binary.LittleEndian.Uint32(src[pos:])
 			w2 := binary.LittleEndian.Uint16(src[pos+4:])
@@ -946,6 +1295,19 @@ Decode:
 			inst.Op = Op(decoder[pc])
 			pc++
 
+		case xRequire:
+			inst.Features |= 1 << Feature(decoder[pc])
+			pc++
+
+		case xSetAccess:
+			inst.accessForm = decoder[pc]
+			inst.Access = accessForms[inst.accessForm].args
+			pc++
+
+		case xSetFlags:
+			inst.flagForm = decoder[pc]
+			pc++
+
 		case xArg1,
 			xArg3,
 			xArgAL,
@@ -993,6 +1355,8 @@ Decode:
 
 		case xArgM,
 			xArgM128,
+			xArgM256,
+			xArgM512,
 			xArgM1428byte,
 			xArgM16,
 			xArgM16and16,
@@ -1054,7 +1418,7 @@ Decode:
 			}
 			narg++
 
-		case xArgR8, xArgR16, xArgR32, xArgR64, xArgXmm, xArgXmm1, xArgDR0dashDR7:
+		case xArgR8, xArgR16, xArgR32, xArgR64, xArgXmm, xArgDR0dashDR7:
 			base := baseReg[x]
 			index := Reg(regop)
 			if rex != 0 && base == AL && index >= 4 {
@@ -1065,6 +1429,15 @@ Decode:
 			inst.Args[narg] = base + index
 			narg++
 
+		case xArgXmm1, xArgYmm1, xArgZmm1:
+			// EVEX.R' selects registers 16-31.
+			inst.Args[narg] = baseReg[x] + Reg(regop|evexRR<<4)
+			narg++
+
+		case xArgK1:
+			inst.Args[narg] = K0 + Reg(regop&7)
+			narg++
+
 		case xArgMm, xArgMm1, xArgTR0dashTR7:
 			inst.Args[narg] = baseReg[x] + Reg(regop&7)
 			narg++
@@ -1118,7 +1491,8 @@ Decode:
 
 		case xArgRM8, xArgRM16, xArgRM32, xArgRM64, xArgR32M16, xArgR32M8, xArgR64M16,
 			xArgMmM32, xArgMmM64, xArgMm2M64,
-			xArgXmm2M16, xArgXmm2M32, xArgXmm2M64, xArgXmmM64, xArgXmmM128, xArgXmmM32, xArgXmm2M128:
+			xArgXmm2M8, xArgXmm2M16, xArgXmm2M32, xArgXmm2M64, xArgXmmM64, xArgXmmM128, xArgXmmM32, xArgXmm2M128,
+			xArgYmm2M256, xArgZmm2M512, xArgK2M8, xArgK2M16, xArgK2M32, xArgK2M64:
 			if haveMem {
 				inst.Args[narg] = mem
 				inst.MemBytes = int(memBytes[decodeOp(x)])
@@ -1133,6 +1507,12 @@ Decode:
 				case xArgMmM32, xArgMmM64, xArgMm2M64:
 					// There are only 8 MMX registers, so these ignore the REX.X bit.
 					index &= 7
+				case xArgK2M8, xArgK2M16, xArgK2M32, xArgK2M64:
+					// Likewise there are only 8 opmask registers.
+					index &= 7
+				case xArgXmm2M8, xArgXmm2M16, xArgXmm2M32, xArgXmm2M64, xArgXmm2M128, xArgYmm2M256, xArgZmm2M512:
+					// EVEX.X selects registers 16-31.
+					index |= Reg(evexX << 4)
 				case xArgRM8:
 					if rex != 0 && index >= 4 {
 						rexUsed |= PrefixREX
@@ -1152,14 +1532,95 @@ Decode:
 			inst.Args[narg] = baseReg[x] + Reg(rm&7)
 			narg++
 
-		case xArgXmm2: // register only; TODO(rsc): Handle with tag modrm_regonly tag
+		case xArgXmm2, xArgYmm2, xArgZmm2: // register only; TODO(rsc): Handle with tag modrm_regonly tag
 			if haveMem {
 				inst.Op = 0
 				break Decode
 			}
-			inst.Args[narg] = baseReg[x] + Reg(rm)
+			inst.Args[narg] = baseReg[x] + Reg(rm|evexX<<4)
 			narg++
 
+		case xArgK2:
+			if haveMem {
+				inst.Op = 0
+				break Decode
+			}
+			inst.Args[narg] = K0 + Reg(rm&7)
+			narg++
+
+		case xArgR32V, xArgR64V, xArgXmmV, xArgYmmV, xArgZmmV:
+			inst.Args[narg] = baseReg[x] + Reg(vexV)
+			vexVUsed = true
+			narg++
+
+		case xArgKV:
+			inst.Args[narg] = K0 + Reg(vexV&7)
+			vexVUsed = true
+			narg++
+
+		case xArgXmmIH, xArgYmmIH:
+			inst.is4 = uint8(imm8)
+			n := uint8(imm8) >> 4
+			if mode != 64 {
+				n &= 7
+			}
+			inst.Args[narg] = baseReg[x] + Reg(n)
+			narg++
+
+		case xArgVm32x, xArgVm32y, xArgVm32z, xArgVm64x, xArgVm64y, xArgVm64z:
+			// VSIB memory operand: the SIB index selects a vector register
+			// holding the element addresses, and there is no "no index" encoding.
+			// MemBytes records the size of a single element.
+			// EVEX.V' selects index registers 16-31, and the EVEX forms
+			// require an opmask to record which elements have completed.
+			if !haveSIB || addrMode == 16 || evex && evexAAA == 0 {
+				inst.Op = 0
+				break Decode
+			}
+			if evex {
+				index |= vexV & 16
+				vexV &^= 16
+			}
+			mem.Index = baseReg[x] + Reg(index)
+			inst.Args[narg] = mem
+			inst.MemBytes = 4 << uint(vexW)
+			narg++
+
+		// EVEX decorations modify the preceding argument.
+
+		case xArgMask:
+			if evexAAA != 0 {
+				inst.Mask = K0 + Reg(evexAAA)
+			}
+			evexAAAUsed = true
+
+		case xArgZ:
+			inst.Zero = evexZ
+			evexZUsed = true
+
+		case xArgM32bcst, xArgM64bcst:
+			if evexB && haveMem {
+				n := 4
+				if decodeOp(x) == xArgM64bcst {
+					n = 8
+				}
+				inst.Bcst = inst.MemBytes / n
+				inst.MemBytes = n
+				evexBUsed = true
+			}
+
+		case xArgER:
+			if evexB && !haveMem {
+				inst.Round = RoundNearest + Rounding(evexRC)
+				evexBUsed = true
+			}
+
+		case xArgSAE:
+			if evexB && !haveMem {
+				inst.Round = RoundSAE
+				evexBUsed = true
+			}
+
 		case xArgRel8:
 			inst.PCRelOff = immcpos
 			inst.PCRel = 1
@@ -1174,22 +1635,75 @@ Decode:
 
 		case xArgRel32:
 			inst.PCRelOff = immcpos
+			if rel16 {
+				inst.PCRel = 2
+				inst.Args[narg] = Rel(int16(immc))
+				narg++
+				break
+			}
 			inst.PCRel = 4
 			inst.Args[narg] = Rel(int32(immc))
 			narg++
 		}
 	}
 
+	// VEX.vvvv must be 1111b (no register) if the instruction does not use it.
+	if vexIndex >= 0 && vexV != 0 && !vexVUsed {
+		inst.Op = 0
+	}
+
+	// The EVEX opmask, zeroing, and b bits must be 0 unless the instruction
+	// allows them, and zeroing-masking requires an opmask.
+	if evex {
+		if evexBad ||
+			evexAAA != 0 && !evexAAAUsed ||
+			evexZ && (!evexZUsed || evexAAA == 0) ||
+			evexB && !evexBUsed {
+			inst.Op = 0
+		}
+	}
+
 	if inst.Op == 0 {
 		// Invalid instruction.
-		if nprefix > 0 {
-			return instPrefix(src[0], mode) // invalid instruction
-		}
-		return Inst{Len: pos}, ErrUnrecognized
+		return fail(pos, ReasonUnknownOpcode)
 	}
 
 	// Matched! Hooray!
 
+	// EVEX compresses an 8-bit displacement by scaling it by the size
+	// of the memory access (disp8*N). The compress and expand instructions
+	// access memory an element at a time, so for them N is the element size.
+	if evex && displen == 1 {
+		n := inst.MemBytes
+		switch inst.Op {
+		case VCOMPRESSPD, VCOMPRESSPS, VPCOMPRESSD, VPCOMPRESSQ, VEXPANDPD, VEXPANDPS, VPEXPANDD, VPEXPANDQ:
+			n = 4 << uint(vexW)
+		}
+		for i, a := range inst.Args {
+			if m, ok := a.(Mem); ok {
+				m.Disp *= int64(n)
+				inst.Args[i] = m
+			}
+		}
+	}
+
+	// AMD processors also honor the operand-size prefix on
+	// indirect near branches, which then take a 16-bit target.
+	if d.Vendor == VendorAMD && mode == 64 && dataMode == 16 &&
+		(inst.Op == CALL || inst.Op == JMP) && inst.Opcode>>24 == 0xFF {
+		switch a := inst.Args[0].(type) {
+		case Reg:
+			if RAX <= a && a <= R15 {
+				inst.Args[0] = a - RAX + AX
+			}
+		case Mem:
+			inst.MemBytes = 2
+		}
+		if dataSizeIndex >= 0 {
+			inst.Prefix[dataSizeIndex] |= PrefixImplicit
+		}
+	}
+
 	// 90 decodes as XCHG EAX, EAX but is NOP.
 	// 66 90 decodes as XCHG AX, AX and is NOP too.
 	// 48 90 decodes as XCHG RAX, RAX and is NOP too.
@@ -1204,12 +1718,16 @@ Decode:
 			}
 			inst.Args[0] = nil
 			inst.Args[1] = nil
+			inst.Access = [4]Access{}
+			inst.accessForm = 0
 		}
 		if repIndex >= 0 && inst.Prefix[repIndex] == 0xF3 {
 			inst.Prefix[repIndex] |= PrefixImplicit
 			inst.Op = PAUSE
 			inst.Args[0] = nil
 			inst.Args[1] = nil
+			inst.Access = [4]Access{}
+			inst.accessForm = 0
 		} else if gnuCompat {
 			for i := nprefix - 1; i >= 0; i-- {
 				if inst.Prefix[i]&0xFF == 0xF3 {
@@ -1217,6 +1735,8 @@ Decode:
 					inst.Op = PAUSE
 					inst.Args[0] = nil
 					inst.Args[1] = nil
+					inst.Access = [4]Access{}
+					inst.accessForm = 0
 					break
 				}
 			}
@@ -1244,21 +1764,25 @@ Decode:
 	case INSB, INSW, INSD:
 		inst.Args[0] = Mem{Segment: ES, Base: baseRegForBits(addrMode) + DI - AX}
 		inst.Args[1] = DX
+		inst.Access = [4]Access{AccessWrite, AccessRead}
 		usedAddrSize = true
 
 	case OUTSB, OUTSW, OUTSD:
 		inst.Args[0] = DX
 		inst.Args[1] = Mem{Segment: defaultSeg(), Base: baseRegForBits(addrMode) + SI - AX}
+		inst.Access = [4]Access{AccessRead, AccessRead}
 		usedAddrSize = true
 
 	case MOVSB, MOVSW, MOVSD, MOVSQ:
 		inst.Args[0] = Mem{Segment: ES, Base: baseRegForBits(addrMode) + DI - AX}
 		inst.Args[1] = Mem{Segment: defaultSeg(), Base: baseRegForBits(addrMode) + SI - AX}
+		inst.Access = [4]Access{AccessWrite, AccessRead}
 		usedAddrSize = true
 
 	case CMPSB, CMPSW, CMPSD, CMPSQ:
 		inst.Args[0] = Mem{Segment: defaultSeg(), Base: baseRegForBits(addrMode) + SI - AX}
 		inst.Args[1] = Mem{Segment: ES, Base: baseRegForBits(addrMode) + DI - AX}
+		inst.Access = [4]Access{AccessRead, AccessRead}
 		usedAddrSize = true
 
 	case LODSB, LODSW, LODSD, LODSQ:
@@ -1273,6 +1797,7 @@ Decode:
 			inst.Args[0] = RAX
 		}
 		inst.Args[1] = Mem{Segment: defaultSeg(), Base: baseRegForBits(addrMode) + SI - AX}
+		inst.Access = [4]Access{AccessWrite, AccessRead}
 		usedAddrSize = true
 
 	case STOSB, STOSW, STOSD, STOSQ:
@@ -1287,6 +1812,7 @@ Decode:
 		case STOSQ:
 			inst.Args[1] = RAX
 		}
+		inst.Access = [4]Access{AccessWrite, AccessRead}
 		usedAddrSize = true
 
 	case SCASB, SCASW, SCASD, SCASQ:
@@ -1301,10 +1827,12 @@ Decode:
 		case SCASQ:
 			inst.Args[0] = RAX
 		}
+		inst.Access = [4]Access{AccessRead, AccessRead}
 		usedAddrSize = true
 
 	case XLATB:
 		inst.Args[0] = Mem{Segment: defaultSeg(), Base: baseRegForBits(addrMode) + BX - AX}
+		inst.Access = [4]Access{AccessRead}
 		usedAddrSize = true
 	}
 
@@ -1455,10 +1983,43 @@ Decode:
 		}
 	}
 
+	// In strict mode, prefixes that make the instruction fault
+	// invalidate the whole instruction.
+	if d.Strict {
+		for i, p := range inst.Prefix {
+			if p&PrefixInvalid == 0 {
+				continue
+			}
+			if p&0xFF == PrefixLOCK {
+				return fail(i, ReasonInvalidLock)
+			}
+			return fail(i, ReasonInvalidPrefix)
+		}
+	}
+
+	// Merging-masking leaves the masked-off elements of the
+	// destination unchanged, so the destination is also read.
+	if inst.Mask != 0 && !inst.Zero {
+		if r, ok := inst.Args[0].(Reg); ok && !(K0 <= r && r <= K7) {
+			inst.Access[0] |= AccessRead
+		}
+	}
+	for i, arg := range inst.Args {
+		if arg == nil {
+			inst.Access[i] = 0
+		}
+	}
+
 	inst.DataSize = dataMode
 	inst.AddrSize = addrMode
 	inst.Mode = mode
 	inst.Len = pos
+
+	// An instruction the target processor does not support
+	// is still returned in full, so that the caller can inspect it.
+	if d.Allowed != 0 && inst.Features&^d.Allowed != 0 {
+		return inst, &DecodeError{Off: pos, Reason: ReasonUnsupported, Prefix: inst.Prefix}
+	}
 	return inst, nil
 }
 
@@ -1495,6 +2056,13 @@ func baseRegForBits(bits int) Reg {
 // a range of registers indexed by op, regop, or rm.
 var baseReg = [...]Reg{
 	xArgDR0dashDR7: DR0,
+	xArgK1:         K0,
+	xArgK2:         K0,
+	xArgK2M16:      K0,
+	xArgK2M32:      K0,
+	xArgK2M64:      K0,
+	xArgK2M8:       K0,
+	xArgKV:         K0,
 	xArgMm1:        M0,
 	xArgMm2:        M0,
 	xArgMm2M64:     M0,
@@ -1507,9 +2075,11 @@ var baseReg = [...]Reg{
 	xArgR32M16:     EAX,
 	xArgR32M8:      EAX,
 	xArgR32op:      EAX,
+	xArgR32V:       EAX,
 	xArgR64:        RAX,
 	xArgR64M16:     RAX,
 	xArgR64op:      RAX,
+	xArgR64V:       RAX,
 	xArgR8:         AL,
 	xArgR8op:       AL,
 	xArgRM16:       AX,
@@ -1521,16 +2091,34 @@ var baseReg = [...]Reg{
 	xArgRmf64:      RAX,
 	xArgSTi:        F0,
 	xArgTR0dashTR7: TR0,
+	xArgVm32x:      X0,
+	xArgVm32y:      Y0,
+	xArgVm32z:      Z0,
+	xArgVm64x:      X0,
+	xArgVm64y:      Y0,
+	xArgVm64z:      Z0,
 	xArgXmm1:       X0,
 	xArgXmm2:       X0,
 	xArgXmm2M128:   X0,
 	xArgXmm2M16:    X0,
 	xArgXmm2M32:    X0,
 	xArgXmm2M64:    X0,
+	xArgXmm2M8:     X0,
 	xArgXmm:        X0,
+	xArgXmmIH:      X0,
 	xArgXmmM128:    X0,
 	xArgXmmM32:     X0,
 	xArgXmmM64:     X0,
+	xArgXmmV:       X0,
+	xArgYmm1:       Y0,
+	xArgYmm2:       Y0,
+	xArgYmm2M256:   Y0,
+	xArgYmmIH:      Y0,
+	xArgYmmV:       Y0,
+	xArgZmm1:       Z0,
+	xArgZmm2:       Z0,
+	xArgZmm2M512:   Z0,
+	xArgZmmV:       Z0,
 }
 
 // prefixToSegment returns the segment register
@@ -1577,8 +2165,15 @@ var fixedArg = [...]Arg{
 
 // memBytes records the size of the memory pointed at
 // by a memory argument of the given form.
-var memBytes = [...]int8{
+var memBytes = [...]int16{
+	xArgK2M16:      16 / 8,
+	xArgK2M32:      32 / 8,
+	xArgK2M64:      64 / 8,
+	xArgK2M8:       8 / 8,
 	xArgM128:       128 / 8,
+	xArgM256:       256 / 8,
+	xArgM512:       512 / 8,
+	xArgM512byte:   512,
 	xArgM16:        16 / 8,
 	xArgM16and16:   (16 + 16) / 8,
 	xArgM16colon16: (16 + 16) / 8,
@@ -1592,6 +2187,9 @@ var memBytes = [...]int8{
 	xArgM64:        64 / 8,
 	xArgM64fp:      64 / 8,
 	xArgM64int:     64 / 8,
+	xArgM80bcd:     80 / 8,
+	xArgM80dec:     80 / 8,
+	xArgM80fp:      80 / 8,
 	xArgMm2M64:     64 / 8,
 	xArgMmM32:      32 / 8,
 	xArgMmM64:      64 / 8,
@@ -1610,10 +2208,13 @@ var memBytes = [...]int8{
 	xArgXmm2M16:    16 / 8,
 	xArgXmm2M32:    32 / 8,
 	xArgXmm2M64:    64 / 8,
+	xArgXmm2M8:     8 / 8,
 	xArgXmm:        128 / 8,
 	xArgXmmM128:    128 / 8,
 	xArgXmmM32:     32 / 8,
 	xArgXmmM64:     64 / 8,
+	xArgYmm2M256:   256 / 8,
+	xArgZmm2M512:   512 / 8,
 }
 
 // isCondJmp records the conditional jumps.
@@ -6,6 +6,7 @@ package x86asm
 
 import (
 	"encoding/hex"
+	"errors"
 	"io/ioutil"
 	"strconv"
 	"strings"
@@ -54,11 +55,15 @@ func TestDecode(t *testing.T) {
 		} else {
 			switch syntax {
 			case "gnu":
-				out = GNUSyntax(inst)
+				out = GNUSyntax(inst, 0, nil)
 			case "intel":
-				out = IntelSyntax(inst)
+				out = IntelSyntax(inst, 0, nil)
+			case "masm":
+				out = MASMSyntax(inst, 0, nil)
+			case "nasm":
+				out = NASMSyntax(inst, 0, nil)
 			case "plan9":
-				out = plan9Syntax(inst, 0, nil)
+				out = GoSyntax(inst, 0, nil)
 			default:
 				t.Errorf("unknown syntax %q", syntax)
 				continue
@@ -69,3 +74,215 @@ func TestDecode(t *testing.T) {
 		}
 	}
 }
+
+// decodeHex decodes the hex-encoded instruction code with d.
+// Invalid hex is a mistake in the test table and stops the test.
+func decodeHex(t *testing.T, d Decoder, code string) (Inst, error) {
+	t.Helper()
+	b, err := hex.DecodeString(code)
+	if err != nil {
+		t.Fatalf("invalid hex %q: %v", code, err)
+	}
+	return d.Decode(b)
+}
+
+// mustDecodeHex is like decodeHex but reports a decoding error
+// as a test failure, in which case it returns ok == false.
+func mustDecodeHex(t *testing.T, d Decoder, code string) (inst Inst, ok bool) {
+	t.Helper()
+	inst, err := decodeHex(t, d, code)
+	if err != nil {
+		t.Errorf("%+v.Decode(%s): %v", d, code, err)
+		return Inst{}, false
+	}
+	return inst, true
+}
+
+var symTests = []struct {
+	code  string
+	gnu   string
+	intel string
+	plan9 string
+	nasm  string
+	masm  string
+}{
+	{"e8fb0f0000", "callq runtime.morestack", "call runtime.morestack", "CALL runtime.morestack(SB)", "call runtime.morestack", "call runtime.morestack"},
+	{"ebfe", "jmp main.main", "jmp main.main", "JMP main.main(SB)", "jmp main.main", "jmp main.main"},
+	{"eb10", "jmp main.main+0x12", "jmp main.main+0x12", "JMP 0x1012", "jmp main.main+0x12", "jmp main.main+12h"},
+	{"e9f0ef0000", "jmpq 0xfff5", "jmp 0xfff5", "JMP 0xfff5", "jmp 0xfff5", "jmp 0FFF5h"},
+	{"488b05f90f0000", "mov runtime.morestack(%rip),%rax", "mov rax, qword ptr [rip+runtime.morestack]", "MOVQ runtime.morestack(SB), AX", "mov rax, qword [rel runtime.morestack]", "mov rax, qword ptr [runtime.morestack]"},
+	{"8b05fd0f0000", "mov runtime.morestack+0x3(%rip),%eax", "mov eax, dword ptr [rip+runtime.morestack+0x3]", "MOVL runtime.morestack+3(SB), AX", "mov eax, dword [rel runtime.morestack+0x3]", "mov eax, dword ptr [runtime.morestack+3h]"},
+	{"8b042504200000", "mov 0x2004,%eax", "mov eax, dword ptr [0x2004]", "MOVL runtime.morestack+4(SB), AX", "mov eax, dword [0x2004]", "mov eax, dword ptr [2004h]"},
+}
+
+func TestSymLookup(t *testing.T) {
+	const pc = 0x1000
+	symname := func(addr uint64) (string, uint64) {
+		switch {
+		case 0x1000 <= addr && addr < 0x1100:
+			return "main.main", 0x1000
+		case 0x2000 <= addr && addr < 0x2100:
+			return "runtime.morestack", 0x2000
+		}
+		return "", 0
+	}
+	for _, tt := range symTests {
+		inst, ok := mustDecodeHex(t, Decoder{Mode: 64}, tt.code)
+		if !ok {
+			continue
+		}
+		if out := GNUSyntax(inst, pc, symname); out != tt.gnu {
+			t.Errorf("GNUSyntax(%s) = %s, want %s", tt.code, out, tt.gnu)
+		}
+		if out := IntelSyntax(inst, pc, symname); out != tt.intel {
+			t.Errorf("IntelSyntax(%s) = %s, want %s", tt.code, out, tt.intel)
+		}
+		if out := GoSyntax(inst, pc, symname); out != tt.plan9 {
+			t.Errorf("GoSyntax(%s) = %s, want %s", tt.code, out, tt.plan9)
+		}
+		if out := NASMSyntax(inst, pc, symname); out != tt.nasm {
+			t.Errorf("NASMSyntax(%s) = %s, want %s", tt.code, out, tt.nasm)
+		}
+		if out := MASMSyntax(inst, pc, symname); out != tt.masm {
+			t.Errorf("MASMSyntax(%s) = %s, want %s", tt.code, out, tt.masm)
+		}
+	}
+}
+
+var memBytesTests = []struct {
+	code     string
+	mode     int
+	memBytes int
+}{
+	{"0fae00", 64, 512},         // fxsave [rax]
+	{"c5f81000", 64, 16},        // vmovups xmm0, [rax]
+	{"c5fc1000", 64, 32},        // vmovups ymm0, [rax]
+	{"62f17c481000", 64, 64},    // vmovups zmm0, [rax]
+	{"62f1fd58584008", 64, 8},   // vaddpd zmm0, zmm0, [rax+0x40]{1to8}
+	{"c4e27d5800", 32, 4},       // vpbroadcastd ymm0, [eax]
+	{"62f27d4990440802", 64, 4}, // vpgatherdd zmm0{k1}, [rax+zmm1+0x8]
+}
+
+func TestMemBytes(t *testing.T) {
+	for _, tt := range memBytesTests {
+		inst, ok := mustDecodeHex(t, Decoder{Mode: tt.mode}, tt.code)
+		if !ok {
+			continue
+		}
+		if inst.MemBytes != tt.memBytes {
+			t.Errorf("Decode(%s) = %v with MemBytes %d, want %d", tt.code, inst, inst.MemBytes, tt.memBytes)
+		}
+	}
+}
+
+var decodeErrorTests = []struct {
+	code   string
+	mode   int
+	reason Reason
+	off    int
+	prefix Prefix
+	err    error
+}{
+	{"0f", 64, ReasonTruncated, 1, 0, ErrTruncated},
+	{"f0", 32, ReasonTruncated, 1, PrefixLOCK, ErrTruncated},
+	{"66b811", 32, ReasonTruncated, 3, PrefixData16, ErrTruncated},
+	{"2682", 64, ReasonUnknownOpcode, 2, PrefixES, ErrUnrecognized},
+	{"0f04", 64, ReasonUnknownOpcode, 2, 0, ErrUnrecognized},
+	{"f0f2f33e66f066f2f33e366681841122334455", 32, ReasonTooLong, 15, PrefixLOCK, ErrUnrecognized},
+	{"66c5f858c0", 64, ReasonInvalidPrefix, 0, PrefixData16, ErrUnrecognized},
+	{"f0c5f858c0", 32, ReasonInvalidPrefix, 0, PrefixLOCK, ErrUnrecognized},
+	{"2e48c5f858c0", 64, ReasonInvalidPrefix, 1, PrefixCS, ErrUnrecognized},
+	{"f262f17c4858c0", 64, ReasonInvalidPrefix, 0, PrefixREPN, ErrUnrecognized},
+}
+
+func TestDecodeError(t *testing.T) {
+	for _, tt := range decodeErrorTests {
+		_, err := decodeHex(t, Decoder{Mode: tt.mode}, tt.code)
+		var de *DecodeError
+		if !errors.As(err, &de) {
+			t.Errorf("Decode(%s) = %v, want *DecodeError", tt.code, err)
+			continue
+		}
+		if de.Reason != tt.reason || de.Off != tt.off || de.Prefix[0]&0xFF != tt.prefix&0xFF {
+			t.Errorf("Decode(%s) = %v at %d after %v, want %v at %d after %v", tt.code, de.Reason, de.Off, de.Prefix[0], tt.reason, tt.off, tt.prefix)
+		}
+		if !errors.Is(err, tt.err) {
+			t.Errorf("Decode(%s) = %v, want match for %v", tt.code, err, tt.err)
+		}
+	}
+}
+
+var decoderTests = []struct {
+	d    Decoder
+	code string
+	text string // GNU syntax, or "error: " followed by the error
+	len  int
+}{
+	{Decoder{Mode: 64}, "66e811223344", "callw .+0x44332211", 6},
+	{Decoder{Mode: 64, Vendor: VendorAMD}, "66e811223344", "callw .+0x2211", 4},
+	{Decoder{Mode: 64, Vendor: VendorAMD}, "660f8411223344", "je .+0x2211", 5},
+	{Decoder{Mode: 64, Vendor: VendorAMD}, "6648e911223344", "data16 jmpq .+0x44332211", 7},
+	{Decoder{Mode: 64, Vendor: VendorAMD}, "66ffd0", "call *%ax", 3},
+	{Decoder{Mode: 32, Vendor: VendorAMD}, "66e81122", "callw .+0x2211", 4},
+	{Decoder{Mode: 64}, "f00100", "lock add %eax,(%rax)", 3},
+	{Decoder{Mode: 64}, "f001c0", "lock add %eax,%eax", 3},
+	{Decoder{Mode: 64, Strict: true}, "f00100", "lock add %eax,(%rax)", 3},
+	{Decoder{Mode: 64, Strict: true}, "f001c0", "error: unrecognized instruction: invalid LOCK prefix", 0},
+	{Decoder{Mode: 64, Strict: true}, "66c5f858c0", "error: unrecognized instruction: invalid prefix", 0},
+	{Decoder{Mode: 64}, "2682", "error: unrecognized instruction", 2},
+	{Decoder{Mode: 64, GNUCompat: true}, "2682", "es", 1},
+	{Decoder{Mode: 64, Allowed: NewFeatureSet(FeatureSSE, FeatureSSE2)}, "660ffcc0", "paddb %xmm0,%xmm0", 4},
+	{Decoder{Mode: 64, Allowed: NewFeatureSet(FeatureSSE, FeatureSSE2)}, "c5fdfec0", "error: unsupported instruction", 4},
+	{Decoder{Mode: 64, Allowed: NewFeatureSet(FeatureAVX)}, "c4e279dcc0", "error: unsupported instruction", 5},
+	{Decoder{Mode: 64, Allowed: NewFeatureSet(FeatureSSE, FeatureSSE2)}, "f20f38f1c1", "error: unsupported instruction", 5},
+	{Decoder{Mode: 64, Allowed: NewFeatureSet(FeatureSSE, FeatureSSE2)}, "9f", "error: unsupported instruction", 1},
+	{Decoder{Mode: 32, Allowed: NewFeatureSet(FeatureSSE, FeatureSSE2)}, "9f", "lahf", 1},
+}
+
+func TestDecoder(t *testing.T) {
+	for _, tt := range decoderTests {
+		inst, err := decodeHex(t, tt.d, tt.code)
+		var text string
+		if err != nil {
+			text = "error: " + err.Error()
+		} else {
+			text = GNUSyntax(inst, 0, nil)
+		}
+		if text != tt.text || inst.Len != tt.len {
+			t.Errorf("%+v.Decode(%s) = %s, %d, want %s, %d", tt.d, tt.code, text, inst.Len, tt.text, tt.len)
+		}
+	}
+}
+
+var featureTests = []struct {
+	code     string
+	features string
+}{
+	{"0000", ""},                         // add [rax], al
+	{"0ffcc0", "MMX"},                    // paddb mm0, mm0
+	{"660ffcc0", "SSE2"},                 // paddb xmm0, xmm0
+	{"c5f858c0", "AVX"},                  // vaddps xmm0, xmm0, xmm0
+	{"c5fdfec0", "AVX2"},                 // vpaddd ymm0, ymm0, ymm0
+	{"62f1fd4858c0", "AVX512F"},          // vaddpd zmm0, zmm0, zmm0
+	{"62f1fd2858c0", "AVX512F,AVX512VL"}, // vaddpd ymm0, ymm0, ymm0
+	{"f30fbcc0", "BMI1"},                 // tzcnt eax, eax
+	{"c4e279dcc0", "AES,AVX"},            // vaesenc xmm0, xmm0, xmm0
+	{"0f01d6", "RTM"},                    // xtest
+	{"f20f38f1c1", "SSE4_2"},             // crc32 eax, ecx
+	{"f30fb8c1", "POPCNT"},               // popcnt eax, ecx
+	{"480fc70e", "CX16"},                 // cmpxchg16b [rsi]
+	{"0f38f006", "MOVBE"},                // movbe eax, [rsi]
+	{"9f", "LAHF_LM"},                    // lahf
+}
+
+func TestFeatures(t *testing.T) {
+	for _, tt := range featureTests {
+		inst, ok := mustDecodeHex(t, Decoder{Mode: 64}, tt.code)
+		if !ok {
+			continue
+		}
+		if inst.Features.String() != tt.features {
+			t.Errorf("Decode(%s) = %v with Features %v, want %v", tt.code, inst, inst.Features, tt.features)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// A Disassembler decodes the instructions in a region of code
+// one after another, in a linear sweep from the start of the region.
+//
+// A typical loop is:
+//
+//	d := x86asm.NewDisassembler(code, base, 64)
+//	for {
+//		pc, inst, raw, err := d.Next()
+//		if err == io.EOF {
+//			break
+//		}
+//		...
+//	}
+//
+// The options may be changed before the first call to Next.
+type Disassembler struct {
+	// Decoder holds the options for decoding each instruction.
+	Decoder Decoder
+
+	// Resync selects how the sweep continues past bytes
+	// that do not decode as an instruction.
+	Resync Resync
+
+	// End, if not zero, is the address at which the sweep stops.
+	// An instruction that starts before End is decoded in full,
+	// even if it extends past End.
+	End uint64
+
+	pc   uint64
+	code []byte // undecoded bytes starting at pc
+	err  error  // error to return from all future calls to Next
+
+	// For NewReaderDisassembler.
+	r    io.ReaderAt
+	roff int64 // offset in r of the end of code
+	size int64
+	buf  []byte
+}
+
+// A Resync selects how a Disassembler continues after
+// bytes that do not decode as an instruction.
+// An instruction that decodes but is not in Decoder.Allowed
+// is skipped in full, whatever the Resync.
+type Resync uint8
+
+const (
+	ResyncByte    Resync = iota // skip one byte and decode from the next
+	ResyncDecoded               // skip the bytes decoded before the failure, at least one
+	ResyncStop                  // stop the sweep
+)
+
+func (r Resync) String() string {
+	switch r {
+	case ResyncByte:
+		return "Byte"
+	case ResyncDecoded:
+		return "Decoded"
+	case ResyncStop:
+		return "Stop"
+	}
+	return fmt.Sprintf("Resync(%d)", int(r))
+}
+
+// disasmBufSize is the size of the buffer
+// a Disassembler reads code into from an io.ReaderAt.
+const disasmBufSize = 4096
+
+// NewDisassembler returns a Disassembler for the code in code,
+// whose first byte is at address pc, in the given processor mode.
+func NewDisassembler(code []byte, pc uint64, mode int) *Disassembler {
+	return &Disassembler{
+		Decoder: Decoder{Mode: mode},
+		pc:      pc,
+		code:    code,
+	}
+}
+
+// NewReaderDisassembler returns a Disassembler for the size bytes
+// of code in r, whose first byte is at address pc, in the given processor mode.
+// To start at an offset other than 0, use an io.SectionReader.
+func NewReaderDisassembler(r io.ReaderAt, size int64, pc uint64, mode int) *Disassembler {
+	return &Disassembler{
+		Decoder: Decoder{Mode: mode},
+		pc:      pc,
+		r:       r,
+		size:    size,
+		buf:     make([]byte, disasmBufSize),
+	}
+}
+
+// PC returns the address of the next instruction Next will decode.
+func (d *Disassembler) PC() uint64 {
+	return d.pc
+}
+
+// Next decodes the next instruction and returns its address,
+// the instruction, and the bytes it was decoded from.
+//
+// If the bytes at pc do not decode as an instruction,
+// Next returns the *DecodeError, along with an Inst whose Len
+// is the number of bytes skipped according to d.Resync
+// and those bytes themselves.
+// With ResyncStop, the sweep then ends.
+// Any other error from d.Decoder, like ErrInvalidMode,
+// is returned once, with no instruction, and ends the sweep.
+//
+// At the end of the code, or at d.End, Next returns io.EOF.
+// An error reading from the io.ReaderAt also ends the sweep
+// and is returned from every later call.
+//
+// For a Disassembler reading from an io.ReaderAt,
+// raw is only valid until the next call to Next.
+func (d *Disassembler) Next() (pc uint64, inst Inst, raw []byte, err error) {
+	if d.err != nil {
+		return d.pc, Inst{}, nil, d.err
+	}
+	if d.End != 0 && d.pc >= d.End {
+		d.err = io.EOF
+		return d.pc, Inst{}, nil, d.err
+	}
+	if err := d.fill(); err != nil {
+		d.err = err
+		return d.pc, Inst{}, nil, d.err
+	}
+	if len(d.code) == 0 {
+		d.err = io.EOF
+		return d.pc, Inst{}, nil, d.err
+	}
+
+	pc = d.pc
+	inst, err = d.Decoder.Decode(d.code)
+	var derr *DecodeError
+	if err != nil && !errors.As(err, &derr) {
+		d.err = io.EOF
+		return pc, Inst{}, nil, err
+	}
+	n := inst.Len
+	if err != nil && !errors.Is(err, ErrUnsupported) {
+		switch d.Resync {
+		case ResyncByte:
+			n = 1
+		case ResyncStop:
+			d.err = io.EOF
+		}
+		if n < 1 {
+			n = 1
+		}
+		inst.Len = n
+	}
+	raw = d.code[:n:n]
+	d.code = d.code[n:]
+	d.pc += uint64(n)
+	return pc, inst, raw, err
+}
+
+// fill reads more code from d.r, if there is any,
+// so that d.code holds at least one full instruction.
+func (d *Disassembler) fill() error {
+	if d.r == nil || len(d.code) >= 15 || d.roff >= d.size {
+		return nil
+	}
+	n := copy(d.buf, d.code)
+	m := len(d.buf) - n
+	if int64(m) > d.size-d.roff {
+		m = int(d.size - d.roff)
+	}
+	k, err := d.r.ReadAt(d.buf[n:n+m], d.roff)
+	d.roff += int64(k)
+	d.code = d.buf[:n+k]
+	if k == m {
+		return nil
+	}
+	if err == nil || err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
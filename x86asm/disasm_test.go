@@ -0,0 +1,108 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+var disasmTests = []struct {
+	code   string
+	resync Resync
+	end    uint64
+	out    string
+}{
+	{"", ResyncByte, 0, ""},
+	{"5590c3", ResyncByte, 0, "1000 55 push ebp; 1001 90 nop; 1002 c3 ret"},
+	{"5590c3", ResyncByte, 0x1002, "1000 55 push ebp; 1001 90 nop"},
+	{"5590c3", ResyncByte, 0x1001, "1000 55 push ebp"},
+	{"0f0b0fff90", ResyncByte, 0, "1000 0f0b ud2; 1002 0f error: unknown opcode; 1003 ff error: truncated; 1004 90 nop"},
+	{"0f0b0fff90", ResyncDecoded, 0, "1000 0f0b ud2; 1002 0fff error: unknown opcode; 1004 90 nop"},
+	{"0f0b0fff90", ResyncStop, 0, "1000 0f0b ud2; 1002 0fff error: unknown opcode"},
+	{"e8", ResyncByte, 0, "1000 e8 error: truncated"},
+	{"e8", ResyncDecoded, 0, "1000 e8 error: truncated"},
+}
+
+// disasmText returns the sweep by d, in the form used by disasmTests.
+func disasmText(d *Disassembler) string {
+	var out []string
+	for {
+		pc, inst, raw, err := d.Next()
+		if err == io.EOF {
+			break
+		}
+		text := ""
+		if err != nil {
+			text = "error: " + err.(*DecodeError).Reason.String()
+		} else {
+			text = IntelSyntax(inst, pc, nil)
+		}
+		if inst.Len != len(raw) {
+			text += fmt.Sprintf(" [Len=%d]", inst.Len)
+		}
+		out = append(out, fmt.Sprintf("%x %x %s", pc, raw, text))
+	}
+	return strings.Join(out, "; ")
+}
+
+func TestDisassembler(t *testing.T) {
+	for _, tt := range disasmTests {
+		code, err := hex.DecodeString(tt.code)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d := NewDisassembler(code, 0x1000, 32)
+		d.Resync, d.End = tt.resync, tt.end
+		if out := disasmText(d); out != tt.out {
+			t.Errorf("Disassembler(%s, %v, %#x):\nhave %s\nwant %s", tt.code, tt.resync, tt.end, out, tt.out)
+		}
+		d = NewReaderDisassembler(bytes.NewReader(code), int64(len(code)), 0x1000, 32)
+		d.Resync, d.End = tt.resync, tt.end
+		if out := disasmText(d); out != tt.out {
+			t.Errorf("ReaderDisassembler(%s, %v, %#x):\nhave %s\nwant %s", tt.code, tt.resync, tt.end, out, tt.out)
+		}
+	}
+}
+
+// TestReaderDisassembler checks that reading code in buffered pieces
+// gives the same sweep as decoding it in place, across many buffer refills.
+func TestReaderDisassembler(t *testing.T) {
+	code := bytes.Join(benchInsts(t, 64), nil)
+	want := disasmText(NewDisassembler(code, 0, 64))
+	have := disasmText(NewReaderDisassembler(bytes.NewReader(code), int64(len(code)), 0, 64))
+	if have != want {
+		t.Errorf("NewReaderDisassembler and NewDisassembler disagree")
+	}
+
+	// A short read ends the sweep with io.ErrUnexpectedEOF.
+	d := NewReaderDisassembler(bytes.NewReader(code[:100]), int64(len(code)), 0, 64)
+	var err error
+	for err == nil {
+		_, _, _, err = d.Next()
+	}
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("short read: Next returned %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+	if _, _, _, err2 := d.Next(); err2 != err {
+		t.Errorf("after short read: Next returned %v, want %v", err2, err)
+	}
+}
+
+// TestDisassemblerInvalidMode checks that an error that is not
+// about the code, like ErrInvalidMode, is returned once and ends the sweep.
+func TestDisassemblerInvalidMode(t *testing.T) {
+	d := NewDisassembler([]byte{0x90, 0x90}, 0x1000, 8)
+	if pc, _, raw, err := d.Next(); pc != 0x1000 || raw != nil || err != ErrInvalidMode {
+		t.Errorf("Next = %#x, %x, %v, want 0x1000, nil, %v", pc, raw, err, ErrInvalidMode)
+	}
+	if pc, _, _, err := d.Next(); pc != 0x1000 || err != io.EOF {
+		t.Errorf("after ErrInvalidMode: Next = %#x, %v, want 0x1000, %v", pc, err, io.EOF)
+	}
+}
@@ -0,0 +1,1447 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Table-driven encoding of x86 instructions.
+
+package x86asm
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// An encForm describes one encoding of an instruction.
+// The encoder table, generated by x86map -fmt=encoder from the
+// same rows of x86.csv as the decoder table, lists the forms sorted by Op.
+type encForm struct {
+	op       Op
+	vex      uint16     // implied prefix and opcode map, as in xCondVex; 0 for legacy forms
+	prefix   Prefix     // mandatory prefix (0x66, 0xF2, or 0xF3), or 0
+	code     string     // opcode bytes
+	plus     bool       // a register number is added to the final opcode byte
+	modrm    int8       // modrm reg field for /0 through /7, or encSlashR or encNoModRM
+	is64     int8       // 1 if valid only in 64-bit mode, -1 if invalid in 64-bit mode
+	addrSize int8       // required address size, or 0 for any
+	dataSize int8       // required operand size, or 0 for any
+	vexL     int8       // required VEX.L or EVEX.L'L, or -1 for any
+	vexW     int8       // required VEX.W, or -1 for any
+	isMem    int8       // 1 if the modrm r/m field must be memory, -1 if it must be a register
+	read     []decodeOp // immediate values, in encoding order
+	args     []decodeOp // arguments and EVEX decorations
+}
+
+const (
+	encNoModRM = -1 // form has no modrm byte
+	encSlashR  = 8  // modrm reg field holds a register argument (/r)
+)
+
+// The one-byte NOP and PAUSE are special cases in Decode,
+// so they are special cases here too.
+var (
+	encNOP   = encForm{op: NOP, code: "\x90", modrm: encNoModRM}
+	encPAUSE = encForm{op: PAUSE, prefix: 0xF3, code: "\x90", modrm: encNoModRM}
+)
+
+// ErrNoEncoding is the error returned by Encode
+// for an instruction that has no valid encoding.
+var ErrNoEncoding = errors.New("no encoding for instruction")
+
+// An Encoder encodes instructions as machine code.
+type Encoder struct {
+	// Vendor selects the processor vendor whose decoding rules
+	// the encoding must follow, as in Decoder.
+	Vendor Vendor
+
+	// Exact causes Encode to reproduce the original encoding
+	// of an instruction returned by Decode, as recorded in its
+	// Prefix, Opcode, and Len fields, instead of choosing the
+	// shortest encoding. If the instruction has been modified
+	// so that no encoding reproduces it exactly, or if Decode
+	// skipped bytes that the instruction does not record, like
+	// the displacement of a MOV to or from a control register
+	// with the modrm mod bits set for memory, Encode returns
+	// an error. If the instruction did not come from Decode
+	// (Len is 0), Encode makes the default choice.
+	Exact bool
+}
+
+// Encode returns the machine code encoding of inst,
+// using the default Encoder options.
+// See Encoder.Encode for details.
+func Encode(inst Inst) ([]byte, error) {
+	var e Encoder
+	return e.Encode(inst)
+}
+
+// Encode returns the machine code encoding of inst in the processor
+// mode inst.Mode, which must be 16, 32, or 64.
+//
+// The encoding is chosen from the forms in x86.csv that decode as
+// an instruction with the same Op, Args, EVEX decorations, and
+// explicit (non-implicit) prefixes as inst. Prefixes implied by the
+// chosen form, like operand size overrides, are added automatically.
+// If inst.Prefix lists a VEX2, VEX3, or EVEX prefix, the encoding
+// uses that kind of prefix, even when a shorter one would do.
+// If inst.MemBytes is set, the encoding must access that many bytes
+// of memory. Encode prefers encodings that decode with the operand
+// and address sizes inst.DataSize and inst.AddrSize, or the default
+// sizes for the mode if those are unset, and otherwise chooses the
+// shortest encoding.
+//
+// INT 3 is encoded as CD 03 unless inst.Opcode records the one-byte
+// INT3 (CC), as Decode does: the two behave differently in
+// virtual-8086 mode even though they decode with the same arguments.
+//
+// Arguments are interpreted as Decode would return them.
+// In particular, Rel arguments and RIP-relative memory
+// displacements are relative to the end of the new encoding.
+// A displacement is encoded so that Decode returns the same
+// value when possible: a 16-bit displacement like 0xff8c
+// takes two bytes, not the one byte of the equivalent -0x74.
+func (e *Encoder) Encode(inst Inst) ([]byte, error) {
+	switch inst.Mode {
+	case 16, 32, 64:
+		// ok
+	default:
+		return nil, ErrInvalidMode
+	}
+
+	s := encState{
+		d:     Decoder{Mode: inst.Mode, Vendor: e.Vendor},
+		inst:  &inst,
+		exact: e.Exact && inst.Len > 0,
+	}
+	s.run()
+	if s.best == nil {
+		if s.exact {
+			return nil, fmt.Errorf("%w: cannot reproduce %v", ErrNoEncoding, inst)
+		}
+		return nil, fmt.Errorf("%w: %v", ErrNoEncoding, inst)
+	}
+	return s.best, nil
+}
+
+// encodings returns every encoding of inst that Encode considers,
+// best first, so that the first is the one Encode returns.
+// Unlike Encode, it lists both INT3 and INT 3 for INT 3.
+func encodings(inst Inst) [][]byte {
+	switch inst.Mode {
+	case 16, 32, 64:
+		// ok
+	default:
+		return nil
+	}
+	s := encState{
+		d:    Decoder{Mode: inst.Mode},
+		inst: &inst,
+		all:  true,
+	}
+	s.run()
+	sort.SliceStable(s.encs, func(i, j int) bool {
+		x, y := &s.encs[i], &s.encs[j]
+		if x.score != y.score {
+			return x.score > y.score
+		}
+		return len(x.enc) < len(y.enc)
+	})
+	var out [][]byte
+	seen := map[string]bool{}
+	for _, e := range s.encs {
+		if !seen[string(e.enc)] {
+			seen[string(e.enc)] = true
+			out = append(out, e.enc)
+		}
+	}
+	return out
+}
+
+// encState holds the state of a single call to Encode.
+// Every candidate encoding is checked by decoding it,
+// so that the result always decodes back to the instruction.
+type encState struct {
+	d     Decoder
+	inst  *Inst
+	exact bool   // reproduce inst exactly
+	all   bool   // record every encoding in encs
+	best  []byte // best encoding so far
+	score int    // preference score of best
+	done  bool   // exact encoding found
+	encs  []scoredEnc
+	buf   []byte
+}
+
+// A scoredEnc is an encoding with its preference score.
+type scoredEnc struct {
+	enc   []byte
+	score int
+}
+
+// encFields holds the instruction fields computed
+// from the arguments of an instruction for one form.
+type encFields struct {
+	args     Args   // arguments as Decode would return them
+	anyArgs  bool   // implicit string operation arguments left unspecified
+	reg      int    // modrm reg field register number
+	haveReg  bool   // reg is set
+	rm       int    // modrm r/m register number, if !haveMem
+	mem      Mem    // memory argument
+	haveMem  bool   // mem is set
+	moffs    bool   // mem is a moffs (cm) argument
+	vsib     bool   // mem uses a vector index register
+	memAddr  bool   // the instruction uses the address size
+	addr     int    // address size
+	dispMask uint64 // significant bits of mem.Disp
+	seg      Reg    // segment override
+	stringDS bool   // mem is the DS operand of a string operation, using the default segment
+	opreg    int    // register number added to the final opcode byte
+	vvvv     int    // VEX.vvvv register number
+	is4      int    // register number in imm8[7:4], or -1
+	imm      int64  // iw, id, or io immediate
+	imm8     int64  // ib immediate
+	immc     int64  // cb, cw, cd, cp, or cm value
+	needREX  bool   // an argument requires a REX prefix (SPL, BPL, SIL, DIL)
+	noREX    bool   // an argument forbids a REX prefix (AH, CH, DH, BH)
+	aaa      int    // EVEX.aaa opmask register number
+	z        bool   // EVEX.z zeroing-masking
+	bcst     bool   // EVEX.b as broadcast
+	round    bool   // EVEX.b as rounding control or exception suppression
+	rc       int    // EVEX.L'L rounding control
+	opsize   bool   // add an operand size prefix not called for by the form
+}
+
+// run tries every form of the instruction.
+func (s *encState) run() {
+	inst := s.inst
+	forms := encForms(inst.Op)
+	switch {
+	case inst.Op == NOP && inst.Args[0] == nil:
+		forms = []encForm{encNOP}
+	case inst.Op == PAUSE:
+		forms = []encForm{encPAUSE}
+	}
+	for i := range forms {
+		f := &forms[i]
+		x, ok := s.place(f)
+		if !ok {
+			continue
+		}
+		s.tryForm(f, &x)
+		if s.done {
+			return
+		}
+	}
+}
+
+// encForms returns the encoder table entries for op.
+func encForms(op Op) []encForm {
+	i := sort.Search(len(encoder), func(i int) bool {
+		return encoder[i].op >= op
+	})
+	j := i
+	for j < len(encoder) && encoder[j].op == op {
+		j++
+	}
+	return encoder[i:j]
+}
+
+// defaultAddrSize returns the address size used without a 0x67 prefix.
+func defaultAddrSize(mode int) int {
+	return mode
+}
+
+// place computes the instruction fields for encoding
+// the arguments of s.inst using form f.
+func (s *encState) place(f *encForm) (x encFields, ok bool) {
+	inst := s.inst
+	mode := inst.Mode
+	if f.is64 > 0 && mode != 64 || f.is64 < 0 && mode == 64 {
+		return x, false
+	}
+	if f.dataSize == 64 && mode != 64 {
+		return x, false
+	}
+	if inst.Op == INT && !s.all && (f.code == "\xCC") != (inst.Opcode>>24 == 0xCC) {
+		return x, false
+	}
+
+	x.args = inst.Args
+	x.is4 = -1
+	x.addr = inst.AddrSize
+	if x.addr == 0 {
+		x.addr = defaultAddrSize(mode)
+	}
+	x.dispMask = 1<<32 - 1
+
+	// The string instructions have implicit arguments
+	// from which we need only the address size and segment.
+	args := inst.Args
+	if seg := stringSeg(inst.Op); seg >= -1 {
+		if !s.placeString(&x, seg) {
+			return x, false
+		}
+		args = Args{}
+	}
+
+	narg := 0
+	next := func() Arg {
+		if narg >= len(args) {
+			return nil
+		}
+		a := args[narg]
+		narg++
+		return a
+	}
+
+	var maskUsed, zUsed, bcstUsed, roundUsed bool
+	for _, op := range f.args {
+		// EVEX decorations modify the preceding argument.
+		switch op {
+		case xArgMask:
+			if inst.Mask != 0 {
+				n, ok := regIndex(inst.Mask, K0, 8)
+				if !ok || n == 0 {
+					return x, false
+				}
+				x.aaa = n
+			}
+			maskUsed = true
+			continue
+		case xArgZ:
+			x.z = inst.Zero
+			zUsed = true
+			continue
+		case xArgM32bcst, xArgM64bcst:
+			x.bcst = inst.Bcst != 0
+			bcstUsed = true
+			continue
+		case xArgER:
+			if RoundNearest <= inst.Round && inst.Round <= RoundZero {
+				x.round = true
+				x.rc = int(inst.Round - RoundNearest)
+			}
+			roundUsed = true
+			continue
+		case xArgSAE:
+			if inst.Round == RoundSAE {
+				x.round = true
+			}
+			roundUsed = true
+			continue
+		}
+
+		argIndex := narg
+		a := next()
+		if a == nil {
+			return x, false
+		}
+		switch op {
+		default:
+			return x, false
+
+		case xArg1,
+			xArg3,
+			xArgAL,
+			xArgAX,
+			xArgCL,
+			xArgCS,
+			xArgDS,
+			xArgDX,
+			xArgEAX,
+			xArgEDX,
+			xArgES,
+			xArgFS,
+			xArgGS,
+			xArgRAX,
+			xArgRDX,
+			xArgSS,
+			xArgST,
+			xArgXMM0:
+			if a != fixedArg[op] {
+				return x, false
+			}
+
+		case xArgImm8, xArgImm8u, xArgImm16, xArgImm16u, xArgImm32, xArgImm64:
+			v, ok := a.(Imm)
+			if !ok {
+				return x, false
+			}
+			v, ok = encImm(op, v, f.dataSize)
+			if !ok {
+				return x, false
+			}
+			x.args[argIndex] = v
+			if op == xArgImm8 || op == xArgImm8u {
+				x.imm8 = int64(v)
+			} else {
+				x.imm = int64(v)
+			}
+
+		case xArgRel8, xArgRel16, xArgRel32:
+			v, ok := a.(Rel)
+			if !ok {
+				return x, false
+			}
+			x.immc = int64(v)
+
+		case xArgPtr16colon16, xArgPtr16colon32:
+			seg, ok1 := a.(Imm)
+			off, ok2 := next().(Imm)
+			if !ok1 || !ok2 {
+				return x, false
+			}
+			if op == xArgPtr16colon16 {
+				x.immc = int64(seg)<<16 | int64(off)&(1<<16-1)
+			} else {
+				x.immc = int64(seg)<<32 | int64(off)&(1<<32-1)
+			}
+
+		case xArgMoffs8, xArgMoffs16, xArgMoffs32, xArgMoffs64:
+			m, ok := a.(Mem)
+			if !ok || m.Base != 0 || m.Index != 0 {
+				return x, false
+			}
+			x.mem, x.haveMem, x.moffs, x.memAddr = m, true, true, true
+			x.seg = m.Segment
+			if mode == 16 && !fits16(m.Disp) {
+				x.addr = 32
+			}
+			switch x.addr {
+			case 16:
+				if !fits16(m.Disp) {
+					return x, false
+				}
+				x.dispMask = 1<<16 - 1
+			case 32:
+				if m.Disp < -1<<31 || m.Disp >= 1<<32 {
+					return x, false
+				}
+			case 64:
+				x.dispMask = 1<<64 - 1
+			}
+			x.immc = m.Disp
+
+		case xArgM,
+			xArgM128,
+			xArgM256,
+			xArgM512,
+			xArgM1428byte,
+			xArgM16,
+			xArgM16and16,
+			xArgM16and32,
+			xArgM16and64,
+			xArgM16colon16,
+			xArgM16colon32,
+			xArgM16colon64,
+			xArgM16int,
+			xArgM2byte,
+			xArgM32,
+			xArgM32and32,
+			xArgM32fp,
+			xArgM32int,
+			xArgM512byte,
+			xArgM64,
+			xArgM64fp,
+			xArgM64int,
+			xArgM8,
+			xArgM80bcd,
+			xArgM80dec,
+			xArgM80fp,
+			xArgM94108byte,
+			xArgMem:
+			if !x.placeMem(a, mode) {
+				return x, false
+			}
+
+		case xArgVm32x, xArgVm32y, xArgVm32z, xArgVm64x, xArgVm64y, xArgVm64z:
+			m, ok := a.(Mem)
+			if !ok {
+				return x, false
+			}
+			if _, ok := regIndex(m.Index, baseReg[op], 32); !ok {
+				return x, false
+			}
+			x.vsib = true
+			if !x.placeMem(a, mode) {
+				return x, false
+			}
+
+		case xArgR8, xArgR16, xArgR32, xArgR64, xArgXmm, xArgDR0dashDR7,
+			xArgXmm1, xArgYmm1, xArgZmm1, xArgK1, xArgMm, xArgMm1, xArgTR0dashTR7,
+			xArgCR0dashCR7, xArgSreg:
+			n, ok := x.placeReg(a, op)
+			if !ok {
+				return x, false
+			}
+			x.reg, x.haveReg = n, true
+
+		case xArgRM8, xArgRM16, xArgRM32, xArgRM64, xArgR32M16, xArgR32M8, xArgR64M16,
+			xArgMmM32, xArgMmM64, xArgMm2M64,
+			xArgXmm2M8, xArgXmm2M16, xArgXmm2M32, xArgXmm2M64, xArgXmmM64, xArgXmmM128, xArgXmmM32, xArgXmm2M128,
+			xArgYmm2M256, xArgZmm2M512, xArgK2M8, xArgK2M16, xArgK2M32, xArgK2M64:
+			if _, ok := a.(Mem); ok {
+				if !x.placeMem(a, mode) {
+					return x, false
+				}
+				break
+			}
+			n, ok := x.placeReg(a, op)
+			if !ok {
+				return x, false
+			}
+			x.rm = n
+
+		case xArgRmf16, xArgRmf32, xArgRmf64, xArgMm2, xArgXmm2, xArgYmm2, xArgZmm2, xArgK2:
+			n, ok := x.placeReg(a, op)
+			if !ok {
+				return x, false
+			}
+			x.rm = n
+
+		case xArgR8op, xArgR16op, xArgR32op, xArgR64op, xArgSTi:
+			n, ok := x.placeReg(a, op)
+			if !ok {
+				return x, false
+			}
+			x.opreg = n
+
+		case xArgR32V, xArgR64V, xArgXmmV, xArgYmmV, xArgZmmV, xArgKV:
+			n, ok := x.placeReg(a, op)
+			if !ok {
+				return x, false
+			}
+			x.vvvv = n
+
+		case xArgXmmIH, xArgYmmIH:
+			n, ok := x.placeReg(a, op)
+			if !ok {
+				return x, false
+			}
+			x.is4 = n
+		}
+	}
+	if narg < len(args) && args[narg] != nil {
+		return x, false
+	}
+
+	// The instruction's decorations must all have been used.
+	if inst.Mask != 0 && !maskUsed ||
+		inst.Zero && (!zUsed || inst.Mask == 0) ||
+		inst.Bcst != 0 && (!bcstUsed || !x.haveMem) ||
+		inst.Round != 0 && (!roundUsed || x.haveMem) {
+		return x, false
+	}
+
+	if f.isMem > 0 && !x.haveMem || f.isMem < 0 && x.haveMem {
+		return x, false
+	}
+	if f.addrSize != 0 {
+		if x.memAddr && x.addr != int(f.addrSize) {
+			return x, false
+		}
+		x.addr = int(f.addrSize)
+		x.memAddr = true
+	}
+	switch x.addr {
+	case 16:
+		if mode == 64 {
+			return x, false
+		}
+	case 32:
+	case 64:
+		if mode != 64 {
+			return x, false
+		}
+	default:
+		return x, false
+	}
+	return x, true
+}
+
+// stringSeg returns the index of the argument of the string
+// operation op that uses the default DS segment, -1 if op is a
+// string operation with no such argument, or -2 for other ops.
+func stringSeg(op Op) int {
+	switch op {
+	case MOVSB, MOVSW, MOVSD, MOVSQ,
+		LODSB, LODSW, LODSD, LODSQ,
+		OUTSB, OUTSW, OUTSD:
+		return 1
+	case CMPSB, CMPSW, CMPSD, CMPSQ, XLATB:
+		return 0
+	case INSB, INSW, INSD,
+		STOSB, STOSW, STOSD, STOSQ,
+		SCASB, SCASW, SCASD, SCASQ:
+		return -1
+	}
+	return -2
+}
+
+// placeString sets the address size and segment override for
+// a string operation from its implicit memory arguments.
+// The argument with index seg uses the default DS segment.
+func (s *encState) placeString(x *encFields, seg int) bool {
+	if x.args == (Args{}) {
+		x.anyArgs = true
+		return true
+	}
+	x.memAddr = true
+	addr := 0
+	for i, a := range x.args {
+		m, ok := a.(Mem)
+		if !ok {
+			continue
+		}
+		n := regBits(m.Base)
+		if n == 0 || addr != 0 && n != addr {
+			return false
+		}
+		addr = n
+		if i == seg {
+			if m.Segment == DS {
+				x.stringDS = true
+			} else {
+				x.seg = m.Segment
+			}
+		}
+	}
+	if addr != 0 {
+		x.addr = addr
+	}
+	return true
+}
+
+// regBits returns the size in bits of the general-purpose
+// or instruction pointer register r, or 0 for other registers.
+func regBits(r Reg) int {
+	switch {
+	case AX <= r && r <= R15W, r == IP:
+		return 16
+	case EAX <= r && r <= R15L, r == EIP:
+		return 32
+	case RAX <= r && r <= R15, r == RIP:
+		return 64
+	}
+	return 0
+}
+
+// regIndex returns the number of register r in the
+// n registers starting at base.
+func regIndex(r, base Reg, n int) (int, bool) {
+	if base <= r && r < base+Reg(n) {
+		return int(r - base), true
+	}
+	return 0, false
+}
+
+// placeReg returns the register number of the register argument a
+// for the decoder argument op, recording any REX requirements in x.
+func (x *encFields) placeReg(a Arg, op decodeOp) (int, bool) {
+	r, ok := a.(Reg)
+	if !ok {
+		return 0, false
+	}
+	switch op {
+	case xArgR8, xArgRM8, xArgR8op:
+		switch {
+		case AL <= r && r <= BL:
+			return int(r - AL), true
+		case AH <= r && r <= BH:
+			x.noREX = true
+			return int(r-AH) + 4, true
+		case SPB <= r && r <= DIB:
+			x.needREX = true
+			return int(r-SPB) + 4, true
+		case R8B <= r && r <= R15B:
+			return int(r-R8B) + 8, true
+		}
+		return 0, false
+	case xArgSreg:
+		return regIndex(r, ES, 6)
+	case xArgCR0dashCR7:
+		return regIndex(r, CR0, 16)
+	case xArgK1, xArgK2, xArgKV, xArgK2M8, xArgK2M16, xArgK2M32, xArgK2M64,
+		xArgMm, xArgMm1, xArgMm2, xArgMmM32, xArgMmM64, xArgMm2M64,
+		xArgSTi, xArgTR0dashTR7:
+		return regIndex(r, baseReg[op], 8)
+	case xArgXmm1, xArgYmm1, xArgZmm1,
+		xArgXmm2, xArgYmm2, xArgZmm2,
+		xArgXmm2M8, xArgXmm2M16, xArgXmm2M32, xArgXmm2M64, xArgXmm2M128, xArgYmm2M256, xArgZmm2M512,
+		xArgXmmV, xArgYmmV, xArgZmmV:
+		return regIndex(r, baseReg[op], 32)
+	}
+	return regIndex(r, baseReg[op], 16)
+}
+
+// placeMem records the memory argument a.
+func (x *encFields) placeMem(a Arg, mode int) bool {
+	m, ok := a.(Mem)
+	if !ok || x.haveMem {
+		return false
+	}
+	x.mem, x.haveMem, x.memAddr = m, true, true
+	x.seg = m.Segment
+	if m.Base == RIP || m.Base == EIP {
+		x.addr = regBits(m.Base)
+	} else if n := regBits(m.Base); n != 0 {
+		x.addr = n
+	} else if n := regBits(m.Index); n != 0 {
+		x.addr = n
+	} else if mode == 16 && !fits16(m.Disp) {
+		x.addr = 32
+	}
+	if x.addr == 16 {
+		x.dispMask = 1<<16 - 1
+	}
+	return true
+}
+
+// fits16 reports whether the displacement d fits in 16 bits,
+// signed or unsigned. An absolute address that does not fit
+// calls for a 32-bit address size in 16-bit mode.
+func fits16(d int64) bool {
+	return -1<<15 <= d && d < 1<<16
+}
+
+// encImm returns the value v of an immediate argument for the
+// decoder argument op as Decode would return it, accepting
+// values that differ only in the sign of the encoded bits.
+func encImm(op decodeOp, v Imm, dataSize int8) (Imm, bool) {
+	switch op {
+	case xArgImm8:
+		if -1<<7 <= v && v < 1<<7 {
+			return v, true
+		}
+	case xArgImm8u:
+		if -1<<7 <= v && v < 1<<8 {
+			return Imm(uint8(v)), true
+		}
+	case xArgImm16:
+		if -1<<15 <= v && v < 1<<16 {
+			return Imm(int16(v)), true
+		}
+	case xArgImm16u:
+		if -1<<15 <= v && v < 1<<16 {
+			return Imm(uint16(v)), true
+		}
+	case xArgImm32:
+		// A 32-bit immediate is sign-extended for a 64-bit operation.
+		if -1<<31 <= v && v < 1<<31 || dataSize != 64 && 0 <= v && v < 1<<32 {
+			return Imm(int32(v)), true
+		}
+	case xArgImm64:
+		return v, true
+	}
+	return 0, false
+}
+
+// A memEnc is one encoding of a memory argument:
+// the modrm mod and r/m fields, the SIB byte, and the displacement.
+type memEnc struct {
+	mod     int
+	rm      int
+	sib     int   // SIB byte, or -1 for none
+	base    int   // base register number, or -1
+	index   int   // index register number, or -1
+	disp    int64 // displacement, scaled for EVEX disp8*N
+	dispLen int
+}
+
+// memEncodings returns the possible encodings of
+// the memory argument m with address size addr.
+func memEncodings(m Mem, addr, mode int, vsib, evex bool) []memEnc {
+	if addr == 16 {
+		return memEncodings16(m)
+	}
+	if m.Disp < -1<<31 || m.Disp >= 1<<32 {
+		return nil
+	}
+	disp := int64(int32(m.Disp))
+	if m.Base == RIP || m.Base == EIP {
+		if mode != 64 || m.Index != 0 {
+			return nil
+		}
+		return []memEnc{{mod: 0, rm: 5, sib: -1, base: -1, index: -1, disp: disp, dispLen: 4}}
+	}
+
+	base, index := -1, -1
+	if m.Base != 0 {
+		n, ok := regIndex(m.Base, baseRegForBits(addr), 16)
+		if !ok {
+			return nil
+		}
+		base = n
+	}
+	scale := 0
+	if m.Index != 0 {
+		if vsib {
+			for _, r := range []Reg{X0, Y0, Z0} {
+				if n, ok := regIndex(m.Index, r, 32); ok {
+					index = n
+				}
+			}
+		} else {
+			n, ok := regIndex(m.Index, baseRegForBits(addr), 16)
+			if !ok || n == 4 {
+				// Index 4 (without REX.X) means no index.
+				return nil
+			}
+			index = n
+		}
+	}
+	switch m.Scale {
+	case 0, 1:
+		scale = 0
+	case 2:
+		scale = 1
+	case 4:
+		scale = 2
+	case 8:
+		scale = 3
+	default:
+		return nil
+	}
+	// A scale without an index, as decoded from a SIB byte
+	// with no index and printed by GNUSyntax as (%esi,%eiz,1),
+	// calls for a SIB byte.
+	needSIB := m.Index == 0 && m.Scale != 0
+	if vsib && index < 0 {
+		return nil
+	}
+	sibIndex := 4
+	if index >= 0 {
+		sibIndex = index & 7
+	}
+
+	var out []memEnc
+	if base < 0 {
+		// In 64-bit mode, mod=0 rm=5 is RIP-relative,
+		// so an absolute address needs a SIB byte.
+		if index < 0 && !vsib && mode != 64 && !needSIB {
+			out = append(out, memEnc{mod: 0, rm: 5, sib: -1, base: -1, index: -1, disp: disp, dispLen: 4})
+		}
+		sib := scale<<6 | sibIndex<<3 | 5
+		out = append(out, memEnc{mod: 0, rm: 4, sib: sib, base: -1, index: index, disp: disp, dispLen: 4})
+		return out
+	}
+
+	// The possible displacements: none, 8 bits, or 32 bits.
+	// EVEX scales an 8-bit displacement by the size of the memory
+	// access, which depends on the form, so try every scale.
+	type dispEnc struct {
+		mod  int
+		disp int64
+		size int
+	}
+	var disps []dispEnc
+	if disp == 0 && base&7 != 5 {
+		disps = append(disps, dispEnc{0, 0, 0})
+	}
+	for n := int64(1); n <= 64; n *= 2 {
+		// Decode returns a 32-bit displacement unsigned,
+		// so an 8-bit one only decodes the same if it is the
+		// value of m.Disp, not merely its sign extension.
+		if disp == m.Disp && disp%n == 0 && -1<<7 <= disp/n && disp/n < 1<<7 {
+			disps = append(disps, dispEnc{1, disp / n, 1})
+		}
+		if !evex {
+			break
+		}
+	}
+	disps = append(disps, dispEnc{2, disp, 4})
+
+	for _, d := range disps {
+		if index < 0 && !vsib && base&7 != 4 && !needSIB {
+			out = append(out, memEnc{mod: d.mod, rm: base & 7, sib: -1, base: base, index: -1, disp: d.disp, dispLen: d.size})
+		}
+		sib := scale<<6 | sibIndex<<3 | base&7
+		out = append(out, memEnc{mod: d.mod, rm: 4, sib: sib, base: base, index: index, disp: d.disp, dispLen: d.size})
+	}
+	return out
+}
+
+// memEncodings16 returns the possible encodings of
+// the memory argument m with 16-bit addressing.
+func memEncodings16(m Mem) []memEnc {
+	if !fits16(m.Disp) {
+		return nil
+	}
+	disp := int64(int16(m.Disp))
+	if m.Base == 0 && m.Index == 0 {
+		return []memEnc{{mod: 0, rm: 6, sib: -1, base: -1, index: -1, disp: disp, dispLen: 2}}
+	}
+	if m.Index != 0 && m.Scale > 1 {
+		return nil
+	}
+	rm := -1
+	for i, a := range addr16 {
+		if a.Base == m.Base && a.Index == m.Index {
+			rm = i
+		}
+	}
+	if rm < 0 {
+		return nil
+	}
+	var out []memEnc
+	if disp == 0 && rm != 6 {
+		out = append(out, memEnc{mod: 0, rm: rm, sib: -1, base: -1, index: -1})
+	}
+	if disp == m.Disp && -1<<7 <= disp && disp < 1<<7 {
+		out = append(out, memEnc{mod: 1, rm: rm, sib: -1, base: -1, index: -1, disp: disp, dispLen: 1})
+	}
+	out = append(out, memEnc{mod: 2, rm: rm, sib: -1, base: -1, index: -1, disp: disp, dispLen: 2})
+	return out
+}
+
+// tryForm tries the encodings of the instruction using form f.
+func (s *encState) tryForm(f *encForm, x *encFields) {
+	inst := s.inst
+	mode := inst.Mode
+	evex := f.vex&0x20 != 0
+
+	mems := []memEnc{{sib: -1, base: -1, index: -1}}
+	if x.haveMem && !x.moffs {
+		mems = memEncodings(x.mem, x.addr, mode, x.vsib, evex)
+	}
+
+	// The kind of VEX prefix, if any, is fixed in exact mode
+	// and when inst.Prefix lists one.
+	vexKind := Prefix(0)
+	for _, p := range inst.Prefix {
+		if p == 0 {
+			break
+		}
+		if p.IsVEX() {
+			vexKind = p & 0xFF
+		}
+	}
+	if s.exact || vexKind != 0 {
+		if (vexKind != 0) != (f.vex != 0) || (vexKind == PrefixEVEX) != evex {
+			return
+		}
+	}
+
+	for i := range mems {
+		me := &mems[i]
+		if f.vex == 0 {
+			s.try(s.legacy(f, x, me), x)
+			if s.extraOpsize(f) {
+				x.opsize = true
+				s.try(s.legacy(f, x, me), x)
+				x.opsize = false
+			}
+			continue
+		}
+
+		// Unless the form fixes them, VEX.W and VEX.L
+		// can take any value; in exact mode, vex reproduces
+		// the recorded ones.
+		ws := []int{int(f.vexW)}
+		if f.vexW < 0 {
+			ws = []int{0}
+			if f.dataSize == 64 {
+				ws = []int{1}
+			}
+		}
+		ls := []int{int(f.vexL)}
+		if x.round {
+			// EVEX.L'L holds the rounding control instead,
+			// and the vector length is implicitly 512 bits.
+			if f.vexL >= 0 && f.vexL != 2 {
+				return
+			}
+			ls = []int{x.rc}
+		} else if f.vexL < 0 {
+			ls = []int{0}
+		}
+		for _, w := range ws {
+			for _, l := range ls {
+				s.try(s.vex(f, x, me, vexKind, w, l), x)
+				if s.done {
+					return
+				}
+			}
+		}
+	}
+}
+
+// extraOpsize reports whether to try form f with an operand size
+// prefix that the form does not call for. A few forms, like CALL rel32
+// in 64-bit mode, decode with the requested 16-bit operand size
+// given the prefix, even though their arguments do not change size.
+func (s *encState) extraOpsize(f *encForm) bool {
+	inst := s.inst
+	switch {
+	case s.exact, int(f.dataSize) == inst.DataSize:
+		return false
+	case inst.Mode == 16:
+		return inst.DataSize == 32
+	}
+	return inst.DataSize == 16
+}
+
+// prefixes appends the legacy prefixes for the instruction to s.buf.
+// It returns false if the form cannot be encoded.
+func (s *encState) prefixes(f *encForm, x *encFields) bool {
+	inst := s.inst
+	mode := inst.Mode
+	if s.exact {
+		for _, p := range inst.Prefix {
+			if p == 0 || p.IsVEX() {
+				break
+			}
+			s.buf = append(s.buf, byte(p))
+		}
+		return true
+	}
+
+	// Explicit prefixes from the instruction come first.
+	seg := x.seg
+	for _, p := range inst.Prefix {
+		if p == 0 {
+			break
+		}
+		if p&PrefixImplicit != 0 || p.IsREX() || p.IsVEX() {
+			continue
+		}
+		s.buf = append(s.buf, byte(p))
+		if x.stringDS && segmentPrefix(p) {
+			// The ignored segment prefix would override
+			// the default, so state DS explicitly.
+			seg = DS
+		}
+	}
+
+	switch seg {
+	case 0:
+	case ES, CS, SS, DS:
+		if mode != 64 {
+			s.buf = append(s.buf, byte(segmentToPrefix(seg)))
+		}
+	case FS, GS:
+		s.buf = append(s.buf, byte(segmentToPrefix(seg)))
+	default:
+		return false
+	}
+
+	switch {
+	case f.vex != 0:
+		// VEX.W, not an operand size prefix, selects the operand
+		// size, even in 16-bit mode, and 0x66 may not precede VEX.
+		if x.opsize {
+			return false
+		}
+	case f.dataSize == 16 && mode != 16,
+		f.dataSize == 32 && mode == 16,
+		x.opsize:
+		s.buf = append(s.buf, 0x66)
+	}
+	if x.memAddr && x.addr != defaultAddrSize(mode) {
+		s.buf = append(s.buf, 0x67)
+	}
+	if f.prefix != 0 {
+		s.buf = append(s.buf, byte(f.prefix))
+	}
+	return true
+}
+
+// segmentPrefix reports whether p is a segment override prefix.
+func segmentPrefix(p Prefix) bool {
+	switch p & 0xFF {
+	case PrefixES, PrefixCS, PrefixSS, PrefixDS, PrefixFS, PrefixGS:
+		return true
+	}
+	return false
+}
+
+// segmentToPrefix returns the segment override prefix for segment r.
+func segmentToPrefix(r Reg) Prefix {
+	switch r {
+	case ES:
+		return PrefixES
+	case CS:
+		return PrefixCS
+	case SS:
+		return PrefixSS
+	case DS:
+		return PrefixDS
+	case FS:
+		return PrefixFS
+	case GS:
+		return PrefixGS
+	}
+	return 0
+}
+
+// rexBits returns the REX.R, REX.X, and REX.B bits
+// and the EVEX.R', EVEX.X (for registers), and EVEX.V' bits
+// needed for the register numbers in x and me.
+func rexBits(f *encForm, x *encFields, me *memEnc) (r, xb, b, rr, v int) {
+	if f.modrm == encSlashR {
+		r = x.reg >> 3 & 1
+		rr = x.reg >> 4 & 1
+	}
+	switch {
+	case f.plus:
+		b = x.opreg >> 3 & 1
+	case x.haveMem && !x.moffs:
+		if me.base >= 0 {
+			b = me.base >> 3 & 1
+		}
+		if me.index >= 0 {
+			xb = me.index >> 3 & 1
+			if x.vsib {
+				v = me.index >> 4 & 1
+			}
+		}
+	case f.modrm != encNoModRM:
+		b = x.rm >> 3 & 1
+		xb = x.rm >> 4 & 1
+	}
+	v |= x.vvvv >> 4 & 1
+	return
+}
+
+// legacy returns the encoding of a legacy (non-VEX) form.
+func (s *encState) legacy(f *encForm, x *encFields, me *memEnc) []byte {
+	mode := s.inst.Mode
+	s.buf = s.buf[:0]
+	if !s.prefixes(f, x) {
+		return nil
+	}
+
+	r, xb, b, rr, v := rexBits(f, x, me)
+	if rr != 0 || v != 0 || x.vvvv != 0 || x.rm >= 16 && !x.haveMem {
+		return nil
+	}
+	if !s.exact {
+		if r != 0 && mode != 64 && hasArg(f, xArgCR0dashCR7) {
+			// Outside 64-bit mode, LOCK stands in for REX.R
+			// to select CR8 through CR15.
+			s.buf = append(s.buf, byte(PrefixLOCK))
+			r = 0
+		}
+		rex := Prefix(r<<2 | xb<<1 | b)
+		if f.dataSize == 64 {
+			rex |= PrefixREXW
+		}
+		if rex != 0 || x.needREX {
+			if mode != 64 || x.noREX {
+				return nil
+			}
+			s.buf = append(s.buf, byte(PrefixREX|rex))
+		}
+	}
+
+	s.opcode(f, x, me)
+	return s.buf
+}
+
+// hasArg reports whether form f has an argument decoded by op.
+func hasArg(f *encForm, op decodeOp) bool {
+	for _, a := range f.args {
+		if a == op {
+			return true
+		}
+	}
+	return false
+}
+
+// vex returns the encoding of a VEX or EVEX form
+// using a prefix of the given kind (or the shortest, if kind is 0)
+// and the given VEX.W and VEX.L or EVEX.L'L values.
+func (s *encState) vex(f *encForm, x *encFields, me *memEnc, kind Prefix, w, l int) []byte {
+	mode := s.inst.Mode
+	evex := f.vex&0x20 != 0
+	s.buf = s.buf[:0]
+	if !s.prefixes(f, x) {
+		return nil
+	}
+
+	r, xb, b, rr, v := rexBits(f, x, me)
+	vvvv := x.vvvv & 15
+	if !evex && (rr != 0 || v != 0 || x.rm >= 16 && !x.haveMem) {
+		return nil
+	}
+	if mode != 64 && (r|xb|b|rr|v != 0 || vvvv >= 8) {
+		return nil
+	}
+	if !evex && (x.aaa != 0 || x.z || x.bcst || x.round) {
+		return nil
+	}
+
+	var pp byte
+	switch f.vex >> 8 {
+	case 0x66:
+		pp = 1
+	case 0xF3:
+		pp = 2
+	case 0xF2:
+		pp = 3
+	}
+	m := byte(f.vex & 0x1F)
+	last := byte(w)<<7 | byte(^vvvv&15)<<3 | pp
+
+	if kind == 0 {
+		switch {
+		case evex:
+			kind = PrefixEVEX
+		case m == 1 && w == 0 && xb == 0 && b == 0:
+			kind = PrefixVEX2
+		default:
+			kind = PrefixVEX3
+		}
+	}
+	rxb := byte(^(r<<2|xb<<1|b)&7) << 5
+	if s.exact {
+		// Reproduce the recorded payload, including the bits
+		// that Decode ignores, like VEX.W outside 64-bit mode.
+		n := 1
+		switch kind {
+		case PrefixVEX3:
+			n = 2
+		case PrefixEVEX:
+			n = 3
+		}
+		s.buf = append(s.buf, byte(kind))
+		s.buf = append(s.buf, s.inst.vex[:n]...)
+		s.opcode(f, x, me)
+		return s.buf
+	}
+	switch kind {
+	case PrefixVEX2:
+		if m != 1 || w != 0 || xb != 0 || b != 0 {
+			return nil
+		}
+		s.buf = append(s.buf, byte(PrefixVEX2), rxb&0x80|last&0x7F|byte(l)<<2)
+	case PrefixVEX3:
+		s.buf = append(s.buf, byte(PrefixVEX3), rxb|m, last|byte(l)<<2)
+	case PrefixEVEX:
+		p0 := rxb | byte(^rr&1)<<4 | m
+		p2 := byte(l)<<5 | byte(^v&1)<<3 | byte(x.aaa)
+		if x.z {
+			p2 |= 0x80
+		}
+		if x.bcst || x.round {
+			p2 |= 0x10
+		}
+		s.buf = append(s.buf, byte(PrefixEVEX), p0, last|0x04, p2)
+	}
+
+	s.opcode(f, x, me)
+	return s.buf
+}
+
+// opcode appends the opcode bytes, modrm, SIB, displacement,
+// and immediate values for the instruction to s.buf.
+func (s *encState) opcode(f *encForm, x *encFields, me *memEnc) {
+	inst := s.inst
+	s.buf = append(s.buf, f.code...)
+	if f.plus {
+		s.buf[len(s.buf)-1] += byte(x.opreg & 7)
+	}
+
+	if f.modrm != encNoModRM {
+		reg := int(f.modrm)
+		if f.modrm == encSlashR {
+			reg = x.reg
+		}
+		mod := 3
+		if s.exact && len(f.code) < 4 {
+			// Some forms ignore the mod bits of a register
+			// or the unused reg field; use the original ones,
+			// recorded after the opcode bytes in inst.Opcode.
+			orig := int(inst.Opcode >> uint(24-8*len(f.code)))
+			mod = orig >> 6 & 3
+			if f.modrm == encSlashR && !x.haveReg {
+				reg = orig >> 3 & 7
+			}
+		}
+		if !x.haveMem {
+			s.buf = append(s.buf, byte(mod<<6|(reg&7)<<3|x.rm&7))
+		} else {
+			s.buf = append(s.buf, byte(me.mod<<6|(reg&7)<<3|me.rm))
+			if me.sib >= 0 {
+				s.buf = append(s.buf, byte(me.sib))
+			}
+			s.buf = appendInt(s.buf, me.disp, me.dispLen)
+		}
+	}
+
+	for _, op := range f.read {
+		switch op {
+		case xReadIb:
+			v := byte(x.imm8)
+			if x.is4 >= 0 {
+				v = byte(x.is4<<4) | v&0x0F
+				if s.exact {
+					v = inst.is4
+				}
+			}
+			s.buf = append(s.buf, v)
+		case xReadIw:
+			s.buf = appendInt(s.buf, x.imm, 2)
+		case xReadId:
+			s.buf = appendInt(s.buf, x.imm, 4)
+		case xReadIo:
+			s.buf = appendInt(s.buf, x.imm, 8)
+		case xReadCb:
+			s.buf = appendInt(s.buf, x.immc, 1)
+		case xReadCw:
+			s.buf = appendInt(s.buf, x.immc, 2)
+		case xReadCd:
+			// See the AMD rule in Decode.
+			if s.d.Vendor == VendorAMD && inst.Mode == 64 && f.dataSize == 16 &&
+				(inst.Op == CALL || inst.Op == JMP || isCondJmp[inst.Op]) {
+				s.buf = appendInt(s.buf, x.immc, 2)
+				break
+			}
+			s.buf = appendInt(s.buf, x.immc, 4)
+		case xReadCp:
+			s.buf = appendInt(s.buf, x.immc, 6)
+		case xReadCm:
+			s.buf = appendInt(s.buf, x.immc, x.addr/8)
+		}
+	}
+}
+
+// appendInt appends the n low bytes of v to b, in little-endian order.
+func appendInt(b []byte, v int64, n int) []byte {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(v))
+	return append(b, buf[:n]...)
+}
+
+// try decodes the candidate encoding enc and,
+// if it decodes as the instruction, records it in s.
+func (s *encState) try(enc []byte, x *encFields) {
+	if enc == nil || s.done || len(enc) > 15 {
+		return
+	}
+	got, err := s.d.Decode(enc)
+	if err != nil || got.Len != len(enc) {
+		return
+	}
+	if s.exact {
+		if got == *s.inst {
+			s.best = append([]byte(nil), enc...)
+			s.done = true
+		}
+		return
+	}
+	if !s.matches(&got, x) {
+		return
+	}
+	// Prefer the requested operand and address sizes,
+	// or else the default ones: a shorter encoding using
+	// a 16-bit operand size might truncate a branch target.
+	score := 0
+	wantData, wantAddr := s.inst.DataSize, s.inst.AddrSize
+	if wantData == 0 {
+		wantData = 32
+		if s.inst.Mode == 16 {
+			wantData = 16
+		}
+	}
+	if wantAddr == 0 {
+		wantAddr = defaultAddrSize(s.inst.Mode)
+	}
+	if got.DataSize == wantData {
+		score += 2
+	}
+	if got.AddrSize == wantAddr {
+		score++
+	}
+	if s.all {
+		s.encs = append(s.encs, scoredEnc{append([]byte(nil), enc...), score})
+		return
+	}
+	if s.best == nil || score > s.score || score == s.score && len(enc) < len(s.best) {
+		s.best = append(s.best[:0], enc...)
+		s.score = score
+	}
+}
+
+// matches reports whether the decoded instruction got
+// is the instruction being encoded.
+func (s *encState) matches(got *Inst, x *encFields) bool {
+	inst := s.inst
+	if got.Op != inst.Op ||
+		got.Mask != inst.Mask || got.Zero != inst.Zero ||
+		got.Bcst != inst.Bcst || got.Round != inst.Round ||
+		inst.MemBytes != 0 && got.MemBytes != inst.MemBytes {
+		return false
+	}
+	if !x.anyArgs {
+		for i, a := range x.args {
+			if m, ok := a.(Mem); ok {
+				gm, ok := got.Args[i].(Mem)
+				if !ok || !sameMem(m, gm, inst.Mode, x.dispMask) {
+					return false
+				}
+				continue
+			}
+			if got.Args[i] != a {
+				return false
+			}
+		}
+	}
+	want, have := explicitPrefixes(inst), explicitPrefixes(got)
+	if (x.moffs || !x.haveMem) && x.memAddr && x.addr != defaultAddrSize(inst.Mode) && have != want {
+		// Decode leaves the address size prefix of a moffs argument
+		// or of JCXZ explicit, but Encode added it for x.addr.
+		have = withoutPrefix(have, PrefixAddrSize)
+	}
+	return have == want
+}
+
+// withoutPrefix returns list with the first p removed.
+func withoutPrefix(list Prefixes, p Prefix) Prefixes {
+	for i, q := range list {
+		if q == p {
+			copy(list[i:], list[i+1:])
+			list[len(list)-1] = 0
+			break
+		}
+	}
+	return list
+}
+
+// sameMem reports whether the memory arguments m1 and m2 are the same,
+// comparing only the displacement bits in mask. In 64-bit mode,
+// the ES, CS, SS, and DS segments are the same as no segment.
+func sameMem(m1, m2 Mem, mode int, mask uint64) bool {
+	norm := func(m Mem) Mem {
+		if mode == 64 && ES <= m.Segment && m.Segment <= DS {
+			m.Segment = 0
+		}
+		if m.Index == 0 {
+			m.Scale = 0
+		} else if m.Scale == 0 {
+			m.Scale = 1
+		}
+		m.Disp = int64(uint64(m.Disp) & mask)
+		return m
+	}
+	return norm(m1) == norm(m2)
+}
+
+// explicitPrefixes returns the encodings of the explicit
+// legacy prefixes of inst, the ones that Encode must reproduce.
+func explicitPrefixes(inst *Inst) Prefixes {
+	var list Prefixes
+	n := 0
+	for _, p := range inst.Prefix {
+		if p == 0 {
+			break
+		}
+		if p&PrefixImplicit != 0 || p.IsREX() || p.IsVEX() {
+			continue
+		}
+		list[n] = p & 0xFF
+		n++
+	}
+	return list
+}
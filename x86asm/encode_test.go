@@ -0,0 +1,215 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var encodeTests = []struct {
+	inst Inst
+	code string
+}{
+	{Inst{Mode: 64, Op: ADD, Args: Args{EAX, Imm(1)}}, "83c001"},
+	{Inst{Mode: 64, Op: ADD, Args: Args{RAX, Imm(1000)}}, "4805e8030000"},
+	{Inst{Mode: 64, Op: MOV, Args: Args{AL, Imm(-1)}}, "b0ff"},
+	{Inst{Mode: 64, Op: MOV, Args: Args{RAX, Imm(0x123456789)}}, "48b88967452301000000"},
+	{Inst{Mode: 64, Op: MOV, Args: Args{RAX, Mem{Base: RIP, Disp: 0x10}}}, "488b0510000000"},
+	{Inst{Mode: 64, Op: MOV, Args: Args{R12, Mem{Base: R13, Index: R12, Scale: 8, Disp: -8}}}, "4f8b64e5f8"},
+	{Inst{Mode: 64, Op: MOV, Args: Args{SPB, R9B}}, "4488cc"},
+	{Inst{Mode: 64, Op: MOV, Args: Args{EAX, Mem{Disp: 0x1234}}}, "8b042534120000"},
+	{Inst{Mode: 64, Op: MOV, Args: Args{EAX, Mem{Base: ESP}}}, "678b0424"},
+	{Inst{Mode: 64, Op: MOV, Args: Args{RAX, Mem{Segment: FS, Disp: 0x28}}}, "64488b042528000000"},
+	{Inst{Mode: 64, Op: MOV, Args: Args{CR8, RAX}}, "440f22c0"},
+	{Inst{Mode: 64, Op: PUSH, Args: Args{R15}}, "4157"},
+	{Inst{Mode: 64, Op: PUSH, Args: Args{RAX}, DataSize: 64}, "4850"},
+	{Inst{Mode: 64, Op: JMP, Args: Args{Rel(-2)}}, "ebfe"},
+	{Inst{Mode: 64, Op: JNE, Args: Args{Rel(1000)}}, "0f85e8030000"},
+	{Inst{Mode: 64, Op: JECXZ, Args: Args{Rel(5)}}, "67e305"},
+	{Inst{Mode: 64, Op: NOP}, "90"},
+	{Inst{Mode: 64, Op: PAUSE}, "f390"},
+	{Inst{Mode: 64, Op: XCHG, Args: Args{EAX, EAX}}, "87c0"},
+	{Inst{Mode: 64, Op: STOSQ, Prefix: Prefixes{PrefixREP}}, "f348ab"},
+	{Inst{Mode: 64, Op: ADD, Prefix: Prefixes{PrefixLOCK}, Args: Args{Mem{Base: RDI}, EAX}}, "f00107"},
+	{Inst{Mode: 64, Op: INC, Args: Args{Mem{Base: RDI}}, MemBytes: 8}, "48ff07"},
+	{Inst{Mode: 64, Op: VADDPS, Args: Args{Y1, Y2, Y3}}, "c5ec58cb"},
+	{Inst{Mode: 64, Op: VADDPS, Args: Args{Y1, Y2, Y13}}, "c4c16c58cd"},
+	{Inst{Mode: 64, Op: VADDPS, Args: Args{X1, X2, X3}, Prefix: Prefixes{PrefixEVEX}}, "62f16c0858cb"},
+	{Inst{Mode: 64, Op: CVTTSD2SI, Args: Args{EDX, Mem{Base: RCX}}, DataSize: 16}, "66f20f2c11"},
+	{Inst{Mode: 64, Op: VADDPS, Args: Args{Z1, Z2, Z30}, Mask: K1, Zero: true}, "62916cc958ce"},
+	{Inst{Mode: 64, Op: VADDPS, Args: Args{Z1, Z2, Mem{Base: RAX, Disp: 256}}}, "62f16c48584804"},
+	{Inst{Mode: 64, Op: VADDPS, Args: Args{Z1, Z2, Mem{Base: RAX, Disp: 8}}, Bcst: 16}, "62f16c58584802"},
+	{Inst{Mode: 64, Op: VADDPS, Args: Args{Z1, Z2, Z3}, Round: RoundZero}, "62f16c7858cb"},
+	{Inst{Mode: 64, Op: VPGATHERDD, Args: Args{Z1, Mem{Base: RAX, Index: Z22, Scale: 4}}, Mask: K1}, "62f27d41900cb0"},
+	{Inst{Mode: 64, Op: VBLENDVPD, Args: Args{X0, X1, X2, X7}}, "c4e3714bc270"},
+	{Inst{Mode: 32, Op: MOV, Args: Args{EAX, Mem{Disp: 0x1234}}}, "a134120000"},
+	{Inst{Mode: 32, Op: MOV, Args: Args{EAX, Mem{Segment: ES, Base: EBX}}}, "268b03"},
+	{Inst{Mode: 32, Op: LEA, Args: Args{ESI, Mem{Base: ESI, Scale: 1}}}, "8d3426"},
+	{Inst{Mode: 32, Op: LODSB, Args: Args{AL, Mem{Segment: ES, Base: ESI}}}, "26ac"},
+	{Inst{Mode: 32, Op: JMP, Args: Args{Rel(1000)}}, "e9e8030000"},
+	{Inst{Mode: 32, Op: LJMP, Args: Args{Imm(0x10), Imm(0x1234)}}, "ea341200001000"},
+	{Inst{Mode: 16, Op: MOV, Args: Args{AX, Mem{Base: BP}}}, "8b4600"},
+	{Inst{Mode: 16, Op: MOV, Args: Args{EAX, Mem{Base: BX, Index: SI, Scale: 1, Disp: 2}}}, "668b4002"},
+	{Inst{Mode: 16, Op: JMP, Args: Args{Rel(1000)}}, "e9e803"},
+	{Inst{Mode: 16, Op: MOV, Args: Args{AX, Mem{Base: BX, Disp: 0xff8c}}}, "8b878cff"},
+	{Inst{Mode: 16, Op: MOV, Args: Args{AX, Mem{Base: BX, Disp: -0x74}}}, "8b478c"},
+	{Inst{Mode: 32, Op: MOV, Args: Args{EAX, Mem{Base: EAX, Disp: 0xffffff8c}}}, "8b808cffffff"},
+	{Inst{Mode: 32, Op: MOV, Args: Args{EAX, CR10}}, "f00f20d0"},
+	{Inst{Mode: 64, Op: INT, Args: Args{Imm(3)}}, "cd03"},
+	{Inst{Mode: 64, Op: INT, Args: Args{Imm(3)}, Opcode: 0xCC000000}, "cc"},
+}
+
+func TestEncode(t *testing.T) {
+	for _, tt := range encodeTests {
+		code, err := Encode(tt.inst)
+		if err != nil {
+			t.Errorf("Encode(%v): %v", tt.inst, err)
+			continue
+		}
+		if hex.EncodeToString(code) != tt.code {
+			t.Errorf("Encode(%v) = %x, want %s", tt.inst, code, tt.code)
+		}
+	}
+}
+
+func TestEncodeError(t *testing.T) {
+	for _, inst := range []Inst{
+		{Mode: 64, Op: MOV, Args: Args{SPB, AH}},
+		{Mode: 32, Op: MOV, Args: Args{R8, RAX}},
+		{Mode: 64, Op: ADD, Args: Args{AL, Imm(1000)}},
+		{Mode: 64, Op: VADDPS, Args: Args{X1, X2, X3}, Round: RoundZero},
+	} {
+		if code, err := Encode(inst); !errors.Is(err, ErrNoEncoding) {
+			t.Errorf("Encode(%v) = %x, %v, want ErrNoEncoding", inst, code, err)
+		}
+	}
+	if _, err := Encode(Inst{Op: NOP}); err != ErrInvalidMode {
+		t.Errorf("Encode with mode 0: %v, want ErrInvalidMode", err)
+	}
+
+	// An exact encoding must not differ from the decoded bytes,
+	// so these fail: the decoder skips the SIB byte and displacement
+	// of a MOV to or from a control or debug register.
+	e := Encoder{Exact: true}
+	for _, tt := range []struct {
+		code string
+		mode int
+	}{
+		{"0f20740be6", 32},
+		{"f00f244d5d", 64},
+	} {
+		inst, ok := mustDecodeHex(t, Decoder{Mode: tt.mode}, tt.code)
+		if !ok {
+			continue
+		}
+		if code, err := e.Encode(inst); !errors.Is(err, ErrNoEncoding) {
+			t.Errorf("exact Encode(%v) from %s = %x, %v, want ErrNoEncoding", inst, tt.code, code, err)
+		}
+	}
+}
+
+// encodeDecodeTests are instructions not in testdata/decode.txt
+// to check as TestEncodeDecode does.
+var encodeDecodeTests = []struct {
+	code string
+	mode int
+}{
+	{"c5fb2cc0", 16},     // vcvttsd2si eax, xmm0
+	{"c5cb2ac0", 16},     // vcvtsi2sd xmm0, xmm6, eax
+	{"67c5fb2c00", 16},   // vcvttsd2si eax, qword ptr [eax]
+	{"2e2e8b00", 32},     // mov eax, dword ptr cs:[eax]
+	{"26268b00", 16},     // mov ax, word ptr es:[bx+si]
+	{"6565488b00", 64},   // mov rax, qword ptr gs:[rax]
+	{"263ea4", 32},       // movsb with ignored es and default ds
+	{"3e3ea4", 16},       // movsb with repeated ds
+	{"f3f3a4", 32},       // rep movsb with repeated rep
+	{"26c5f858c0", 32},   // vaddps xmm0, xmm0, xmm0 with ignored es
+	{"8d742600", 32},     // lea with a SIB byte and no index
+	{"cd03", 64},         // int 0x3, not int3
+	{"8b878cff", 16},     // mov ax, word ptr [bx+0xff8c]
+	{"8b808cffffff", 32}, // mov eax, dword ptr [eax+0xffffff8c]
+	{"f0640f2010", 32},   // mov eax, cr10
+	{"c4e1f96ec0", 32},   // vmovd xmm0, eax with ignored VEX.W
+	{"c4c17158c0", 32},   // vaddpd xmm0, xmm1, xmm0 with ignored VEX.B
+	{"62e1fd0858c1", 32}, // vaddpd xmm0, xmm0, xmm1 with ignored EVEX.R'
+	{"c5d75c54acf3", 64}, // vsubsd with ignored VEX.L
+	{"c4e3714bc2f0", 32}, // vblendvpd with ignored is4 bit 7
+	{"c4e3714bc27f", 64}, // vblendvpd with ignored imm8 bits 3:0
+}
+
+// TestEncodeDecode checks that the instructions in testdata/decode.txt
+// and encodeDecodeTests encode as instructions that decode the same way,
+// and that an exact encoding reproduces the decoded instruction in full.
+func TestEncodeDecode(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/decode.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := map[string]bool{}
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.Fields(line)
+		if len(f) < 2 || strings.HasPrefix(line, "#") || seen[f[0]+" "+f[1]] {
+			continue
+		}
+		seen[f[0]+" "+f[1]] = true
+		code, err := hex.DecodeString(strings.Replace(f[0], "|", "", 1))
+		if err != nil {
+			t.Errorf("parsing %q: %v", f[0], err)
+			continue
+		}
+		mode, err := strconv.Atoi(f[1])
+		if err != nil {
+			t.Errorf("invalid mode %q in: %s", f[1], line)
+			continue
+		}
+		testEncodeDecode(t, code, mode)
+	}
+	for _, tt := range encodeDecodeTests {
+		if _, ok := mustDecodeHex(t, Decoder{Mode: tt.mode}, tt.code); !ok {
+			continue
+		}
+		code, _ := hex.DecodeString(tt.code)
+		testEncodeDecode(t, code, tt.mode)
+	}
+}
+
+// testEncodeDecode checks the encodings of the instruction
+// that code decodes as in the given mode, if any.
+func testEncodeDecode(t *testing.T, code []byte, mode int) {
+	inst, err := Decode(code, mode)
+	if err != nil {
+		return
+	}
+	code = code[:inst.Len]
+
+	enc, err := Encode(inst)
+	if err != nil {
+		t.Errorf("Encode(%v) from %x: %v", inst, code, err)
+		return
+	}
+	if len(enc) > len(code) {
+		t.Errorf("Encode(%v) = %x, longer than %x", inst, enc, code)
+	}
+	inst2, err := Decode(enc, mode)
+	if err != nil || IntelSyntax(inst2, 0, nil) != IntelSyntax(inst, 0, nil) {
+		t.Errorf("Encode(%v) = %x, decodes as %v, %v, want %x", inst, enc, inst2, err, code)
+	}
+
+	e := Encoder{Exact: true}
+	enc, err = e.Encode(inst)
+	if err != nil {
+		t.Errorf("exact Encode(%v) from %x: %v", inst, code, err)
+		return
+	}
+	inst2, err = Decode(enc, mode)
+	if err != nil || inst2 != inst {
+		t.Errorf("exact Encode(%v) = %x, decodes as %v, %v, want %x", inst, enc, inst2, err, code)
+	}
+}
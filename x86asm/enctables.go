@@ -0,0 +1,3475 @@
+// DO NOT EDIT
+// generated by: x86map -fmt=encoder ../x86.csv
+
+package x86asm
+
+var encoder = [...]encForm{
+	{op: AAA, code: "\x37", modrm: encNoModRM, is64: -1},
+	{op: AAD, code: "\xd5", modrm: encNoModRM, is64: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgImm8u}},
+	{op: AAM, code: "\xd4", modrm: encNoModRM, is64: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgImm8u}},
+	{op: AAS, code: "\x3f", modrm: encNoModRM, is64: -1},
+	{op: ADC, code: "\x14", modrm: encNoModRM, read: []decodeOp{xReadIb}, args: []decodeOp{xArgAL, xArgImm8u}},
+	{op: ADC, code: "\x15", modrm: encNoModRM, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgAX, xArgImm16}},
+	{op: ADC, code: "\x15", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgEAX, xArgImm32}},
+	{op: ADC, code: "\x15", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRAX, xArgImm32}},
+	{op: ADC, code: "\x81", modrm: 2, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgRM16, xArgImm16}},
+	{op: ADC, code: "\x83", modrm: 2, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8}},
+	{op: ADC, code: "\x11", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: ADC, code: "\x81", modrm: 2, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM32, xArgImm32}},
+	{op: ADC, code: "\x83", modrm: 2, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8}},
+	{op: ADC, code: "\x11", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32}},
+	{op: ADC, code: "\x81", modrm: 2, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM64, xArgImm32}},
+	{op: ADC, code: "\x83", modrm: 2, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8}},
+	{op: ADC, code: "\x11", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64}},
+	{op: ADC, code: "\x80", modrm: 2, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM8, xArgImm8u}},
+	{op: ADC, code: "\x10", modrm: encSlashR, args: []decodeOp{xArgRM8, xArgR8}},
+	{op: ADC, code: "\x13", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: ADC, code: "\x13", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: ADC, code: "\x13", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: ADC, code: "\x12", modrm: encSlashR, args: []decodeOp{xArgR8, xArgRM8}},
+	{op: ADD, code: "\x04", modrm: encNoModRM, read: []decodeOp{xReadIb}, args: []decodeOp{xArgAL, xArgImm8u}},
+	{op: ADD, code: "\x05", modrm: encNoModRM, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgAX, xArgImm16}},
+	{op: ADD, code: "\x05", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgEAX, xArgImm32}},
+	{op: ADD, code: "\x05", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRAX, xArgImm32}},
+	{op: ADD, code: "\x81", modrm: 0, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgRM16, xArgImm16}},
+	{op: ADD, code: "\x83", modrm: 0, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8}},
+	{op: ADD, code: "\x01", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: ADD, code: "\x81", modrm: 0, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM32, xArgImm32}},
+	{op: ADD, code: "\x83", modrm: 0, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8}},
+	{op: ADD, code: "\x01", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32}},
+	{op: ADD, code: "\x81", modrm: 0, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM64, xArgImm32}},
+	{op: ADD, code: "\x83", modrm: 0, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8}},
+	{op: ADD, code: "\x01", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64}},
+	{op: ADD, code: "\x80", modrm: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM8, xArgImm8u}},
+	{op: ADD, code: "\x00", modrm: encSlashR, args: []decodeOp{xArgRM8, xArgR8}},
+	{op: ADD, code: "\x03", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: ADD, code: "\x03", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: ADD, code: "\x03", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: ADD, code: "\x02", modrm: encSlashR, args: []decodeOp{xArgR8, xArgRM8}},
+	{op: ADDPD, prefix: 0x66, code: "\x0f\x58", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: ADDPS, code: "\x0f\x58", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: ADDSD, prefix: 0xF2, code: "\x0f\x58", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: ADDSS, prefix: 0xF3, code: "\x0f\x58", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: ADDSUBPD, prefix: 0x66, code: "\x0f\xd0", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: ADDSUBPS, prefix: 0xF2, code: "\x0f\xd0", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: AESDEC, prefix: 0x66, code: "\x0f\x38\xde", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: AESDECLAST, prefix: 0x66, code: "\x0f\x38\xdf", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: AESENC, prefix: 0x66, code: "\x0f\x38\xdc", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: AESENCLAST, prefix: 0x66, code: "\x0f\x38\xdd", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: AESIMC, prefix: 0x66, code: "\x0f\x38\xdb", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: AESKEYGENASSIST, prefix: 0x66, code: "\x0f\x3a\xdf", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: AND, code: "\x24", modrm: encNoModRM, read: []decodeOp{xReadIb}, args: []decodeOp{xArgAL, xArgImm8u}},
+	{op: AND, code: "\x25", modrm: encNoModRM, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgAX, xArgImm16}},
+	{op: AND, code: "\x25", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgEAX, xArgImm32}},
+	{op: AND, code: "\x25", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRAX, xArgImm32}},
+	{op: AND, code: "\x81", modrm: 4, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgRM16, xArgImm16}},
+	{op: AND, code: "\x83", modrm: 4, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8}},
+	{op: AND, code: "\x21", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: AND, code: "\x81", modrm: 4, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM32, xArgImm32}},
+	{op: AND, code: "\x83", modrm: 4, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8}},
+	{op: AND, code: "\x21", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32}},
+	{op: AND, code: "\x81", modrm: 4, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM64, xArgImm32}},
+	{op: AND, code: "\x83", modrm: 4, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8}},
+	{op: AND, code: "\x21", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64}},
+	{op: AND, code: "\x80", modrm: 4, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM8, xArgImm8u}},
+	{op: AND, code: "\x20", modrm: encSlashR, args: []decodeOp{xArgRM8, xArgR8}},
+	{op: AND, code: "\x23", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: AND, code: "\x23", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: AND, code: "\x23", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: AND, code: "\x22", modrm: encSlashR, args: []decodeOp{xArgR8, xArgRM8}},
+	{op: ANDN, vex: 0x2, code: "\xf2", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, args: []decodeOp{xArgR32, xArgR32V, xArgRM32}},
+	{op: ANDN, vex: 0x2, code: "\xf2", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, args: []decodeOp{xArgR64, xArgR64V, xArgRM64}},
+	{op: ANDNPD, prefix: 0x66, code: "\x0f\x55", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: ANDNPS, code: "\x0f\x55", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: ANDPD, prefix: 0x66, code: "\x0f\x54", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: ANDPS, code: "\x0f\x54", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: ARPL, code: "\x63", modrm: encSlashR, is64: -1, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: BEXTR, vex: 0x2, code: "\xf7", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, args: []decodeOp{xArgR32, xArgRM32, xArgR32V}},
+	{op: BEXTR, vex: 0x2, code: "\xf7", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, args: []decodeOp{xArgR64, xArgRM64, xArgR64V}},
+	{op: BLENDPD, prefix: 0x66, code: "\x0f\x3a\x0d", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: BLENDPS, prefix: 0x66, code: "\x0f\x3a\x0c", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: BLENDVPD, prefix: 0x66, code: "\x0f\x38\x15", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgXMM0}},
+	{op: BLENDVPS, prefix: 0x66, code: "\x0f\x38\x14", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgXMM0}},
+	{op: BLSI, vex: 0x2, code: "\xf3", modrm: 3, dataSize: 32, vexL: 0, vexW: -1, args: []decodeOp{xArgR32V, xArgRM32}},
+	{op: BLSI, vex: 0x2, code: "\xf3", modrm: 3, is64: 1, dataSize: 64, vexL: 0, vexW: -1, args: []decodeOp{xArgR64V, xArgRM64}},
+	{op: BLSMSK, vex: 0x2, code: "\xf3", modrm: 2, dataSize: 32, vexL: 0, vexW: -1, args: []decodeOp{xArgR32V, xArgRM32}},
+	{op: BLSMSK, vex: 0x2, code: "\xf3", modrm: 2, is64: 1, dataSize: 64, vexL: 0, vexW: -1, args: []decodeOp{xArgR64V, xArgRM64}},
+	{op: BLSR, vex: 0x2, code: "\xf3", modrm: 1, dataSize: 32, vexL: 0, vexW: -1, args: []decodeOp{xArgR32V, xArgRM32}},
+	{op: BLSR, vex: 0x2, code: "\xf3", modrm: 1, is64: 1, dataSize: 64, vexL: 0, vexW: -1, args: []decodeOp{xArgR64V, xArgRM64}},
+	{op: BOUND, code: "\x62", modrm: encSlashR, is64: -1, dataSize: 16, args: []decodeOp{xArgR16, xArgM16and16}},
+	{op: BOUND, code: "\x62", modrm: encSlashR, is64: -1, dataSize: 32, args: []decodeOp{xArgR32, xArgM32and32}},
+	{op: BSF, code: "\x0f\xbc", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: BSF, code: "\x0f\xbc", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: BSF, code: "\x0f\xbc", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: BSR, code: "\x0f\xbd", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: BSR, code: "\x0f\xbd", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: BSR, code: "\x0f\xbd", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: BSWAP, code: "\x0f\xc8", plus: true, modrm: encNoModRM, dataSize: 16, args: []decodeOp{xArgR16op}},
+	{op: BSWAP, code: "\x0f\xc8", plus: true, modrm: encNoModRM, dataSize: 32, args: []decodeOp{xArgR32op}},
+	{op: BSWAP, code: "\x0f\xc8", plus: true, modrm: encNoModRM, is64: 1, dataSize: 64, args: []decodeOp{xArgR64op}},
+	{op: BT, code: "\x0f\xba", modrm: 4, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8u}},
+	{op: BT, code: "\x0f\xa3", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: BT, code: "\x0f\xba", modrm: 4, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8u}},
+	{op: BT, code: "\x0f\xa3", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32}},
+	{op: BT, code: "\x0f\xba", modrm: 4, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8u}},
+	{op: BT, code: "\x0f\xa3", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64}},
+	{op: BTC, code: "\x0f\xba", modrm: 7, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8u}},
+	{op: BTC, code: "\x0f\xbb", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: BTC, code: "\x0f\xba", modrm: 7, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8u}},
+	{op: BTC, code: "\x0f\xbb", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32}},
+	{op: BTC, code: "\x0f\xba", modrm: 7, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8u}},
+	{op: BTC, code: "\x0f\xbb", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64}},
+	{op: BTR, code: "\x0f\xba", modrm: 6, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8u}},
+	{op: BTR, code: "\x0f\xb3", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: BTR, code: "\x0f\xba", modrm: 6, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8u}},
+	{op: BTR, code: "\x0f\xb3", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32}},
+	{op: BTR, code: "\x0f\xba", modrm: 6, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8u}},
+	{op: BTR, code: "\x0f\xb3", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64}},
+	{op: BTS, code: "\x0f\xba", modrm: 5, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8u}},
+	{op: BTS, code: "\x0f\xab", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: BTS, code: "\x0f\xba", modrm: 5, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8u}},
+	{op: BTS, code: "\x0f\xab", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32}},
+	{op: BTS, code: "\x0f\xba", modrm: 5, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8u}},
+	{op: BTS, code: "\x0f\xab", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64}},
+	{op: BZHI, vex: 0x2, code: "\xf5", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, args: []decodeOp{xArgR32, xArgRM32, xArgR32V}},
+	{op: BZHI, vex: 0x2, code: "\xf5", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, args: []decodeOp{xArgR64, xArgRM64, xArgR64V}},
+	{op: CALL, code: "\xff", modrm: 2, is64: -1, dataSize: 16, args: []decodeOp{xArgRM16}},
+	{op: CALL, code: "\xff", modrm: 2, is64: -1, dataSize: 32, args: []decodeOp{xArgRM32}},
+	{op: CALL, code: "\xff", modrm: 2, is64: 1, args: []decodeOp{xArgRM64}},
+	{op: CALL, code: "\xe8", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: CALL, code: "\xe8", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: CALL, code: "\xe8", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: CALL, code: "\xe8", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: CBW, code: "\x98", modrm: encNoModRM, dataSize: 16},
+	{op: CDQ, code: "\x99", modrm: encNoModRM, dataSize: 32},
+	{op: CDQE, code: "\x98", modrm: encNoModRM, is64: 1, dataSize: 64},
+	{op: CLC, code: "\xf8", modrm: encNoModRM},
+	{op: CLD, code: "\xfc", modrm: encNoModRM},
+	{op: CLFLUSH, code: "\x0f\xae", modrm: 7, args: []decodeOp{xArgM8}},
+	{op: CLI, code: "\xfa", modrm: encNoModRM},
+	{op: CLTS, code: "\x0f\x06", modrm: encNoModRM},
+	{op: CMC, code: "\xf5", modrm: encNoModRM},
+	{op: CMOVA, code: "\x0f\x47", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: CMOVA, code: "\x0f\x47", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CMOVA, code: "\x0f\x47", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CMOVAE, code: "\x0f\x43", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: CMOVAE, code: "\x0f\x43", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CMOVAE, code: "\x0f\x43", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CMOVB, code: "\x0f\x42", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: CMOVB, code: "\x0f\x42", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CMOVB, code: "\x0f\x42", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CMOVBE, code: "\x0f\x46", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: CMOVBE, code: "\x0f\x46", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CMOVBE, code: "\x0f\x46", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CMOVE, code: "\x0f\x44", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: CMOVE, code: "\x0f\x44", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CMOVE, code: "\x0f\x44", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CMOVG, code: "\x0f\x4f", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: CMOVG, code: "\x0f\x4f", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CMOVG, code: "\x0f\x4f", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CMOVGE, code: "\x0f\x4d", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: CMOVGE, code: "\x0f\x4d", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CMOVGE, code: "\x0f\x4d", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CMOVL, code: "\x0f\x4c", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: CMOVL, code: "\x0f\x4c", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CMOVL, code: "\x0f\x4c", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CMOVLE, code: "\x0f\x4e", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: CMOVLE, code: "\x0f\x4e", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CMOVLE, code: "\x0f\x4e", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CMOVNE, code: "\x0f\x45", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: CMOVNE, code: "\x0f\x45", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CMOVNE, code: "\x0f\x45", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CMOVNO, code: "\x0f\x41", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: CMOVNO, code: "\x0f\x41", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CMOVNO, code: "\x0f\x41", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CMOVNP, code: "\x0f\x4b", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: CMOVNP, code: "\x0f\x4b", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CMOVNP, code: "\x0f\x4b", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CMOVNS, code: "\x0f\x49", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: CMOVNS, code: "\x0f\x49", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CMOVNS, code: "\x0f\x49", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CMOVO, code: "\x0f\x40", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: CMOVO, code: "\x0f\x40", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CMOVO, code: "\x0f\x40", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CMOVP, code: "\x0f\x4a", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: CMOVP, code: "\x0f\x4a", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CMOVP, code: "\x0f\x4a", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CMOVS, code: "\x0f\x48", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: CMOVS, code: "\x0f\x48", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CMOVS, code: "\x0f\x48", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CMP, code: "\x3c", modrm: encNoModRM, read: []decodeOp{xReadIb}, args: []decodeOp{xArgAL, xArgImm8u}},
+	{op: CMP, code: "\x3d", modrm: encNoModRM, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgAX, xArgImm16}},
+	{op: CMP, code: "\x3d", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgEAX, xArgImm32}},
+	{op: CMP, code: "\x3d", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRAX, xArgImm32}},
+	{op: CMP, code: "\x81", modrm: 7, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgRM16, xArgImm16}},
+	{op: CMP, code: "\x83", modrm: 7, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8}},
+	{op: CMP, code: "\x39", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: CMP, code: "\x81", modrm: 7, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM32, xArgImm32}},
+	{op: CMP, code: "\x83", modrm: 7, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8}},
+	{op: CMP, code: "\x39", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32}},
+	{op: CMP, code: "\x81", modrm: 7, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM64, xArgImm32}},
+	{op: CMP, code: "\x83", modrm: 7, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8}},
+	{op: CMP, code: "\x39", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64}},
+	{op: CMP, code: "\x80", modrm: 7, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM8, xArgImm8u}},
+	{op: CMP, code: "\x38", modrm: encSlashR, args: []decodeOp{xArgRM8, xArgR8}},
+	{op: CMP, code: "\x3b", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: CMP, code: "\x3b", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CMP, code: "\x3b", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CMP, code: "\x3a", modrm: encSlashR, args: []decodeOp{xArgR8, xArgRM8}},
+	{op: CMPPD, prefix: 0x66, code: "\x0f\xc2", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: CMPPS, code: "\x0f\xc2", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: CMPSB, code: "\xa6", modrm: encNoModRM},
+	{op: CMPSD, code: "\xa7", modrm: encNoModRM, dataSize: 32},
+	{op: CMPSD_XMM, prefix: 0xF2, code: "\x0f\xc2", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M64, xArgImm8u}},
+	{op: CMPSQ, code: "\xa7", modrm: encNoModRM, is64: 1, dataSize: 64},
+	{op: CMPSS, prefix: 0xF3, code: "\x0f\xc2", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M32, xArgImm8u}},
+	{op: CMPSW, code: "\xa7", modrm: encNoModRM, dataSize: 16},
+	{op: CMPXCHG, code: "\x0f\xb1", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: CMPXCHG, code: "\x0f\xb1", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32}},
+	{op: CMPXCHG, code: "\x0f\xb1", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64}},
+	{op: CMPXCHG, code: "\x0f\xb0", modrm: encSlashR, args: []decodeOp{xArgRM8, xArgR8}},
+	{op: CMPXCHG16B, code: "\x0f\xc7", modrm: 1, is64: 1, dataSize: 64, args: []decodeOp{xArgM128}},
+	{op: CMPXCHG8B, code: "\x0f\xc7", modrm: 1, dataSize: 16, args: []decodeOp{xArgM64}},
+	{op: CMPXCHG8B, code: "\x0f\xc7", modrm: 1, dataSize: 32, args: []decodeOp{xArgM64}},
+	{op: COMISD, prefix: 0x66, code: "\x0f\x2f", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: COMISS, code: "\x0f\x2f", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: CPUID, code: "\x0f\xa2", modrm: encNoModRM},
+	{op: CQO, code: "\x99", modrm: encNoModRM, is64: 1, dataSize: 64},
+	{op: CRC32, prefix: 0xF2, code: "\x0f\x38\xf1", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR32, xArgRM16}},
+	{op: CRC32, prefix: 0xF2, code: "\x0f\x38\xf1", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: CRC32, prefix: 0xF2, code: "\x0f\x38\xf0", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR32, xArgRM8}},
+	{op: CRC32, prefix: 0xF2, code: "\x0f\x38\xf0", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM8}},
+	{op: CRC32, prefix: 0xF2, code: "\x0f\x38\xf1", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: CRC32, prefix: 0xF2, code: "\x0f\x38\xf0", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM8}},
+	{op: CVTDQ2PD, prefix: 0xF3, code: "\x0f\xe6", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: CVTDQ2PS, code: "\x0f\x5b", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: CVTPD2DQ, prefix: 0xF2, code: "\x0f\xe6", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: CVTPD2PI, prefix: 0x66, code: "\x0f\x2d", modrm: encSlashR, args: []decodeOp{xArgMm, xArgXmmM128}},
+	{op: CVTPD2PS, prefix: 0x66, code: "\x0f\x5a", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: CVTPI2PD, prefix: 0x66, code: "\x0f\x2a", modrm: encSlashR, args: []decodeOp{xArgXmm, xArgMmM64}},
+	{op: CVTPI2PS, code: "\x0f\x2a", modrm: encSlashR, args: []decodeOp{xArgXmm, xArgMmM64}},
+	{op: CVTPS2DQ, prefix: 0x66, code: "\x0f\x5b", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: CVTPS2PD, code: "\x0f\x5a", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: CVTPS2PI, code: "\x0f\x2d", modrm: encSlashR, args: []decodeOp{xArgMm, xArgXmmM64}},
+	{op: CVTSD2SI, prefix: 0xF2, code: "\x0f\x2d", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR32, xArgXmmM64}},
+	{op: CVTSD2SI, prefix: 0xF2, code: "\x0f\x2d", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgXmmM64}},
+	{op: CVTSD2SI, prefix: 0xF2, code: "\x0f\x2d", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgXmmM64}},
+	{op: CVTSD2SS, prefix: 0xF2, code: "\x0f\x5a", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: CVTSI2SD, prefix: 0xF2, code: "\x0f\x2a", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgXmm, xArgRM32}},
+	{op: CVTSI2SD, prefix: 0xF2, code: "\x0f\x2a", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgXmm, xArgRM32}},
+	{op: CVTSI2SD, prefix: 0xF2, code: "\x0f\x2a", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgXmm, xArgRM64}},
+	{op: CVTSI2SS, prefix: 0xF3, code: "\x0f\x2a", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgXmm, xArgRM32}},
+	{op: CVTSI2SS, prefix: 0xF3, code: "\x0f\x2a", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgXmm, xArgRM32}},
+	{op: CVTSI2SS, prefix: 0xF3, code: "\x0f\x2a", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgXmm, xArgRM64}},
+	{op: CVTSS2SD, prefix: 0xF3, code: "\x0f\x5a", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: CVTSS2SI, prefix: 0xF3, code: "\x0f\x2d", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR32, xArgXmmM32}},
+	{op: CVTSS2SI, prefix: 0xF3, code: "\x0f\x2d", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgXmmM32}},
+	{op: CVTSS2SI, prefix: 0xF3, code: "\x0f\x2d", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgXmmM32}},
+	{op: CVTTPD2DQ, prefix: 0x66, code: "\x0f\xe6", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: CVTTPD2PI, prefix: 0x66, code: "\x0f\x2c", modrm: encSlashR, args: []decodeOp{xArgMm, xArgXmmM128}},
+	{op: CVTTPS2DQ, prefix: 0xF3, code: "\x0f\x5b", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: CVTTPS2PI, code: "\x0f\x2c", modrm: encSlashR, args: []decodeOp{xArgMm, xArgXmmM64}},
+	{op: CVTTSD2SI, prefix: 0xF2, code: "\x0f\x2c", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR32, xArgXmmM64}},
+	{op: CVTTSD2SI, prefix: 0xF2, code: "\x0f\x2c", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgXmmM64}},
+	{op: CVTTSD2SI, prefix: 0xF2, code: "\x0f\x2c", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgXmmM64}},
+	{op: CVTTSS2SI, prefix: 0xF3, code: "\x0f\x2c", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR32, xArgXmmM32}},
+	{op: CVTTSS2SI, prefix: 0xF3, code: "\x0f\x2c", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgXmmM32}},
+	{op: CVTTSS2SI, prefix: 0xF3, code: "\x0f\x2c", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgXmmM32}},
+	{op: CWD, code: "\x99", modrm: encNoModRM, dataSize: 16},
+	{op: CWDE, code: "\x98", modrm: encNoModRM, dataSize: 32},
+	{op: DAA, code: "\x27", modrm: encNoModRM, is64: -1},
+	{op: DAS, code: "\x2f", modrm: encNoModRM, is64: -1},
+	{op: DEC, code: "\xff", modrm: 1, dataSize: 16, args: []decodeOp{xArgRM16}},
+	{op: DEC, code: "\xff", modrm: 1, dataSize: 32, args: []decodeOp{xArgRM32}},
+	{op: DEC, code: "\xff", modrm: 1, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64}},
+	{op: DEC, code: "\xfe", modrm: 1, args: []decodeOp{xArgRM8}},
+	{op: DEC, code: "\x48", plus: true, modrm: encNoModRM, is64: -1, dataSize: 16, args: []decodeOp{xArgR16op}},
+	{op: DEC, code: "\x48", plus: true, modrm: encNoModRM, is64: -1, dataSize: 32, args: []decodeOp{xArgR32op}},
+	{op: DIV, code: "\xf7", modrm: 6, dataSize: 16, args: []decodeOp{xArgRM16}},
+	{op: DIV, code: "\xf7", modrm: 6, dataSize: 32, args: []decodeOp{xArgRM32}},
+	{op: DIV, code: "\xf7", modrm: 6, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64}},
+	{op: DIV, code: "\xf6", modrm: 6, args: []decodeOp{xArgRM8}},
+	{op: DIVPD, prefix: 0x66, code: "\x0f\x5e", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: DIVPS, code: "\x0f\x5e", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: DIVSD, prefix: 0xF2, code: "\x0f\x5e", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: DIVSS, prefix: 0xF3, code: "\x0f\x5e", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: DPPD, prefix: 0x66, code: "\x0f\x3a\x41", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: DPPS, prefix: 0x66, code: "\x0f\x3a\x40", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: EMMS, code: "\x0f\x77", modrm: encNoModRM},
+	{op: ENDBR32, prefix: 0xF3, code: "\x0f\x1e\xfb", modrm: encNoModRM},
+	{op: ENDBR64, prefix: 0xF3, code: "\x0f\x1e\xfa", modrm: encNoModRM},
+	{op: ENTER, code: "\xc8", modrm: encNoModRM, read: []decodeOp{xReadIw, xReadIb}, args: []decodeOp{xArgImm16u, xArgImm8u}},
+	{op: EXTRACTPS, prefix: 0x66, code: "\x0f\x3a\x17", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgXmm1, xArgImm8u}},
+	{op: F2XM1, code: "\xd9\xf0", modrm: encNoModRM},
+	{op: FABS, code: "\xd9\xe1", modrm: encNoModRM},
+	{op: FADD, code: "\xd8\xc0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FADD, code: "\xdc\xc0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi, xArgST}},
+	{op: FADD, code: "\xd8", modrm: 0, args: []decodeOp{xArgM32fp}},
+	{op: FADD, code: "\xdc", modrm: 0, args: []decodeOp{xArgM64fp}},
+	{op: FADDP, code: "\xde\xc0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi, xArgST}},
+	{op: FBLD, code: "\xdf", modrm: 4, args: []decodeOp{xArgM80dec}},
+	{op: FBSTP, code: "\xdf", modrm: 6, args: []decodeOp{xArgM80bcd}},
+	{op: FCHS, code: "\xd9\xe0", modrm: encNoModRM},
+	{op: FCMOVB, code: "\xda\xc0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FCMOVBE, code: "\xda\xd0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FCMOVE, code: "\xda\xc8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FCMOVNB, code: "\xdb\xc0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FCMOVNBE, code: "\xdb\xd0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FCMOVNE, code: "\xdb\xc8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FCMOVNU, code: "\xdb\xd8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FCMOVU, code: "\xda\xd8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FCOM, code: "\xd8\xd0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi}},
+	{op: FCOM, code: "\xd8", modrm: 2, args: []decodeOp{xArgM32fp}},
+	{op: FCOM, code: "\xdc", modrm: 2, args: []decodeOp{xArgM64fp}},
+	{op: FCOMI, code: "\xdb\xf0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FCOMIP, code: "\xdf\xf0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FCOMP, code: "\xd8\xd8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi}},
+	{op: FCOMP, code: "\xd8", modrm: 3, args: []decodeOp{xArgM32fp}},
+	{op: FCOMP, code: "\xdc", modrm: 3, args: []decodeOp{xArgM64fp}},
+	{op: FCOMPP, code: "\xde\xd9", modrm: encNoModRM},
+	{op: FCOS, code: "\xd9\xff", modrm: encNoModRM},
+	{op: FDECSTP, code: "\xd9\xf6", modrm: encNoModRM},
+	{op: FDIV, code: "\xd8\xf0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FDIV, code: "\xdc\xf8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi, xArgST}},
+	{op: FDIV, code: "\xd8", modrm: 6, args: []decodeOp{xArgM32fp}},
+	{op: FDIV, code: "\xdc", modrm: 6, args: []decodeOp{xArgM64fp}},
+	{op: FDIVP, code: "\xde\xf8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi, xArgST}},
+	{op: FDIVR, code: "\xd8\xf8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FDIVR, code: "\xdc\xf0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi, xArgST}},
+	{op: FDIVR, code: "\xd8", modrm: 7, args: []decodeOp{xArgM32fp}},
+	{op: FDIVR, code: "\xdc", modrm: 7, args: []decodeOp{xArgM64fp}},
+	{op: FDIVRP, code: "\xde\xf0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi, xArgST}},
+	{op: FFREE, code: "\xdd\xc0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi}},
+	{op: FFREEP, code: "\xdf\xc0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi}},
+	{op: FIADD, code: "\xde", modrm: 0, args: []decodeOp{xArgM16int}},
+	{op: FIADD, code: "\xda", modrm: 0, args: []decodeOp{xArgM32int}},
+	{op: FICOM, code: "\xde", modrm: 2, args: []decodeOp{xArgM16int}},
+	{op: FICOM, code: "\xda", modrm: 2, args: []decodeOp{xArgM32int}},
+	{op: FICOMP, code: "\xde", modrm: 3, args: []decodeOp{xArgM16int}},
+	{op: FICOMP, code: "\xda", modrm: 3, args: []decodeOp{xArgM32int}},
+	{op: FIDIV, code: "\xde", modrm: 6, args: []decodeOp{xArgM16int}},
+	{op: FIDIV, code: "\xda", modrm: 6, args: []decodeOp{xArgM32int}},
+	{op: FIDIVR, code: "\xde", modrm: 7, args: []decodeOp{xArgM16int}},
+	{op: FIDIVR, code: "\xda", modrm: 7, args: []decodeOp{xArgM32int}},
+	{op: FILD, code: "\xdf", modrm: 0, args: []decodeOp{xArgM16int}},
+	{op: FILD, code: "\xdb", modrm: 0, args: []decodeOp{xArgM32int}},
+	{op: FILD, code: "\xdf", modrm: 5, args: []decodeOp{xArgM64int}},
+	{op: FIMUL, code: "\xde", modrm: 1, args: []decodeOp{xArgM16int}},
+	{op: FIMUL, code: "\xda", modrm: 1, args: []decodeOp{xArgM32int}},
+	{op: FINCSTP, code: "\xd9\xf7", modrm: encNoModRM},
+	{op: FIST, code: "\xdf", modrm: 2, args: []decodeOp{xArgM16int}},
+	{op: FIST, code: "\xdb", modrm: 2, args: []decodeOp{xArgM32int}},
+	{op: FISTP, code: "\xdf", modrm: 3, args: []decodeOp{xArgM16int}},
+	{op: FISTP, code: "\xdb", modrm: 3, args: []decodeOp{xArgM32int}},
+	{op: FISTP, code: "\xdf", modrm: 7, args: []decodeOp{xArgM64int}},
+	{op: FISTTP, code: "\xdf", modrm: 1, args: []decodeOp{xArgM16int}},
+	{op: FISTTP, code: "\xdb", modrm: 1, args: []decodeOp{xArgM32int}},
+	{op: FISTTP, code: "\xdd", modrm: 1, args: []decodeOp{xArgM64int}},
+	{op: FISUB, code: "\xde", modrm: 4, args: []decodeOp{xArgM16int}},
+	{op: FISUB, code: "\xda", modrm: 4, args: []decodeOp{xArgM32int}},
+	{op: FISUBR, code: "\xde", modrm: 5, args: []decodeOp{xArgM16int}},
+	{op: FISUBR, code: "\xda", modrm: 5, args: []decodeOp{xArgM32int}},
+	{op: FLD, code: "\xd9\xc0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi}},
+	{op: FLD, code: "\xd9", modrm: 0, args: []decodeOp{xArgM32fp}},
+	{op: FLD, code: "\xdd", modrm: 0, args: []decodeOp{xArgM64fp}},
+	{op: FLD, code: "\xdb", modrm: 5, args: []decodeOp{xArgM80fp}},
+	{op: FLD1, code: "\xd9\xe8", modrm: encNoModRM},
+	{op: FLDCW, code: "\xd9", modrm: 5, args: []decodeOp{xArgM2byte}},
+	{op: FLDENV, code: "\xd9", modrm: 4, args: []decodeOp{xArgM1428byte}},
+	{op: FLDL2E, code: "\xd9\xea", modrm: encNoModRM},
+	{op: FLDL2T, code: "\xd9\xe9", modrm: encNoModRM},
+	{op: FLDLG2, code: "\xd9\xec", modrm: encNoModRM},
+	{op: FLDPI, code: "\xd9\xeb", modrm: encNoModRM},
+	{op: FMUL, code: "\xd8\xc8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FMUL, code: "\xdc\xc8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi, xArgST}},
+	{op: FMUL, code: "\xd8", modrm: 1, args: []decodeOp{xArgM32fp}},
+	{op: FMUL, code: "\xdc", modrm: 1, args: []decodeOp{xArgM64fp}},
+	{op: FMULP, code: "\xde\xc8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi, xArgST}},
+	{op: FNCLEX, code: "\xdb\xe2", modrm: encNoModRM},
+	{op: FNINIT, code: "\xdb\xe3", modrm: encNoModRM},
+	{op: FNOP, code: "\xd9\xd0", modrm: encNoModRM},
+	{op: FNSAVE, code: "\xdd", modrm: 6, args: []decodeOp{xArgM94108byte}},
+	{op: FNSTCW, code: "\xd9", modrm: 7, args: []decodeOp{xArgM2byte}},
+	{op: FNSTENV, code: "\xd9", modrm: 6, args: []decodeOp{xArgM1428byte}},
+	{op: FNSTSW, code: "\xdf\xe0", modrm: encNoModRM, args: []decodeOp{xArgAX}},
+	{op: FNSTSW, code: "\xdd", modrm: 7, args: []decodeOp{xArgM2byte}},
+	{op: FPATAN, code: "\xd9\xf3", modrm: encNoModRM},
+	{op: FPREM, code: "\xd9\xf8", modrm: encNoModRM},
+	{op: FPREM1, code: "\xd9\xf5", modrm: encNoModRM},
+	{op: FPTAN, code: "\xd9\xf2", modrm: encNoModRM},
+	{op: FRNDINT, code: "\xd9\xfc", modrm: encNoModRM},
+	{op: FRSTOR, code: "\xdd", modrm: 4, args: []decodeOp{xArgM94108byte}},
+	{op: FSCALE, code: "\xd9\xfd", modrm: encNoModRM},
+	{op: FSIN, code: "\xd9\xfe", modrm: encNoModRM},
+	{op: FSINCOS, code: "\xd9\xfb", modrm: encNoModRM},
+	{op: FSQRT, code: "\xd9\xfa", modrm: encNoModRM},
+	{op: FST, code: "\xdd\xd0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi}},
+	{op: FST, code: "\xd9", modrm: 2, args: []decodeOp{xArgM32fp}},
+	{op: FST, code: "\xdd", modrm: 2, args: []decodeOp{xArgM64fp}},
+	{op: FSTP, code: "\xdd\xd8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi}},
+	{op: FSTP, code: "\xd9", modrm: 3, args: []decodeOp{xArgM32fp}},
+	{op: FSTP, code: "\xdd", modrm: 3, args: []decodeOp{xArgM64fp}},
+	{op: FSTP, code: "\xdb", modrm: 7, args: []decodeOp{xArgM80fp}},
+	{op: FSUB, code: "\xd8\xe0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FSUB, code: "\xdc\xe8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi, xArgST}},
+	{op: FSUB, code: "\xd8", modrm: 4, args: []decodeOp{xArgM32fp}},
+	{op: FSUB, code: "\xdc", modrm: 4, args: []decodeOp{xArgM64fp}},
+	{op: FSUBP, code: "\xde\xe8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi, xArgST}},
+	{op: FSUBR, code: "\xd8\xe8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FSUBR, code: "\xdc\xe0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi, xArgST}},
+	{op: FSUBR, code: "\xd8", modrm: 5, args: []decodeOp{xArgM32fp}},
+	{op: FSUBR, code: "\xdc", modrm: 5, args: []decodeOp{xArgM64fp}},
+	{op: FSUBRP, code: "\xde\xe0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi, xArgST}},
+	{op: FTST, code: "\xd9\xe4", modrm: encNoModRM},
+	{op: FUCOM, code: "\xdd\xe0", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi}},
+	{op: FUCOMI, code: "\xdb\xe8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FUCOMIP, code: "\xdf\xe8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgST, xArgSTi}},
+	{op: FUCOMP, code: "\xdd\xe8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi}},
+	{op: FUCOMPP, code: "\xda\xe9", modrm: encNoModRM},
+	{op: FWAIT, code: "\x9b", modrm: encNoModRM},
+	{op: FXAM, code: "\xd9\xe5", modrm: encNoModRM},
+	{op: FXCH, code: "\xd9\xc8", plus: true, modrm: encNoModRM, args: []decodeOp{xArgSTi}},
+	{op: FXRSTOR, code: "\x0f\xae", modrm: 1, dataSize: 16, args: []decodeOp{xArgM512byte}},
+	{op: FXRSTOR, code: "\x0f\xae", modrm: 1, dataSize: 32, args: []decodeOp{xArgM512byte}},
+	{op: FXRSTOR64, code: "\x0f\xae", modrm: 1, is64: 1, dataSize: 64, args: []decodeOp{xArgM512byte}},
+	{op: FXSAVE, code: "\x0f\xae", modrm: 0, dataSize: 16, args: []decodeOp{xArgM512byte}},
+	{op: FXSAVE, code: "\x0f\xae", modrm: 0, dataSize: 32, args: []decodeOp{xArgM512byte}},
+	{op: FXSAVE64, code: "\x0f\xae", modrm: 0, is64: 1, dataSize: 64, args: []decodeOp{xArgM512byte}},
+	{op: FXTRACT, code: "\xd9\xf4", modrm: encNoModRM},
+	{op: FYL2X, code: "\xd9\xf1", modrm: encNoModRM},
+	{op: FYL2XP1, code: "\xd9\xf9", modrm: encNoModRM},
+	{op: HADDPD, prefix: 0x66, code: "\x0f\x7c", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: HADDPS, prefix: 0xF2, code: "\x0f\x7c", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: HLT, code: "\xf4", modrm: encNoModRM},
+	{op: HSUBPD, prefix: 0x66, code: "\x0f\x7d", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: HSUBPS, prefix: 0xF2, code: "\x0f\x7d", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: ICEBP, code: "\xf1", modrm: encNoModRM},
+	{op: IDIV, code: "\xf7", modrm: 7, dataSize: 16, args: []decodeOp{xArgRM16}},
+	{op: IDIV, code: "\xf7", modrm: 7, dataSize: 32, args: []decodeOp{xArgRM32}},
+	{op: IDIV, code: "\xf7", modrm: 7, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64}},
+	{op: IDIV, code: "\xf6", modrm: 7, args: []decodeOp{xArgRM8}},
+	{op: IMUL, code: "\xf7", modrm: 5, dataSize: 16, args: []decodeOp{xArgRM16}},
+	{op: IMUL, code: "\xf7", modrm: 5, dataSize: 32, args: []decodeOp{xArgRM32}},
+	{op: IMUL, code: "\xf7", modrm: 5, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64}},
+	{op: IMUL, code: "\xf6", modrm: 5, args: []decodeOp{xArgRM8}},
+	{op: IMUL, code: "\x0f\xaf", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: IMUL, code: "\x69", modrm: encSlashR, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgR16, xArgRM16, xArgImm16}},
+	{op: IMUL, code: "\x6b", modrm: encSlashR, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgR16, xArgRM16, xArgImm8}},
+	{op: IMUL, code: "\x0f\xaf", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: IMUL, code: "\x69", modrm: encSlashR, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgR32, xArgRM32, xArgImm32}},
+	{op: IMUL, code: "\x6b", modrm: encSlashR, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgR32, xArgRM32, xArgImm8}},
+	{op: IMUL, code: "\x0f\xaf", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: IMUL, code: "\x69", modrm: encSlashR, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgR64, xArgRM64, xArgImm32}},
+	{op: IMUL, code: "\x6b", modrm: encSlashR, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgR64, xArgRM64, xArgImm8}},
+	{op: IN, code: "\xec", modrm: encNoModRM, args: []decodeOp{xArgAL, xArgDX}},
+	{op: IN, code: "\xe4", modrm: encNoModRM, read: []decodeOp{xReadIb}, args: []decodeOp{xArgAL, xArgImm8u}},
+	{op: IN, code: "\xed", modrm: encNoModRM, dataSize: 16, args: []decodeOp{xArgAX, xArgDX}},
+	{op: IN, code: "\xe5", modrm: encNoModRM, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgAX, xArgImm8u}},
+	{op: IN, code: "\xed", modrm: encNoModRM, dataSize: 32, args: []decodeOp{xArgEAX, xArgDX}},
+	{op: IN, code: "\xed", modrm: encNoModRM, dataSize: 64, args: []decodeOp{xArgEAX, xArgDX}},
+	{op: IN, code: "\xe5", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgEAX, xArgImm8u}},
+	{op: IN, code: "\xe5", modrm: encNoModRM, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgEAX, xArgImm8u}},
+	{op: INC, code: "\xff", modrm: 0, dataSize: 16, args: []decodeOp{xArgRM16}},
+	{op: INC, code: "\xff", modrm: 0, dataSize: 32, args: []decodeOp{xArgRM32}},
+	{op: INC, code: "\xff", modrm: 0, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64}},
+	{op: INC, code: "\xfe", modrm: 0, args: []decodeOp{xArgRM8}},
+	{op: INC, code: "\x40", plus: true, modrm: encNoModRM, is64: -1, dataSize: 16, args: []decodeOp{xArgR16op}},
+	{op: INC, code: "\x40", plus: true, modrm: encNoModRM, is64: -1, dataSize: 32, args: []decodeOp{xArgR32op}},
+	{op: INSB, code: "\x6c", modrm: encNoModRM},
+	{op: INSD, code: "\x6d", modrm: encNoModRM, dataSize: 32},
+	{op: INSD, code: "\x6d", modrm: encNoModRM, dataSize: 64},
+	{op: INSERTPS, prefix: 0x66, code: "\x0f\x3a\x21", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M32, xArgImm8u}},
+	{op: INSW, code: "\x6d", modrm: encNoModRM, dataSize: 16},
+	{op: INT, code: "\xcc", modrm: encNoModRM, args: []decodeOp{xArg3}},
+	{op: INT, code: "\xcd", modrm: encNoModRM, read: []decodeOp{xReadIb}, args: []decodeOp{xArgImm8u}},
+	{op: INTO, code: "\xce", modrm: encNoModRM, is64: -1},
+	{op: INVD, code: "\x0f\x08", modrm: encNoModRM},
+	{op: INVLPG, code: "\x0f\x01", modrm: 7, args: []decodeOp{xArgM}},
+	{op: INVPCID, prefix: 0x66, code: "\x0f\x38\x82", modrm: encSlashR, is64: -1, args: []decodeOp{xArgR32, xArgM128}},
+	{op: INVPCID, prefix: 0x66, code: "\x0f\x38\x82", modrm: encSlashR, is64: 1, args: []decodeOp{xArgR64, xArgM128}},
+	{op: IRET, code: "\xcf", modrm: encNoModRM, dataSize: 16},
+	{op: IRETD, code: "\xcf", modrm: encNoModRM, dataSize: 32},
+	{op: IRETQ, code: "\xcf", modrm: encNoModRM, is64: 1, dataSize: 64},
+	{op: JA, code: "\x0f\x87", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: JA, code: "\x0f\x87", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JA, code: "\x77", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JA, code: "\x0f\x87", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JA, code: "\x0f\x87", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JAE, code: "\x0f\x83", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: JAE, code: "\x0f\x83", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JAE, code: "\x73", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JAE, code: "\x0f\x83", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JAE, code: "\x0f\x83", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JB, code: "\x0f\x82", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: JB, code: "\x0f\x82", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JB, code: "\x72", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JB, code: "\x0f\x82", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JB, code: "\x0f\x82", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JBE, code: "\x0f\x86", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: JBE, code: "\x0f\x86", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JBE, code: "\x76", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JBE, code: "\x0f\x86", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JBE, code: "\x0f\x86", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JCXZ, code: "\xe3", modrm: encNoModRM, is64: -1, addrSize: 16, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JE, code: "\x0f\x84", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: JE, code: "\x0f\x84", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JE, code: "\x74", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JE, code: "\x0f\x84", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JE, code: "\x0f\x84", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JECXZ, code: "\xe3", modrm: encNoModRM, addrSize: 32, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JG, code: "\x0f\x8f", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: JG, code: "\x0f\x8f", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JG, code: "\x7f", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JG, code: "\x0f\x8f", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JG, code: "\x0f\x8f", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JGE, code: "\x0f\x8d", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: JGE, code: "\x0f\x8d", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JGE, code: "\x7d", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JGE, code: "\x0f\x8d", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JGE, code: "\x0f\x8d", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JL, code: "\x0f\x8c", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: JL, code: "\x0f\x8c", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JL, code: "\x7c", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JL, code: "\x0f\x8c", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JL, code: "\x0f\x8c", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JLE, code: "\x0f\x8e", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: JLE, code: "\x0f\x8e", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JLE, code: "\x7e", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JLE, code: "\x0f\x8e", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JLE, code: "\x0f\x8e", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JMP, code: "\xff", modrm: 4, is64: -1, dataSize: 16, args: []decodeOp{xArgRM16}},
+	{op: JMP, code: "\xff", modrm: 4, is64: -1, dataSize: 32, args: []decodeOp{xArgRM32}},
+	{op: JMP, code: "\xff", modrm: 4, is64: 1, args: []decodeOp{xArgRM64}},
+	{op: JMP, code: "\xe9", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: JMP, code: "\xe9", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JMP, code: "\xe9", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JMP, code: "\xe9", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JMP, code: "\xeb", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JNE, code: "\x0f\x85", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: JNE, code: "\x0f\x85", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JNE, code: "\x75", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JNE, code: "\x0f\x85", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JNE, code: "\x0f\x85", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JNO, code: "\x0f\x81", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: JNO, code: "\x0f\x81", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JNO, code: "\x71", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JNO, code: "\x0f\x81", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JNO, code: "\x0f\x81", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JNP, code: "\x0f\x8b", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: JNP, code: "\x0f\x8b", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JNP, code: "\x7b", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JNP, code: "\x0f\x8b", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JNP, code: "\x0f\x8b", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JNS, code: "\x0f\x89", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: JNS, code: "\x0f\x89", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JNS, code: "\x79", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JNS, code: "\x0f\x89", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JNS, code: "\x0f\x89", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JO, code: "\x0f\x80", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: JO, code: "\x0f\x80", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JO, code: "\x70", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JO, code: "\x0f\x80", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JO, code: "\x0f\x80", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JP, code: "\x0f\x8a", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: JP, code: "\x0f\x8a", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JP, code: "\x7a", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JP, code: "\x0f\x8a", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JP, code: "\x0f\x8a", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JRCXZ, code: "\xe3", modrm: encNoModRM, is64: 1, addrSize: 64, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JS, code: "\x0f\x88", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: JS, code: "\x0f\x88", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JS, code: "\x78", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: JS, code: "\x0f\x88", modrm: encNoModRM, is64: 1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: JS, code: "\x0f\x88", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: KADDB, vex: 0x6601, code: "\x4a", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KADDD, vex: 0x6601, code: "\x4a", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KADDQ, vex: 0x1, code: "\x4a", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KADDW, vex: 0x1, code: "\x4a", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KANDB, vex: 0x6601, code: "\x41", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KANDD, vex: 0x6601, code: "\x41", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KANDNB, vex: 0x6601, code: "\x42", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KANDND, vex: 0x6601, code: "\x42", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KANDNQ, vex: 0x1, code: "\x42", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KANDNW, vex: 0x1, code: "\x42", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KANDQ, vex: 0x1, code: "\x41", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KANDW, vex: 0x1, code: "\x41", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KMOVB, vex: 0x6601, code: "\x90", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgK1, xArgK2M8}},
+	{op: KMOVB, vex: 0x6601, code: "\x91", modrm: encSlashR, vexL: 0, vexW: 0, isMem: 1, args: []decodeOp{xArgM8, xArgK1}},
+	{op: KMOVB, vex: 0x6601, code: "\x92", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgRmf32}},
+	{op: KMOVB, vex: 0x6601, code: "\x93", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgR32, xArgK2}},
+	{op: KMOVD, vex: 0x6601, code: "\x90", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgK1, xArgK2M32}},
+	{op: KMOVD, vex: 0x6601, code: "\x91", modrm: encSlashR, vexL: 0, vexW: 1, isMem: 1, args: []decodeOp{xArgM32, xArgK1}},
+	{op: KMOVD, vex: 0xf201, code: "\x92", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgRmf32}},
+	{op: KMOVD, vex: 0xf201, code: "\x93", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgR32, xArgK2}},
+	{op: KMOVQ, vex: 0x1, code: "\x90", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgK1, xArgK2M64}},
+	{op: KMOVQ, vex: 0x1, code: "\x91", modrm: encSlashR, vexL: 0, vexW: 1, isMem: 1, args: []decodeOp{xArgM64, xArgK1}},
+	{op: KMOVQ, vex: 0xf201, code: "\x92", modrm: encSlashR, is64: 1, vexL: 0, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgRmf64}},
+	{op: KMOVQ, vex: 0xf201, code: "\x93", modrm: encSlashR, is64: 1, vexL: 0, vexW: 1, isMem: -1, args: []decodeOp{xArgR64, xArgK2}},
+	{op: KMOVW, vex: 0x1, code: "\x90", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgK1, xArgK2M16}},
+	{op: KMOVW, vex: 0x1, code: "\x91", modrm: encSlashR, vexL: 0, vexW: 0, isMem: 1, args: []decodeOp{xArgM16, xArgK1}},
+	{op: KMOVW, vex: 0x1, code: "\x92", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgRmf32}},
+	{op: KMOVW, vex: 0x1, code: "\x93", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgR32, xArgK2}},
+	{op: KNOTB, vex: 0x6601, code: "\x44", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgK2}},
+	{op: KNOTD, vex: 0x6601, code: "\x44", modrm: encSlashR, vexL: 0, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgK2}},
+	{op: KNOTQ, vex: 0x1, code: "\x44", modrm: encSlashR, vexL: 0, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgK2}},
+	{op: KNOTW, vex: 0x1, code: "\x44", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgK2}},
+	{op: KORB, vex: 0x6601, code: "\x45", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KORD, vex: 0x6601, code: "\x45", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KORQ, vex: 0x1, code: "\x45", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KORTESTB, vex: 0x6601, code: "\x98", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgK2}},
+	{op: KORTESTD, vex: 0x6601, code: "\x98", modrm: encSlashR, vexL: 0, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgK2}},
+	{op: KORTESTQ, vex: 0x1, code: "\x98", modrm: encSlashR, vexL: 0, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgK2}},
+	{op: KORTESTW, vex: 0x1, code: "\x98", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgK2}},
+	{op: KORW, vex: 0x1, code: "\x45", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KSHIFTLB, vex: 0x6603, code: "\x32", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgK2, xArgImm8u}},
+	{op: KSHIFTLD, vex: 0x6603, code: "\x33", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgK2, xArgImm8u}},
+	{op: KSHIFTLQ, vex: 0x6603, code: "\x33", modrm: encSlashR, vexL: 0, vexW: 1, isMem: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgK2, xArgImm8u}},
+	{op: KSHIFTLW, vex: 0x6603, code: "\x32", modrm: encSlashR, vexL: 0, vexW: 1, isMem: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgK2, xArgImm8u}},
+	{op: KSHIFTRB, vex: 0x6603, code: "\x30", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgK2, xArgImm8u}},
+	{op: KSHIFTRD, vex: 0x6603, code: "\x31", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgK2, xArgImm8u}},
+	{op: KSHIFTRQ, vex: 0x6603, code: "\x31", modrm: encSlashR, vexL: 0, vexW: 1, isMem: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgK2, xArgImm8u}},
+	{op: KSHIFTRW, vex: 0x6603, code: "\x30", modrm: encSlashR, vexL: 0, vexW: 1, isMem: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgK2, xArgImm8u}},
+	{op: KTESTB, vex: 0x6601, code: "\x99", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgK2}},
+	{op: KTESTD, vex: 0x6601, code: "\x99", modrm: encSlashR, vexL: 0, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgK2}},
+	{op: KTESTQ, vex: 0x1, code: "\x99", modrm: encSlashR, vexL: 0, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgK2}},
+	{op: KTESTW, vex: 0x1, code: "\x99", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgK2}},
+	{op: KUNPCKBW, vex: 0x6601, code: "\x4b", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KUNPCKDQ, vex: 0x1, code: "\x4b", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KUNPCKWD, vex: 0x1, code: "\x4b", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KXNORB, vex: 0x6601, code: "\x46", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KXNORD, vex: 0x6601, code: "\x46", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KXNORQ, vex: 0x1, code: "\x46", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KXNORW, vex: 0x1, code: "\x46", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KXORB, vex: 0x6601, code: "\x47", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KXORD, vex: 0x6601, code: "\x47", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KXORQ, vex: 0x1, code: "\x47", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: KXORW, vex: 0x1, code: "\x47", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgKV, xArgK2}},
+	{op: LAHF, code: "\x9f", modrm: encNoModRM, is64: -1},
+	{op: LAHF, code: "\x9f", modrm: encNoModRM, is64: 1},
+	{op: LAR, code: "\x0f\x02", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: LAR, code: "\x0f\x02", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgR32M16}},
+	{op: LAR, code: "\x0f\x02", modrm: encSlashR, dataSize: 64, args: []decodeOp{xArgR64, xArgR64M16}},
+	{op: LCALL, code: "\xff", modrm: 3, dataSize: 16, args: []decodeOp{xArgM16colon16}},
+	{op: LCALL, code: "\xff", modrm: 3, dataSize: 32, args: []decodeOp{xArgM16colon32}},
+	{op: LCALL, code: "\xff", modrm: 3, is64: 1, dataSize: 64, args: []decodeOp{xArgM16colon64}},
+	{op: LCALL, code: "\x9a", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgPtr16colon16}},
+	{op: LCALL, code: "\x9a", modrm: encNoModRM, is64: -1, dataSize: 32, read: []decodeOp{xReadCp}, args: []decodeOp{xArgPtr16colon32}},
+	{op: LDDQU, prefix: 0xF2, code: "\x0f\xf0", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgM128}},
+	{op: LDMXCSR, code: "\x0f\xae", modrm: 2, args: []decodeOp{xArgM32}},
+	{op: LDS, code: "\xc5", modrm: encSlashR, is64: -1, dataSize: 16, args: []decodeOp{xArgR16, xArgM16colon16}},
+	{op: LDS, code: "\xc5", modrm: encSlashR, is64: -1, dataSize: 32, args: []decodeOp{xArgR32, xArgM16colon32}},
+	{op: LEA, code: "\x8d", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgM}},
+	{op: LEA, code: "\x8d", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgM}},
+	{op: LEA, code: "\x8d", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgM}},
+	{op: LEAVE, code: "\xc9", modrm: encNoModRM, is64: 1, dataSize: 32},
+	{op: LEAVE, code: "\xc9", modrm: encNoModRM, is64: 1, dataSize: 64},
+	{op: LEAVE, code: "\xc9", modrm: encNoModRM, is64: -1, dataSize: 32},
+	{op: LEAVE, code: "\xc9", modrm: encNoModRM, dataSize: 16},
+	{op: LES, code: "\xc4", modrm: encSlashR, is64: -1, dataSize: 16, args: []decodeOp{xArgR16, xArgM16colon16}},
+	{op: LES, code: "\xc4", modrm: encSlashR, is64: -1, dataSize: 32, args: []decodeOp{xArgR32, xArgM16colon32}},
+	{op: LFENCE, code: "\x0f\xae\xe8", modrm: encNoModRM},
+	{op: LFS, code: "\x0f\xb4", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgM16colon16}},
+	{op: LFS, code: "\x0f\xb4", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgM16colon32}},
+	{op: LFS, code: "\x0f\xb4", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgM16colon64}},
+	{op: LGDT, code: "\x0f\x01", modrm: 2, is64: -1, args: []decodeOp{xArgM16and32}},
+	{op: LGDT, code: "\x0f\x01", modrm: 2, is64: 1, args: []decodeOp{xArgM16and64}},
+	{op: LGS, code: "\x0f\xb5", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgM16colon16}},
+	{op: LGS, code: "\x0f\xb5", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgM16colon32}},
+	{op: LGS, code: "\x0f\xb5", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgM16colon64}},
+	{op: LIDT, code: "\x0f\x01", modrm: 3, is64: -1, args: []decodeOp{xArgM16and32}},
+	{op: LIDT, code: "\x0f\x01", modrm: 3, is64: 1, args: []decodeOp{xArgM16and64}},
+	{op: LJMP, code: "\xff", modrm: 5, dataSize: 16, args: []decodeOp{xArgM16colon16}},
+	{op: LJMP, code: "\xff", modrm: 5, dataSize: 32, args: []decodeOp{xArgM16colon32}},
+	{op: LJMP, code: "\xff", modrm: 5, is64: 1, dataSize: 64, args: []decodeOp{xArgM16colon64}},
+	{op: LJMP, code: "\xea", modrm: encNoModRM, is64: -1, dataSize: 16, read: []decodeOp{xReadCd}, args: []decodeOp{xArgPtr16colon16}},
+	{op: LJMP, code: "\xea", modrm: encNoModRM, is64: -1, dataSize: 32, read: []decodeOp{xReadCp}, args: []decodeOp{xArgPtr16colon32}},
+	{op: LLDT, code: "\x0f\x00", modrm: 2, args: []decodeOp{xArgRM16}},
+	{op: LMSW, code: "\x0f\x01", modrm: 6, args: []decodeOp{xArgRM16}},
+	{op: LODSB, code: "\xac", modrm: encNoModRM},
+	{op: LODSD, code: "\xad", modrm: encNoModRM, dataSize: 32},
+	{op: LODSQ, code: "\xad", modrm: encNoModRM, is64: 1, dataSize: 64},
+	{op: LODSW, code: "\xad", modrm: encNoModRM, dataSize: 16},
+	{op: LOOP, code: "\xe2", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: LOOPE, code: "\xe1", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: LOOPNE, code: "\xe0", modrm: encNoModRM, read: []decodeOp{xReadCb}, args: []decodeOp{xArgRel8}},
+	{op: LRET, code: "\xca", modrm: encNoModRM, read: []decodeOp{xReadIw}, args: []decodeOp{xArgImm16u}},
+	{op: LRET, code: "\xcb", modrm: encNoModRM},
+	{op: LSL, code: "\x0f\x03", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: LSL, code: "\x0f\x03", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgR32M16}},
+	{op: LSL, code: "\x0f\x03", modrm: encSlashR, dataSize: 64, args: []decodeOp{xArgR64, xArgR32M16}},
+	{op: LSS, code: "\x0f\xb2", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgM16colon16}},
+	{op: LSS, code: "\x0f\xb2", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgM16colon32}},
+	{op: LSS, code: "\x0f\xb2", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgM16colon64}},
+	{op: LTR, code: "\x0f\x00", modrm: 3, args: []decodeOp{xArgRM16}},
+	{op: LZCNT, prefix: 0xF3, code: "\x0f\xbd", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: LZCNT, prefix: 0xF3, code: "\x0f\xbd", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: LZCNT, prefix: 0xF3, code: "\x0f\xbd", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: MASKMOVDQU, prefix: 0x66, code: "\x0f\xf7", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2}},
+	{op: MASKMOVQ, code: "\x0f\xf7", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2}},
+	{op: MAXPD, prefix: 0x66, code: "\x0f\x5f", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: MAXPS, code: "\x0f\x5f", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: MAXSD, prefix: 0xF2, code: "\x0f\x5f", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: MAXSS, prefix: 0xF3, code: "\x0f\x5f", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: MFENCE, code: "\x0f\xae\xf0", modrm: encNoModRM},
+	{op: MINPD, prefix: 0x66, code: "\x0f\x5d", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: MINPS, code: "\x0f\x5d", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: MINSD, prefix: 0xF2, code: "\x0f\x5d", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: MINSS, prefix: 0xF3, code: "\x0f\x5d", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: MONITOR, code: "\x0f\x01\xc8", modrm: encNoModRM},
+	{op: MOV, code: "\xa0", modrm: encNoModRM, read: []decodeOp{xReadCm}, args: []decodeOp{xArgAL, xArgMoffs8}},
+	{op: MOV, code: "\xa0", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCm}, args: []decodeOp{xArgAL, xArgMoffs8}},
+	{op: MOV, code: "\xa1", modrm: encNoModRM, dataSize: 16, read: []decodeOp{xReadCm}, args: []decodeOp{xArgAX, xArgMoffs16}},
+	{op: MOV, code: "\x0f\x22", modrm: encSlashR, is64: -1, args: []decodeOp{xArgCR0dashCR7, xArgRmf32}},
+	{op: MOV, code: "\x0f\x22", modrm: encSlashR, is64: 1, args: []decodeOp{xArgCR0dashCR7, xArgRmf64}},
+	{op: MOV, code: "\x0f\x23", modrm: encSlashR, is64: -1, args: []decodeOp{xArgDR0dashDR7, xArgRmf32}},
+	{op: MOV, code: "\x0f\x23", modrm: encSlashR, is64: 1, args: []decodeOp{xArgDR0dashDR7, xArgRmf64}},
+	{op: MOV, code: "\xa1", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCm}, args: []decodeOp{xArgEAX, xArgMoffs32}},
+	{op: MOV, code: "\xa1", modrm: encNoModRM, dataSize: 64, read: []decodeOp{xReadCm}, args: []decodeOp{xArgRAX, xArgMoffs64}},
+	{op: MOV, code: "\x8e", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgSreg, xArgRM16}},
+	{op: MOV, code: "\x8e", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgSreg, xArgR32M16}},
+	{op: MOV, code: "\x8e", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgSreg, xArgR64M16}},
+	{op: MOV, code: "\x0f\x26", modrm: encSlashR, is64: -1, args: []decodeOp{xArgTR0dashTR7, xArgRmf32}},
+	{op: MOV, code: "\x0f\x26", modrm: encSlashR, is64: 1, args: []decodeOp{xArgTR0dashTR7, xArgRmf64}},
+	{op: MOV, code: "\xa3", modrm: encNoModRM, dataSize: 16, read: []decodeOp{xReadCm}, args: []decodeOp{xArgMoffs16, xArgAX}},
+	{op: MOV, code: "\xa3", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCm}, args: []decodeOp{xArgMoffs32, xArgEAX}},
+	{op: MOV, code: "\xa3", modrm: encNoModRM, dataSize: 64, read: []decodeOp{xReadCm}, args: []decodeOp{xArgMoffs64, xArgRAX}},
+	{op: MOV, code: "\xa2", modrm: encNoModRM, read: []decodeOp{xReadCm}, args: []decodeOp{xArgMoffs8, xArgAL}},
+	{op: MOV, code: "\xa2", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadCm}, args: []decodeOp{xArgMoffs8, xArgAL}},
+	{op: MOV, code: "\xc7", modrm: 0, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgRM16, xArgImm16}},
+	{op: MOV, code: "\x8c", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgSreg}},
+	{op: MOV, code: "\x8c", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64M16, xArgSreg}},
+	{op: MOV, code: "\x8c", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32M16, xArgSreg}},
+	{op: MOV, code: "\x89", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: MOV, code: "\xc7", modrm: 0, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM32, xArgImm32}},
+	{op: MOV, code: "\x89", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32}},
+	{op: MOV, code: "\xc7", modrm: 0, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM64, xArgImm32}},
+	{op: MOV, code: "\x89", modrm: encSlashR, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64}},
+	{op: MOV, code: "\xc6", modrm: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM8, xArgImm8u}},
+	{op: MOV, code: "\x88", modrm: encSlashR, args: []decodeOp{xArgRM8, xArgR8}},
+	{op: MOV, code: "\xb8", plus: true, modrm: encNoModRM, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgR16op, xArgImm16}},
+	{op: MOV, code: "\x8b", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: MOV, code: "\xb8", plus: true, modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgR32op, xArgImm32}},
+	{op: MOV, code: "\x8b", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: MOV, code: "\xb8", plus: true, modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadIo}, args: []decodeOp{xArgR64op, xArgImm64}},
+	{op: MOV, code: "\x8b", modrm: encSlashR, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: MOV, code: "\xb0", plus: true, modrm: encNoModRM, read: []decodeOp{xReadIb}, args: []decodeOp{xArgR8op, xArgImm8u}},
+	{op: MOV, code: "\x8a", modrm: encSlashR, args: []decodeOp{xArgR8, xArgRM8}},
+	{op: MOV, code: "\x0f\x20", modrm: encSlashR, is64: -1, args: []decodeOp{xArgRmf32, xArgCR0dashCR7}},
+	{op: MOV, code: "\x0f\x21", modrm: encSlashR, is64: -1, args: []decodeOp{xArgRmf32, xArgDR0dashDR7}},
+	{op: MOV, code: "\x0f\x24", modrm: encSlashR, is64: -1, args: []decodeOp{xArgRmf32, xArgTR0dashTR7}},
+	{op: MOV, code: "\x0f\x20", modrm: encSlashR, is64: 1, args: []decodeOp{xArgRmf64, xArgCR0dashCR7}},
+	{op: MOV, code: "\x0f\x21", modrm: encSlashR, is64: 1, args: []decodeOp{xArgRmf64, xArgDR0dashDR7}},
+	{op: MOV, code: "\x0f\x24", modrm: encSlashR, is64: 1, args: []decodeOp{xArgRmf64, xArgTR0dashTR7}},
+	{op: MOVAPD, prefix: 0x66, code: "\x0f\x28", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: MOVAPD, prefix: 0x66, code: "\x0f\x29", modrm: encSlashR, args: []decodeOp{xArgXmm2M128, xArgXmm1}},
+	{op: MOVAPS, code: "\x0f\x28", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: MOVAPS, code: "\x0f\x29", modrm: encSlashR, args: []decodeOp{xArgXmm2M128, xArgXmm1}},
+	{op: MOVBE, code: "\x0f\x38\xf1", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgM16, xArgR16}},
+	{op: MOVBE, code: "\x0f\x38\xf1", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgM32, xArgR32}},
+	{op: MOVBE, code: "\x0f\x38\xf1", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgM64, xArgR64}},
+	{op: MOVBE, code: "\x0f\x38\xf0", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgM16}},
+	{op: MOVBE, code: "\x0f\x38\xf0", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgM32}},
+	{op: MOVBE, code: "\x0f\x38\xf0", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgM64}},
+	{op: MOVD, code: "\x0f\x6e", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgMm, xArgRM32}},
+	{op: MOVD, code: "\x0f\x6e", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgMm, xArgRM32}},
+	{op: MOVD, code: "\x0f\x7e", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM32, xArgMm}},
+	{op: MOVD, code: "\x0f\x7e", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgMm}},
+	{op: MOVD, prefix: 0x66, code: "\x0f\x7e", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM32, xArgXmm}},
+	{op: MOVD, prefix: 0x66, code: "\x0f\x7e", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgXmm}},
+	{op: MOVD, prefix: 0x66, code: "\x0f\x6e", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgXmm, xArgRM32}},
+	{op: MOVD, prefix: 0x66, code: "\x0f\x6e", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgXmm, xArgRM32}},
+	{op: MOVDDUP, prefix: 0xF2, code: "\x0f\x12", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: MOVDQ2Q, prefix: 0xF2, code: "\x0f\xd6", modrm: encSlashR, args: []decodeOp{xArgMm, xArgXmm2}},
+	{op: MOVDQA, prefix: 0x66, code: "\x0f\x6f", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: MOVDQA, prefix: 0x66, code: "\x0f\x7f", modrm: encSlashR, args: []decodeOp{xArgXmm2M128, xArgXmm1}},
+	{op: MOVDQU, prefix: 0xF3, code: "\x0f\x6f", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: MOVDQU, prefix: 0xF3, code: "\x0f\x7f", modrm: encSlashR, args: []decodeOp{xArgXmm2M128, xArgXmm1}},
+	{op: MOVHLPS, code: "\x0f\x12", modrm: encSlashR, isMem: -1, args: []decodeOp{xArgXmm1, xArgXmm2}},
+	{op: MOVHPD, prefix: 0x66, code: "\x0f\x16", modrm: encSlashR, args: []decodeOp{xArgXmm, xArgXmm2M64}},
+	{op: MOVHPD, prefix: 0x66, code: "\x0f\x17", modrm: encSlashR, args: []decodeOp{xArgXmm2M64, xArgXmm}},
+	{op: MOVHPS, code: "\x0f\x17", modrm: encSlashR, args: []decodeOp{xArgM64, xArgXmm}},
+	{op: MOVHPS, code: "\x0f\x16", modrm: encSlashR, isMem: 1, args: []decodeOp{xArgXmm, xArgM64}},
+	{op: MOVLHPS, code: "\x0f\x16", modrm: encSlashR, isMem: -1, args: []decodeOp{xArgXmm1, xArgXmm2}},
+	{op: MOVLPD, prefix: 0x66, code: "\x0f\x12", modrm: encSlashR, args: []decodeOp{xArgXmm, xArgXmm2M64}},
+	{op: MOVLPD, prefix: 0x66, code: "\x0f\x13", modrm: encSlashR, args: []decodeOp{xArgXmm2M64, xArgXmm}},
+	{op: MOVLPS, code: "\x0f\x13", modrm: encSlashR, args: []decodeOp{xArgM64, xArgXmm}},
+	{op: MOVLPS, code: "\x0f\x12", modrm: encSlashR, isMem: 1, args: []decodeOp{xArgXmm, xArgM64}},
+	{op: MOVMSKPD, prefix: 0x66, code: "\x0f\x50", modrm: encSlashR, args: []decodeOp{xArgR32, xArgXmm2}},
+	{op: MOVMSKPS, code: "\x0f\x50", modrm: encSlashR, args: []decodeOp{xArgR32, xArgXmm2}},
+	{op: MOVNTDQ, prefix: 0x66, code: "\x0f\xe7", modrm: encSlashR, args: []decodeOp{xArgM128, xArgXmm}},
+	{op: MOVNTDQA, prefix: 0x66, code: "\x0f\x38\x2a", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgM128}},
+	{op: MOVNTI, code: "\x0f\xc3", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgM32, xArgR32}},
+	{op: MOVNTI, code: "\x0f\xc3", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgM32, xArgR32}},
+	{op: MOVNTI, code: "\x0f\xc3", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgM64, xArgR64}},
+	{op: MOVNTPD, prefix: 0x66, code: "\x0f\x2b", modrm: encSlashR, args: []decodeOp{xArgM128, xArgXmm}},
+	{op: MOVNTPS, code: "\x0f\x2b", modrm: encSlashR, args: []decodeOp{xArgM128, xArgXmm}},
+	{op: MOVNTQ, code: "\x0f\xe7", modrm: encSlashR, args: []decodeOp{xArgM64, xArgMm}},
+	{op: MOVNTSD, prefix: 0xF2, code: "\x0f\x2b", modrm: encSlashR, args: []decodeOp{xArgM64, xArgXmm}},
+	{op: MOVNTSS, prefix: 0xF3, code: "\x0f\x2b", modrm: encSlashR, args: []decodeOp{xArgM32, xArgXmm}},
+	{op: MOVQ, code: "\x0f\x6f", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: MOVQ, code: "\x0f\x6e", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgMm, xArgRM64}},
+	{op: MOVQ, code: "\x0f\x7f", modrm: encSlashR, args: []decodeOp{xArgMmM64, xArgMm}},
+	{op: MOVQ, code: "\x0f\x7e", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgMm}},
+	{op: MOVQ, prefix: 0x66, code: "\x0f\x7e", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgXmm}},
+	{op: MOVQ, prefix: 0x66, code: "\x0f\x6e", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgXmm, xArgRM64}},
+	{op: MOVQ, prefix: 0xF3, code: "\x0f\x7e", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: MOVQ, prefix: 0x66, code: "\x0f\xd6", modrm: encSlashR, args: []decodeOp{xArgXmm2M64, xArgXmm1}},
+	{op: MOVQ2DQ, prefix: 0xF3, code: "\x0f\xd6", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgMm2}},
+	{op: MOVSB, code: "\xa4", modrm: encNoModRM},
+	{op: MOVSD, code: "\xa5", modrm: encNoModRM, dataSize: 32},
+	{op: MOVSD_XMM, prefix: 0xF2, code: "\x0f\x10", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: MOVSD_XMM, prefix: 0xF2, code: "\x0f\x11", modrm: encSlashR, args: []decodeOp{xArgXmm2M64, xArgXmm1}},
+	{op: MOVSHDUP, prefix: 0xF3, code: "\x0f\x16", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: MOVSLDUP, prefix: 0xF3, code: "\x0f\x12", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: MOVSQ, code: "\xa5", modrm: encNoModRM, is64: 1, dataSize: 64},
+	{op: MOVSS, prefix: 0xF3, code: "\x0f\x10", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: MOVSS, prefix: 0xF3, code: "\x0f\x11", modrm: encSlashR, args: []decodeOp{xArgXmm2M32, xArgXmm}},
+	{op: MOVSW, code: "\xa5", modrm: encNoModRM, dataSize: 16},
+	{op: MOVSX, code: "\x0f\xbf", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: MOVSX, code: "\x0f\xbe", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM8}},
+	{op: MOVSX, code: "\x0f\xbf", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM16}},
+	{op: MOVSX, code: "\x0f\xbe", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM8}},
+	{op: MOVSX, code: "\x0f\xbf", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM16}},
+	{op: MOVSX, code: "\x0f\xbe", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM8}},
+	{op: MOVSXD, code: "\x63", modrm: encSlashR, is64: 1, dataSize: 16, args: []decodeOp{xArgR16, xArgRM32}},
+	{op: MOVSXD, code: "\x63", modrm: encSlashR, is64: 1, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: MOVSXD, code: "\x63", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM32}},
+	{op: MOVUPD, prefix: 0x66, code: "\x0f\x10", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: MOVUPD, prefix: 0x66, code: "\x0f\x11", modrm: encSlashR, args: []decodeOp{xArgXmm2M128, xArgXmm}},
+	{op: MOVUPS, code: "\x0f\x10", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: MOVUPS, code: "\x0f\x11", modrm: encSlashR, args: []decodeOp{xArgXmm2M128, xArgXmm1}},
+	{op: MOVZX, code: "\x0f\xb7", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: MOVZX, code: "\x0f\xb6", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM8}},
+	{op: MOVZX, code: "\x0f\xb7", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM16}},
+	{op: MOVZX, code: "\x0f\xb6", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM8}},
+	{op: MOVZX, code: "\x0f\xb7", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM16}},
+	{op: MOVZX, code: "\x0f\xb6", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM8}},
+	{op: MPSADBW, prefix: 0x66, code: "\x0f\x3a\x42", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: MUL, code: "\xf7", modrm: 4, dataSize: 16, args: []decodeOp{xArgRM16}},
+	{op: MUL, code: "\xf7", modrm: 4, dataSize: 32, args: []decodeOp{xArgRM32}},
+	{op: MUL, code: "\xf7", modrm: 4, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64}},
+	{op: MUL, code: "\xf6", modrm: 4, args: []decodeOp{xArgRM8}},
+	{op: MULPD, prefix: 0x66, code: "\x0f\x59", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: MULPS, code: "\x0f\x59", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: MULSD, prefix: 0xF2, code: "\x0f\x59", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: MULSS, prefix: 0xF3, code: "\x0f\x59", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: MULX, vex: 0xf202, code: "\xf6", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, args: []decodeOp{xArgR32, xArgR32V, xArgRM32}},
+	{op: MULX, vex: 0xf202, code: "\xf6", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, args: []decodeOp{xArgR64, xArgR64V, xArgRM64}},
+	{op: MWAIT, code: "\x0f\x01\xc9", modrm: encNoModRM},
+	{op: NEG, code: "\xf7", modrm: 3, dataSize: 16, args: []decodeOp{xArgRM16}},
+	{op: NEG, code: "\xf7", modrm: 3, dataSize: 32, args: []decodeOp{xArgRM32}},
+	{op: NEG, code: "\xf7", modrm: 3, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64}},
+	{op: NEG, code: "\xf6", modrm: 3, args: []decodeOp{xArgRM8}},
+	{op: NOP, code: "\x0f\x1f", modrm: 0, dataSize: 16, args: []decodeOp{xArgRM16}},
+	{op: NOP, code: "\x0f\x1f", modrm: 0, dataSize: 32, args: []decodeOp{xArgRM32}},
+	{op: NOT, code: "\xf7", modrm: 2, dataSize: 16, args: []decodeOp{xArgRM16}},
+	{op: NOT, code: "\xf7", modrm: 2, dataSize: 32, args: []decodeOp{xArgRM32}},
+	{op: NOT, code: "\xf7", modrm: 2, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64}},
+	{op: NOT, code: "\xf6", modrm: 2, args: []decodeOp{xArgRM8}},
+	{op: OR, code: "\x0c", modrm: encNoModRM, read: []decodeOp{xReadIb}, args: []decodeOp{xArgAL, xArgImm8u}},
+	{op: OR, code: "\x0d", modrm: encNoModRM, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgAX, xArgImm16}},
+	{op: OR, code: "\x0d", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgEAX, xArgImm32}},
+	{op: OR, code: "\x0d", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRAX, xArgImm32}},
+	{op: OR, code: "\x81", modrm: 1, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgRM16, xArgImm16}},
+	{op: OR, code: "\x83", modrm: 1, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8}},
+	{op: OR, code: "\x09", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: OR, code: "\x81", modrm: 1, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM32, xArgImm32}},
+	{op: OR, code: "\x83", modrm: 1, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8}},
+	{op: OR, code: "\x09", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32}},
+	{op: OR, code: "\x81", modrm: 1, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM64, xArgImm32}},
+	{op: OR, code: "\x83", modrm: 1, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8}},
+	{op: OR, code: "\x09", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64}},
+	{op: OR, code: "\x80", modrm: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM8, xArgImm8u}},
+	{op: OR, code: "\x08", modrm: encSlashR, args: []decodeOp{xArgRM8, xArgR8}},
+	{op: OR, code: "\x0b", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: OR, code: "\x0b", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: OR, code: "\x0b", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: OR, code: "\x0a", modrm: encSlashR, args: []decodeOp{xArgR8, xArgRM8}},
+	{op: ORPD, prefix: 0x66, code: "\x0f\x56", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: ORPS, code: "\x0f\x56", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: OUT, code: "\xee", modrm: encNoModRM, args: []decodeOp{xArgDX, xArgAL}},
+	{op: OUT, code: "\xef", modrm: encNoModRM, dataSize: 16, args: []decodeOp{xArgDX, xArgAX}},
+	{op: OUT, code: "\xef", modrm: encNoModRM, dataSize: 32, args: []decodeOp{xArgDX, xArgEAX}},
+	{op: OUT, code: "\xef", modrm: encNoModRM, dataSize: 64, args: []decodeOp{xArgDX, xArgEAX}},
+	{op: OUT, code: "\xe6", modrm: encNoModRM, read: []decodeOp{xReadIb}, args: []decodeOp{xArgImm8u, xArgAL}},
+	{op: OUT, code: "\xe7", modrm: encNoModRM, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgImm8u, xArgAX}},
+	{op: OUT, code: "\xe7", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgImm8u, xArgEAX}},
+	{op: OUT, code: "\xe7", modrm: encNoModRM, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgImm8u, xArgEAX}},
+	{op: OUTSB, code: "\x6e", modrm: encNoModRM},
+	{op: OUTSD, code: "\x6f", modrm: encNoModRM, dataSize: 32},
+	{op: OUTSD, code: "\x6f", modrm: encNoModRM, dataSize: 64},
+	{op: OUTSW, code: "\x6f", modrm: encNoModRM, dataSize: 16},
+	{op: PABSB, code: "\x0f\x38\x1c", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PABSB, prefix: 0x66, code: "\x0f\x38\x1c", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PABSD, code: "\x0f\x38\x1e", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PABSD, prefix: 0x66, code: "\x0f\x38\x1e", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PABSW, code: "\x0f\x38\x1d", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PABSW, prefix: 0x66, code: "\x0f\x38\x1d", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PACKSSDW, code: "\x0f\x6b", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PACKSSDW, prefix: 0x66, code: "\x0f\x6b", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PACKSSWB, code: "\x0f\x63", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PACKSSWB, prefix: 0x66, code: "\x0f\x63", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PACKUSDW, prefix: 0x66, code: "\x0f\x38\x2b", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PACKUSWB, code: "\x0f\x67", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PACKUSWB, prefix: 0x66, code: "\x0f\x67", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PADDB, code: "\x0f\xfc", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PADDB, prefix: 0x66, code: "\x0f\xfc", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PADDD, code: "\x0f\xfe", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PADDD, prefix: 0x66, code: "\x0f\xfe", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PADDQ, code: "\x0f\xd4", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PADDQ, prefix: 0x66, code: "\x0f\xd4", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PADDSB, code: "\x0f\xec", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PADDSB, prefix: 0x66, code: "\x0f\xec", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PADDSW, code: "\x0f\xed", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PADDSW, prefix: 0x66, code: "\x0f\xed", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PADDUSB, code: "\x0f\xdc", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PADDUSB, prefix: 0x66, code: "\x0f\xdc", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PADDUSW, code: "\x0f\xdd", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PADDUSW, prefix: 0x66, code: "\x0f\xdd", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PADDW, code: "\x0f\xfd", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PADDW, prefix: 0x66, code: "\x0f\xfd", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PALIGNR, code: "\x0f\x3a\x0f", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgMm1, xArgMm2M64, xArgImm8u}},
+	{op: PALIGNR, prefix: 0x66, code: "\x0f\x3a\x0f", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: PAND, code: "\x0f\xdb", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PAND, prefix: 0x66, code: "\x0f\xdb", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PANDN, code: "\x0f\xdf", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PANDN, prefix: 0x66, code: "\x0f\xdf", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PAVGB, code: "\x0f\xe0", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PAVGB, prefix: 0x66, code: "\x0f\xe0", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PAVGW, code: "\x0f\xe3", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PAVGW, prefix: 0x66, code: "\x0f\xe3", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PBLENDVB, prefix: 0x66, code: "\x0f\x38\x10", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgXMM0}},
+	{op: PBLENDW, prefix: 0x66, code: "\x0f\x3a\x0e", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: PCLMULQDQ, prefix: 0x66, code: "\x0f\x3a\x44", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: PCMPEQB, code: "\x0f\x74", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PCMPEQB, prefix: 0x66, code: "\x0f\x74", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PCMPEQD, code: "\x0f\x76", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PCMPEQD, prefix: 0x66, code: "\x0f\x76", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PCMPEQQ, prefix: 0x66, code: "\x0f\x38\x29", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PCMPEQW, code: "\x0f\x75", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PCMPEQW, prefix: 0x66, code: "\x0f\x75", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PCMPESTRI, prefix: 0x66, code: "\x0f\x3a\x61", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: PCMPESTRM, prefix: 0x66, code: "\x0f\x3a\x60", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: PCMPGTB, code: "\x0f\x64", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PCMPGTB, prefix: 0x66, code: "\x0f\x64", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PCMPGTD, code: "\x0f\x66", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PCMPGTD, prefix: 0x66, code: "\x0f\x66", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PCMPGTQ, prefix: 0x66, code: "\x0f\x38\x37", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PCMPGTW, code: "\x0f\x65", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PCMPGTW, prefix: 0x66, code: "\x0f\x65", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PCMPISTRI, prefix: 0x66, code: "\x0f\x3a\x63", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: PCMPISTRM, prefix: 0x66, code: "\x0f\x3a\x62", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: PDEP, vex: 0xf202, code: "\xf5", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, args: []decodeOp{xArgR32, xArgR32V, xArgRM32}},
+	{op: PDEP, vex: 0xf202, code: "\xf5", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, args: []decodeOp{xArgR64, xArgR64V, xArgRM64}},
+	{op: PEXT, vex: 0xf302, code: "\xf5", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, args: []decodeOp{xArgR32, xArgR32V, xArgRM32}},
+	{op: PEXT, vex: 0xf302, code: "\xf5", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, args: []decodeOp{xArgR64, xArgR64V, xArgRM64}},
+	{op: PEXTRB, prefix: 0x66, code: "\x0f\x3a\x14", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgR32M8, xArgXmm1, xArgImm8u}},
+	{op: PEXTRD, prefix: 0x66, code: "\x0f\x3a\x16", modrm: encSlashR, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgXmm1, xArgImm8u}},
+	{op: PEXTRD, prefix: 0x66, code: "\x0f\x3a\x16", modrm: encSlashR, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgXmm1, xArgImm8u}},
+	{op: PEXTRQ, prefix: 0x66, code: "\x0f\x3a\x16", modrm: encSlashR, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgXmm1, xArgImm8u}},
+	{op: PEXTRW, code: "\x0f\xc5", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgR32, xArgMm2, xArgImm8u}},
+	{op: PEXTRW, prefix: 0x66, code: "\x0f\xc5", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgR32, xArgXmm2, xArgImm8u}},
+	{op: PEXTRW, prefix: 0x66, code: "\x0f\x3a\x15", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgR32M16, xArgXmm1, xArgImm8u}},
+	{op: PHADDD, code: "\x0f\x38\x02", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PHADDD, prefix: 0x66, code: "\x0f\x38\x02", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PHADDSW, code: "\x0f\x38\x03", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PHADDSW, prefix: 0x66, code: "\x0f\x38\x03", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PHADDW, code: "\x0f\x38\x01", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PHADDW, prefix: 0x66, code: "\x0f\x38\x01", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PHMINPOSUW, prefix: 0x66, code: "\x0f\x38\x41", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PHSUBD, code: "\x0f\x38\x06", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PHSUBD, prefix: 0x66, code: "\x0f\x38\x06", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PHSUBSW, code: "\x0f\x38\x07", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PHSUBSW, prefix: 0x66, code: "\x0f\x38\x07", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PHSUBW, code: "\x0f\x38\x05", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PHSUBW, prefix: 0x66, code: "\x0f\x38\x05", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PINSRB, prefix: 0x66, code: "\x0f\x3a\x20", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgR32M8, xArgImm8u}},
+	{op: PINSRD, prefix: 0x66, code: "\x0f\x3a\x22", modrm: encSlashR, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgRM32, xArgImm8u}},
+	{op: PINSRD, prefix: 0x66, code: "\x0f\x3a\x22", modrm: encSlashR, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgRM32, xArgImm8u}},
+	{op: PINSRQ, prefix: 0x66, code: "\x0f\x3a\x22", modrm: encSlashR, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgRM64, xArgImm8u}},
+	{op: PINSRW, code: "\x0f\xc4", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgMm, xArgR32M16, xArgImm8u}},
+	{op: PINSRW, prefix: 0x66, code: "\x0f\xc4", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm, xArgR32M16, xArgImm8u}},
+	{op: PMADDUBSW, code: "\x0f\x38\x04", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PMADDUBSW, prefix: 0x66, code: "\x0f\x38\x04", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMADDWD, code: "\x0f\xf5", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PMADDWD, prefix: 0x66, code: "\x0f\xf5", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMAXSB, prefix: 0x66, code: "\x0f\x38\x3c", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMAXSD, prefix: 0x66, code: "\x0f\x38\x3d", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMAXSW, code: "\x0f\xee", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PMAXSW, prefix: 0x66, code: "\x0f\xee", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMAXUB, code: "\x0f\xde", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PMAXUB, prefix: 0x66, code: "\x0f\xde", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMAXUD, prefix: 0x66, code: "\x0f\x38\x3f", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMAXUW, prefix: 0x66, code: "\x0f\x38\x3e", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMINSB, prefix: 0x66, code: "\x0f\x38\x38", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMINSD, prefix: 0x66, code: "\x0f\x38\x39", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMINSW, code: "\x0f\xea", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PMINSW, prefix: 0x66, code: "\x0f\xea", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMINUB, code: "\x0f\xda", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PMINUB, prefix: 0x66, code: "\x0f\xda", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMINUD, prefix: 0x66, code: "\x0f\x38\x3b", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMINUW, prefix: 0x66, code: "\x0f\x38\x3a", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMOVMSKB, code: "\x0f\xd7", modrm: encSlashR, args: []decodeOp{xArgR32, xArgMm2}},
+	{op: PMOVMSKB, prefix: 0x66, code: "\x0f\xd7", modrm: encSlashR, args: []decodeOp{xArgR32, xArgXmm2}},
+	{op: PMOVSXBD, prefix: 0x66, code: "\x0f\x38\x21", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: PMOVSXBQ, prefix: 0x66, code: "\x0f\x38\x22", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M16}},
+	{op: PMOVSXBW, prefix: 0x66, code: "\x0f\x38\x20", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: PMOVSXDQ, prefix: 0x66, code: "\x0f\x38\x25", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: PMOVSXWD, prefix: 0x66, code: "\x0f\x38\x23", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: PMOVSXWQ, prefix: 0x66, code: "\x0f\x38\x24", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: PMOVZXBD, prefix: 0x66, code: "\x0f\x38\x31", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: PMOVZXBQ, prefix: 0x66, code: "\x0f\x38\x32", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M16}},
+	{op: PMOVZXBW, prefix: 0x66, code: "\x0f\x38\x30", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: PMOVZXDQ, prefix: 0x66, code: "\x0f\x38\x35", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: PMOVZXWD, prefix: 0x66, code: "\x0f\x38\x33", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: PMOVZXWQ, prefix: 0x66, code: "\x0f\x38\x34", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: PMULDQ, prefix: 0x66, code: "\x0f\x38\x28", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMULHRSW, code: "\x0f\x38\x0b", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PMULHRSW, prefix: 0x66, code: "\x0f\x38\x0b", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMULHUW, code: "\x0f\xe4", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PMULHUW, prefix: 0x66, code: "\x0f\xe4", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMULHW, code: "\x0f\xe5", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PMULHW, prefix: 0x66, code: "\x0f\xe5", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMULLD, prefix: 0x66, code: "\x0f\x38\x40", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMULLW, code: "\x0f\xd5", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PMULLW, prefix: 0x66, code: "\x0f\xd5", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PMULUDQ, code: "\x0f\xf4", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PMULUDQ, prefix: 0x66, code: "\x0f\xf4", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: POP, code: "\x1f", modrm: encNoModRM, is64: -1, args: []decodeOp{xArgDS}},
+	{op: POP, code: "\x07", modrm: encNoModRM, is64: -1, args: []decodeOp{xArgES}},
+	{op: POP, code: "\x0f\xa1", modrm: encNoModRM, is64: 1, dataSize: 32, args: []decodeOp{xArgFS}},
+	{op: POP, code: "\x0f\xa1", modrm: encNoModRM, is64: 1, dataSize: 64, args: []decodeOp{xArgFS}},
+	{op: POP, code: "\x0f\xa1", modrm: encNoModRM, is64: -1, dataSize: 32, args: []decodeOp{xArgFS}},
+	{op: POP, code: "\x0f\xa1", modrm: encNoModRM, dataSize: 16, args: []decodeOp{xArgFS}},
+	{op: POP, code: "\x0f\xa9", modrm: encNoModRM, is64: 1, dataSize: 32, args: []decodeOp{xArgGS}},
+	{op: POP, code: "\x0f\xa9", modrm: encNoModRM, is64: 1, dataSize: 64, args: []decodeOp{xArgGS}},
+	{op: POP, code: "\x0f\xa9", modrm: encNoModRM, is64: -1, dataSize: 32, args: []decodeOp{xArgGS}},
+	{op: POP, code: "\x0f\xa9", modrm: encNoModRM, dataSize: 16, args: []decodeOp{xArgGS}},
+	{op: POP, code: "\x17", modrm: encNoModRM, is64: -1, args: []decodeOp{xArgSS}},
+	{op: POP, code: "\x8f", modrm: 0, dataSize: 16, args: []decodeOp{xArgRM16}},
+	{op: POP, code: "\x8f", modrm: 0, is64: -1, dataSize: 32, args: []decodeOp{xArgRM32}},
+	{op: POP, code: "\x8f", modrm: 0, is64: 1, dataSize: 32, args: []decodeOp{xArgRM64}},
+	{op: POP, code: "\x8f", modrm: 0, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64}},
+	{op: POP, code: "\x58", plus: true, modrm: encNoModRM, dataSize: 16, args: []decodeOp{xArgR16op}},
+	{op: POP, code: "\x58", plus: true, modrm: encNoModRM, is64: -1, dataSize: 32, args: []decodeOp{xArgR32op}},
+	{op: POP, code: "\x58", plus: true, modrm: encNoModRM, is64: 1, dataSize: 32, args: []decodeOp{xArgR64op}},
+	{op: POP, code: "\x58", plus: true, modrm: encNoModRM, is64: 1, dataSize: 64, args: []decodeOp{xArgR64op}},
+	{op: POPA, code: "\x61", modrm: encNoModRM, is64: -1, dataSize: 16},
+	{op: POPAD, code: "\x61", modrm: encNoModRM, is64: -1, dataSize: 32},
+	{op: POPCNT, prefix: 0xF3, code: "\x0f\xb8", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: POPCNT, prefix: 0xF3, code: "\x0f\xb8", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: POPCNT, prefix: 0xF3, code: "\x0f\xb8", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: POPF, code: "\x9d", modrm: encNoModRM, dataSize: 16},
+	{op: POPFD, code: "\x9d", modrm: encNoModRM, is64: -1, dataSize: 32},
+	{op: POPFQ, code: "\x9d", modrm: encNoModRM, is64: 1, dataSize: 32},
+	{op: POPFQ, code: "\x9d", modrm: encNoModRM, is64: 1, dataSize: 64},
+	{op: POR, code: "\x0f\xeb", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: POR, prefix: 0x66, code: "\x0f\xeb", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PREFETCHNTA, code: "\x0f\x18", modrm: 0, args: []decodeOp{xArgM8}},
+	{op: PREFETCHT0, code: "\x0f\x18", modrm: 1, args: []decodeOp{xArgM8}},
+	{op: PREFETCHT1, code: "\x0f\x18", modrm: 2, args: []decodeOp{xArgM8}},
+	{op: PREFETCHT2, code: "\x0f\x18", modrm: 3, args: []decodeOp{xArgM8}},
+	{op: PREFETCHW, code: "\x0f\x0d", modrm: 1, args: []decodeOp{xArgM8}},
+	{op: PSADBW, code: "\x0f\xf6", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PSADBW, prefix: 0x66, code: "\x0f\xf6", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSHUFB, code: "\x0f\x38\x00", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PSHUFB, prefix: 0x66, code: "\x0f\x38\x00", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSHUFD, prefix: 0x66, code: "\x0f\x70", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: PSHUFHW, prefix: 0xF3, code: "\x0f\x70", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: PSHUFLW, prefix: 0xF2, code: "\x0f\x70", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: PSHUFW, code: "\x0f\x70", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgMm1, xArgMm2M64, xArgImm8u}},
+	{op: PSIGNB, code: "\x0f\x38\x08", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PSIGNB, prefix: 0x66, code: "\x0f\x38\x08", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSIGND, code: "\x0f\x38\x0a", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PSIGND, prefix: 0x66, code: "\x0f\x38\x0a", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSIGNW, code: "\x0f\x38\x09", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PSIGNW, prefix: 0x66, code: "\x0f\x38\x09", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSLLD, code: "\x0f\xf2", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PSLLD, code: "\x0f\x72", modrm: 6, read: []decodeOp{xReadIb}, args: []decodeOp{xArgMm2, xArgImm8u}},
+	{op: PSLLD, prefix: 0x66, code: "\x0f\xf2", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSLLD, prefix: 0x66, code: "\x0f\x72", modrm: 6, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2, xArgImm8u}},
+	{op: PSLLDQ, prefix: 0x66, code: "\x0f\x73", modrm: 7, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2, xArgImm8u}},
+	{op: PSLLQ, code: "\x0f\xf3", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PSLLQ, code: "\x0f\x73", modrm: 6, read: []decodeOp{xReadIb}, args: []decodeOp{xArgMm2, xArgImm8u}},
+	{op: PSLLQ, prefix: 0x66, code: "\x0f\xf3", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSLLQ, prefix: 0x66, code: "\x0f\x73", modrm: 6, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2, xArgImm8u}},
+	{op: PSLLW, code: "\x0f\xf1", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PSLLW, code: "\x0f\x71", modrm: 6, read: []decodeOp{xReadIb}, args: []decodeOp{xArgMm2, xArgImm8u}},
+	{op: PSLLW, prefix: 0x66, code: "\x0f\xf1", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSLLW, prefix: 0x66, code: "\x0f\x71", modrm: 6, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2, xArgImm8u}},
+	{op: PSRAD, code: "\x0f\xe2", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PSRAD, code: "\x0f\x72", modrm: 4, read: []decodeOp{xReadIb}, args: []decodeOp{xArgMm2, xArgImm8u}},
+	{op: PSRAD, prefix: 0x66, code: "\x0f\xe2", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSRAD, prefix: 0x66, code: "\x0f\x72", modrm: 4, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2, xArgImm8u}},
+	{op: PSRAW, code: "\x0f\xe1", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PSRAW, code: "\x0f\x71", modrm: 4, read: []decodeOp{xReadIb}, args: []decodeOp{xArgMm2, xArgImm8u}},
+	{op: PSRAW, prefix: 0x66, code: "\x0f\xe1", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSRAW, prefix: 0x66, code: "\x0f\x71", modrm: 4, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2, xArgImm8u}},
+	{op: PSRLD, code: "\x0f\xd2", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PSRLD, code: "\x0f\x72", modrm: 2, read: []decodeOp{xReadIb}, args: []decodeOp{xArgMm2, xArgImm8u}},
+	{op: PSRLD, prefix: 0x66, code: "\x0f\xd2", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSRLD, prefix: 0x66, code: "\x0f\x72", modrm: 2, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2, xArgImm8u}},
+	{op: PSRLDQ, prefix: 0x66, code: "\x0f\x73", modrm: 3, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2, xArgImm8u}},
+	{op: PSRLQ, code: "\x0f\xd3", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PSRLQ, code: "\x0f\x73", modrm: 2, read: []decodeOp{xReadIb}, args: []decodeOp{xArgMm2, xArgImm8u}},
+	{op: PSRLQ, prefix: 0x66, code: "\x0f\xd3", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSRLQ, prefix: 0x66, code: "\x0f\x73", modrm: 2, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2, xArgImm8u}},
+	{op: PSRLW, code: "\x0f\xd1", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PSRLW, code: "\x0f\x71", modrm: 2, read: []decodeOp{xReadIb}, args: []decodeOp{xArgMm2, xArgImm8u}},
+	{op: PSRLW, prefix: 0x66, code: "\x0f\xd1", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSRLW, prefix: 0x66, code: "\x0f\x71", modrm: 2, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2, xArgImm8u}},
+	{op: PSUBB, code: "\x0f\xf8", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PSUBB, prefix: 0x66, code: "\x0f\xf8", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSUBD, code: "\x0f\xfa", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PSUBD, prefix: 0x66, code: "\x0f\xfa", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSUBQ, code: "\x0f\xfb", modrm: encSlashR, args: []decodeOp{xArgMm1, xArgMm2M64}},
+	{op: PSUBQ, prefix: 0x66, code: "\x0f\xfb", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSUBSB, code: "\x0f\xe8", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PSUBSB, prefix: 0x66, code: "\x0f\xe8", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSUBSW, code: "\x0f\xe9", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PSUBSW, prefix: 0x66, code: "\x0f\xe9", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSUBUSB, code: "\x0f\xd8", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PSUBUSB, prefix: 0x66, code: "\x0f\xd8", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSUBUSW, code: "\x0f\xd9", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PSUBUSW, prefix: 0x66, code: "\x0f\xd9", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PSUBW, code: "\x0f\xf9", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PSUBW, prefix: 0x66, code: "\x0f\xf9", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PTEST, prefix: 0x66, code: "\x0f\x38\x17", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PUNPCKHBW, code: "\x0f\x68", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PUNPCKHBW, prefix: 0x66, code: "\x0f\x68", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PUNPCKHDQ, code: "\x0f\x6a", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PUNPCKHDQ, prefix: 0x66, code: "\x0f\x6a", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PUNPCKHQDQ, prefix: 0x66, code: "\x0f\x6d", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PUNPCKHWD, code: "\x0f\x69", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PUNPCKHWD, prefix: 0x66, code: "\x0f\x69", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PUNPCKLBW, code: "\x0f\x60", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM32}},
+	{op: PUNPCKLBW, prefix: 0x66, code: "\x0f\x60", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PUNPCKLDQ, code: "\x0f\x62", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM32}},
+	{op: PUNPCKLDQ, prefix: 0x66, code: "\x0f\x62", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PUNPCKLQDQ, prefix: 0x66, code: "\x0f\x6c", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PUNPCKLWD, code: "\x0f\x61", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM32}},
+	{op: PUNPCKLWD, prefix: 0x66, code: "\x0f\x61", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: PUSH, code: "\x0e", modrm: encNoModRM, is64: -1, args: []decodeOp{xArgCS}},
+	{op: PUSH, code: "\x1e", modrm: encNoModRM, is64: -1, args: []decodeOp{xArgDS}},
+	{op: PUSH, code: "\x06", modrm: encNoModRM, is64: -1, args: []decodeOp{xArgES}},
+	{op: PUSH, code: "\x0f\xa0", modrm: encNoModRM, args: []decodeOp{xArgFS}},
+	{op: PUSH, code: "\x0f\xa8", modrm: encNoModRM, args: []decodeOp{xArgGS}},
+	{op: PUSH, code: "\x16", modrm: encNoModRM, is64: -1, args: []decodeOp{xArgSS}},
+	{op: PUSH, code: "\x68", modrm: encNoModRM, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgImm16}},
+	{op: PUSH, code: "\x68", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgImm32}},
+	{op: PUSH, code: "\x68", modrm: encNoModRM, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgImm32}},
+	{op: PUSH, code: "\x6a", modrm: encNoModRM, read: []decodeOp{xReadIb}, args: []decodeOp{xArgImm8}},
+	{op: PUSH, code: "\xff", modrm: 6, dataSize: 16, args: []decodeOp{xArgRM16}},
+	{op: PUSH, code: "\xff", modrm: 6, is64: -1, dataSize: 32, args: []decodeOp{xArgRM32}},
+	{op: PUSH, code: "\xff", modrm: 6, is64: 1, dataSize: 32, args: []decodeOp{xArgRM64}},
+	{op: PUSH, code: "\xff", modrm: 6, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64}},
+	{op: PUSH, code: "\x50", plus: true, modrm: encNoModRM, dataSize: 16, args: []decodeOp{xArgR16op}},
+	{op: PUSH, code: "\x50", plus: true, modrm: encNoModRM, is64: -1, dataSize: 32, args: []decodeOp{xArgR32op}},
+	{op: PUSH, code: "\x50", plus: true, modrm: encNoModRM, is64: 1, dataSize: 32, args: []decodeOp{xArgR64op}},
+	{op: PUSH, code: "\x50", plus: true, modrm: encNoModRM, is64: 1, dataSize: 64, args: []decodeOp{xArgR64op}},
+	{op: PUSHA, code: "\x60", modrm: encNoModRM, is64: -1, dataSize: 16},
+	{op: PUSHAD, code: "\x60", modrm: encNoModRM, is64: -1, dataSize: 32},
+	{op: PUSHF, code: "\x9c", modrm: encNoModRM, dataSize: 16},
+	{op: PUSHFD, code: "\x9c", modrm: encNoModRM, is64: -1, dataSize: 32},
+	{op: PUSHFQ, code: "\x9c", modrm: encNoModRM, is64: 1, dataSize: 32},
+	{op: PUSHFQ, code: "\x9c", modrm: encNoModRM, is64: 1, dataSize: 64},
+	{op: PXOR, code: "\x0f\xef", modrm: encSlashR, args: []decodeOp{xArgMm, xArgMmM64}},
+	{op: PXOR, prefix: 0x66, code: "\x0f\xef", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: RCL, code: "\xd1", modrm: 2, dataSize: 16, args: []decodeOp{xArgRM16, xArg1}},
+	{op: RCL, code: "\xd3", modrm: 2, dataSize: 16, args: []decodeOp{xArgRM16, xArgCL}},
+	{op: RCL, code: "\xc1", modrm: 2, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8u}},
+	{op: RCL, code: "\xd1", modrm: 2, dataSize: 32, args: []decodeOp{xArgRM32, xArg1}},
+	{op: RCL, code: "\xd3", modrm: 2, dataSize: 32, args: []decodeOp{xArgRM32, xArgCL}},
+	{op: RCL, code: "\xc1", modrm: 2, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8u}},
+	{op: RCL, code: "\xd1", modrm: 2, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArg1}},
+	{op: RCL, code: "\xd3", modrm: 2, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgCL}},
+	{op: RCL, code: "\xc1", modrm: 2, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8u}},
+	{op: RCL, code: "\xd0", modrm: 2, args: []decodeOp{xArgRM8, xArg1}},
+	{op: RCL, code: "\xd2", modrm: 2, args: []decodeOp{xArgRM8, xArgCL}},
+	{op: RCL, code: "\xc0", modrm: 2, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM8, xArgImm8u}},
+	{op: RCPPS, code: "\x0f\x53", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: RCPSS, prefix: 0xF3, code: "\x0f\x53", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: RCR, code: "\xd1", modrm: 3, dataSize: 16, args: []decodeOp{xArgRM16, xArg1}},
+	{op: RCR, code: "\xd3", modrm: 3, dataSize: 16, args: []decodeOp{xArgRM16, xArgCL}},
+	{op: RCR, code: "\xc1", modrm: 3, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8u}},
+	{op: RCR, code: "\xd1", modrm: 3, dataSize: 32, args: []decodeOp{xArgRM32, xArg1}},
+	{op: RCR, code: "\xd3", modrm: 3, dataSize: 32, args: []decodeOp{xArgRM32, xArgCL}},
+	{op: RCR, code: "\xc1", modrm: 3, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8u}},
+	{op: RCR, code: "\xd1", modrm: 3, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArg1}},
+	{op: RCR, code: "\xd3", modrm: 3, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgCL}},
+	{op: RCR, code: "\xc1", modrm: 3, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8u}},
+	{op: RCR, code: "\xd0", modrm: 3, args: []decodeOp{xArgRM8, xArg1}},
+	{op: RCR, code: "\xd2", modrm: 3, args: []decodeOp{xArgRM8, xArgCL}},
+	{op: RCR, code: "\xc0", modrm: 3, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM8, xArgImm8u}},
+	{op: RDFSBASE, prefix: 0xF3, code: "\x0f\xae", modrm: 0, is64: 1, dataSize: 16, isMem: -1, args: []decodeOp{xArgRM32}},
+	{op: RDFSBASE, prefix: 0xF3, code: "\x0f\xae", modrm: 0, is64: 1, dataSize: 32, isMem: -1, args: []decodeOp{xArgRM32}},
+	{op: RDFSBASE, prefix: 0xF3, code: "\x0f\xae", modrm: 0, is64: 1, dataSize: 64, isMem: -1, args: []decodeOp{xArgRM64}},
+	{op: RDGSBASE, prefix: 0xF3, code: "\x0f\xae", modrm: 1, is64: 1, dataSize: 16, isMem: -1, args: []decodeOp{xArgRM32}},
+	{op: RDGSBASE, prefix: 0xF3, code: "\x0f\xae", modrm: 1, is64: 1, dataSize: 32, isMem: -1, args: []decodeOp{xArgRM32}},
+	{op: RDGSBASE, prefix: 0xF3, code: "\x0f\xae", modrm: 1, is64: 1, dataSize: 64, isMem: -1, args: []decodeOp{xArgRM64}},
+	{op: RDMSR, code: "\x0f\x32", modrm: encNoModRM},
+	{op: RDPMC, code: "\x0f\x33", modrm: encNoModRM},
+	{op: RDRAND, code: "\x0f\xc7", modrm: 6, is64: 1, dataSize: 64},
+	{op: RDRAND, code: "\x0f\xc7", modrm: 6, dataSize: 16, isMem: -1, args: []decodeOp{xArgRmf16}},
+	{op: RDRAND, code: "\x0f\xc7", modrm: 6, dataSize: 32, isMem: -1, args: []decodeOp{xArgRmf32}},
+	{op: RDTSC, code: "\x0f\x31", modrm: encNoModRM},
+	{op: RDTSCP, code: "\x0f\x01\xf9", modrm: encNoModRM},
+	{op: RET, code: "\xc2", modrm: encNoModRM, read: []decodeOp{xReadIw}, args: []decodeOp{xArgImm16u}},
+	{op: RET, code: "\xc3", modrm: encNoModRM},
+	{op: ROL, code: "\xd1", modrm: 0, dataSize: 16, args: []decodeOp{xArgRM16, xArg1}},
+	{op: ROL, code: "\xd3", modrm: 0, dataSize: 16, args: []decodeOp{xArgRM16, xArgCL}},
+	{op: ROL, code: "\xc1", modrm: 0, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8u}},
+	{op: ROL, code: "\xd1", modrm: 0, dataSize: 32, args: []decodeOp{xArgRM32, xArg1}},
+	{op: ROL, code: "\xd3", modrm: 0, dataSize: 32, args: []decodeOp{xArgRM32, xArgCL}},
+	{op: ROL, code: "\xc1", modrm: 0, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8u}},
+	{op: ROL, code: "\xd1", modrm: 0, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArg1}},
+	{op: ROL, code: "\xd3", modrm: 0, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgCL}},
+	{op: ROL, code: "\xc1", modrm: 0, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8u}},
+	{op: ROL, code: "\xd0", modrm: 0, args: []decodeOp{xArgRM8, xArg1}},
+	{op: ROL, code: "\xd2", modrm: 0, args: []decodeOp{xArgRM8, xArgCL}},
+	{op: ROL, code: "\xc0", modrm: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM8, xArgImm8u}},
+	{op: ROR, code: "\xd1", modrm: 1, dataSize: 16, args: []decodeOp{xArgRM16, xArg1}},
+	{op: ROR, code: "\xd3", modrm: 1, dataSize: 16, args: []decodeOp{xArgRM16, xArgCL}},
+	{op: ROR, code: "\xc1", modrm: 1, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8u}},
+	{op: ROR, code: "\xd1", modrm: 1, dataSize: 32, args: []decodeOp{xArgRM32, xArg1}},
+	{op: ROR, code: "\xd3", modrm: 1, dataSize: 32, args: []decodeOp{xArgRM32, xArgCL}},
+	{op: ROR, code: "\xc1", modrm: 1, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8u}},
+	{op: ROR, code: "\xd1", modrm: 1, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArg1}},
+	{op: ROR, code: "\xd3", modrm: 1, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgCL}},
+	{op: ROR, code: "\xc1", modrm: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8u}},
+	{op: ROR, code: "\xd0", modrm: 1, args: []decodeOp{xArgRM8, xArg1}},
+	{op: ROR, code: "\xd2", modrm: 1, args: []decodeOp{xArgRM8, xArgCL}},
+	{op: ROR, code: "\xc0", modrm: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM8, xArgImm8u}},
+	{op: RORX, vex: 0xf203, code: "\xf0", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgR32, xArgRM32, xArgImm8u}},
+	{op: RORX, vex: 0xf203, code: "\xf0", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgR64, xArgRM64, xArgImm8u}},
+	{op: ROUNDPD, prefix: 0x66, code: "\x0f\x3a\x09", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: ROUNDPS, prefix: 0x66, code: "\x0f\x3a\x08", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: ROUNDSD, prefix: 0x66, code: "\x0f\x3a\x0b", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M64, xArgImm8u}},
+	{op: ROUNDSS, prefix: 0x66, code: "\x0f\x3a\x0a", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M32, xArgImm8u}},
+	{op: RSM, code: "\x0f\xaa", modrm: encNoModRM},
+	{op: RSQRTPS, code: "\x0f\x52", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: RSQRTSS, prefix: 0xF3, code: "\x0f\x52", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: SAHF, code: "\x9e", modrm: encNoModRM, is64: -1},
+	{op: SAHF, code: "\x9e", modrm: encNoModRM, is64: 1},
+	{op: SAR, code: "\xd1", modrm: 7, dataSize: 16, args: []decodeOp{xArgRM16, xArg1}},
+	{op: SAR, code: "\xd3", modrm: 7, dataSize: 16, args: []decodeOp{xArgRM16, xArgCL}},
+	{op: SAR, code: "\xc1", modrm: 7, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8u}},
+	{op: SAR, code: "\xd1", modrm: 7, dataSize: 32, args: []decodeOp{xArgRM32, xArg1}},
+	{op: SAR, code: "\xd3", modrm: 7, dataSize: 32, args: []decodeOp{xArgRM32, xArgCL}},
+	{op: SAR, code: "\xc1", modrm: 7, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8u}},
+	{op: SAR, code: "\xd1", modrm: 7, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArg1}},
+	{op: SAR, code: "\xd3", modrm: 7, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgCL}},
+	{op: SAR, code: "\xc1", modrm: 7, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8u}},
+	{op: SAR, code: "\xd0", modrm: 7, args: []decodeOp{xArgRM8, xArg1}},
+	{op: SAR, code: "\xd2", modrm: 7, args: []decodeOp{xArgRM8, xArgCL}},
+	{op: SAR, code: "\xc0", modrm: 7, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM8, xArgImm8u}},
+	{op: SARX, vex: 0xf302, code: "\xf7", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, args: []decodeOp{xArgR32, xArgRM32, xArgR32V}},
+	{op: SARX, vex: 0xf302, code: "\xf7", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, args: []decodeOp{xArgR64, xArgRM64, xArgR64V}},
+	{op: SBB, code: "\x1c", modrm: encNoModRM, read: []decodeOp{xReadIb}, args: []decodeOp{xArgAL, xArgImm8u}},
+	{op: SBB, code: "\x1d", modrm: encNoModRM, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgAX, xArgImm16}},
+	{op: SBB, code: "\x1d", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgEAX, xArgImm32}},
+	{op: SBB, code: "\x1d", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRAX, xArgImm32}},
+	{op: SBB, code: "\x81", modrm: 3, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgRM16, xArgImm16}},
+	{op: SBB, code: "\x83", modrm: 3, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8}},
+	{op: SBB, code: "\x19", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: SBB, code: "\x81", modrm: 3, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM32, xArgImm32}},
+	{op: SBB, code: "\x83", modrm: 3, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8}},
+	{op: SBB, code: "\x19", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32}},
+	{op: SBB, code: "\x81", modrm: 3, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM64, xArgImm32}},
+	{op: SBB, code: "\x83", modrm: 3, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8}},
+	{op: SBB, code: "\x19", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64}},
+	{op: SBB, code: "\x80", modrm: 3, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM8, xArgImm8u}},
+	{op: SBB, code: "\x18", modrm: encSlashR, args: []decodeOp{xArgRM8, xArgR8}},
+	{op: SBB, code: "\x1b", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: SBB, code: "\x1b", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: SBB, code: "\x1b", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: SBB, code: "\x1a", modrm: encSlashR, args: []decodeOp{xArgR8, xArgRM8}},
+	{op: SCASB, code: "\xae", modrm: encNoModRM},
+	{op: SCASD, code: "\xaf", modrm: encNoModRM, dataSize: 32},
+	{op: SCASQ, code: "\xaf", modrm: encNoModRM, is64: 1, dataSize: 64},
+	{op: SCASW, code: "\xaf", modrm: encNoModRM, dataSize: 16},
+	{op: SETA, code: "\x0f\x97", modrm: encSlashR, args: []decodeOp{xArgRM8}},
+	{op: SETAE, code: "\x0f\x93", modrm: encSlashR, args: []decodeOp{xArgRM8}},
+	{op: SETB, code: "\x0f\x92", modrm: encSlashR, args: []decodeOp{xArgRM8}},
+	{op: SETBE, code: "\x0f\x96", modrm: encSlashR, args: []decodeOp{xArgRM8}},
+	{op: SETE, code: "\x0f\x94", modrm: encSlashR, args: []decodeOp{xArgRM8}},
+	{op: SETG, code: "\x0f\x9f", modrm: encSlashR, args: []decodeOp{xArgRM8}},
+	{op: SETGE, code: "\x0f\x9d", modrm: encSlashR, args: []decodeOp{xArgRM8}},
+	{op: SETL, code: "\x0f\x9c", modrm: encSlashR, args: []decodeOp{xArgRM8}},
+	{op: SETLE, code: "\x0f\x9e", modrm: encSlashR, args: []decodeOp{xArgRM8}},
+	{op: SETNE, code: "\x0f\x95", modrm: encSlashR, args: []decodeOp{xArgRM8}},
+	{op: SETNO, code: "\x0f\x91", modrm: encSlashR, args: []decodeOp{xArgRM8}},
+	{op: SETNP, code: "\x0f\x9b", modrm: encSlashR, args: []decodeOp{xArgRM8}},
+	{op: SETNS, code: "\x0f\x99", modrm: encSlashR, args: []decodeOp{xArgRM8}},
+	{op: SETO, code: "\x0f\x90", modrm: encSlashR, args: []decodeOp{xArgRM8}},
+	{op: SETP, code: "\x0f\x9a", modrm: encSlashR, args: []decodeOp{xArgRM8}},
+	{op: SETS, code: "\x0f\x98", modrm: encSlashR, args: []decodeOp{xArgRM8}},
+	{op: SFENCE, code: "\x0f\xae\xf8", modrm: encNoModRM},
+	{op: SGDT, code: "\x0f\x01", modrm: 0, args: []decodeOp{xArgM}},
+	{op: SHL, code: "\xd1", modrm: 4, dataSize: 16, args: []decodeOp{xArgRM16, xArg1}},
+	{op: SHL, code: "\xd3", modrm: 4, dataSize: 16, args: []decodeOp{xArgRM16, xArgCL}},
+	{op: SHL, code: "\xc1", modrm: 4, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8u}},
+	{op: SHL, code: "\xd1", modrm: 4, dataSize: 32, args: []decodeOp{xArgRM32, xArg1}},
+	{op: SHL, code: "\xd3", modrm: 4, dataSize: 32, args: []decodeOp{xArgRM32, xArgCL}},
+	{op: SHL, code: "\xc1", modrm: 4, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8u}},
+	{op: SHL, code: "\xd1", modrm: 4, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArg1}},
+	{op: SHL, code: "\xd3", modrm: 4, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgCL}},
+	{op: SHL, code: "\xc1", modrm: 4, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8u}},
+	{op: SHL, code: "\xd0", modrm: 4, args: []decodeOp{xArgRM8, xArg1}},
+	{op: SHL, code: "\xd2", modrm: 4, args: []decodeOp{xArgRM8, xArgCL}},
+	{op: SHL, code: "\xc0", modrm: 4, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM8, xArgImm8u}},
+	{op: SHLD, code: "\x0f\xa5", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16, xArgCL}},
+	{op: SHLD, code: "\x0f\xa4", modrm: encSlashR, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgR16, xArgImm8u}},
+	{op: SHLD, code: "\x0f\xa5", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32, xArgCL}},
+	{op: SHLD, code: "\x0f\xa4", modrm: encSlashR, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgR32, xArgImm8u}},
+	{op: SHLD, code: "\x0f\xa5", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64, xArgCL}},
+	{op: SHLD, code: "\x0f\xa4", modrm: encSlashR, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgR64, xArgImm8u}},
+	{op: SHLX, vex: 0x6602, code: "\xf7", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, args: []decodeOp{xArgR32, xArgRM32, xArgR32V}},
+	{op: SHLX, vex: 0x6602, code: "\xf7", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, args: []decodeOp{xArgR64, xArgRM64, xArgR64V}},
+	{op: SHR, code: "\xd1", modrm: 5, dataSize: 16, args: []decodeOp{xArgRM16, xArg1}},
+	{op: SHR, code: "\xd3", modrm: 5, dataSize: 16, args: []decodeOp{xArgRM16, xArgCL}},
+	{op: SHR, code: "\xc1", modrm: 5, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8u}},
+	{op: SHR, code: "\xd1", modrm: 5, dataSize: 32, args: []decodeOp{xArgRM32, xArg1}},
+	{op: SHR, code: "\xd3", modrm: 5, dataSize: 32, args: []decodeOp{xArgRM32, xArgCL}},
+	{op: SHR, code: "\xc1", modrm: 5, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8u}},
+	{op: SHR, code: "\xd1", modrm: 5, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArg1}},
+	{op: SHR, code: "\xd3", modrm: 5, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgCL}},
+	{op: SHR, code: "\xc1", modrm: 5, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8u}},
+	{op: SHR, code: "\xd0", modrm: 5, args: []decodeOp{xArgRM8, xArg1}},
+	{op: SHR, code: "\xd2", modrm: 5, args: []decodeOp{xArgRM8, xArgCL}},
+	{op: SHR, code: "\xc0", modrm: 5, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM8, xArgImm8u}},
+	{op: SHRD, code: "\x0f\xad", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16, xArgCL}},
+	{op: SHRD, code: "\x0f\xac", modrm: encSlashR, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgR16, xArgImm8u}},
+	{op: SHRD, code: "\x0f\xad", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32, xArgCL}},
+	{op: SHRD, code: "\x0f\xac", modrm: encSlashR, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgR32, xArgImm8u}},
+	{op: SHRD, code: "\x0f\xad", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64, xArgCL}},
+	{op: SHRD, code: "\x0f\xac", modrm: encSlashR, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgR64, xArgImm8u}},
+	{op: SHRX, vex: 0xf202, code: "\xf7", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, args: []decodeOp{xArgR32, xArgRM32, xArgR32V}},
+	{op: SHRX, vex: 0xf202, code: "\xf7", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, args: []decodeOp{xArgR64, xArgRM64, xArgR64V}},
+	{op: SHUFPD, prefix: 0x66, code: "\x0f\xc6", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: SHUFPS, code: "\x0f\xc6", modrm: encSlashR, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: SIDT, code: "\x0f\x01", modrm: 1, args: []decodeOp{xArgM}},
+	{op: SLDT, code: "\x0f\x00", modrm: 0, dataSize: 16, args: []decodeOp{xArgRM16}},
+	{op: SLDT, code: "\x0f\x00", modrm: 0, dataSize: 32, args: []decodeOp{xArgR32M16}},
+	{op: SLDT, code: "\x0f\x00", modrm: 0, dataSize: 64, args: []decodeOp{xArgR64M16}},
+	{op: SMSW, code: "\x0f\x01", modrm: 4, dataSize: 16, args: []decodeOp{xArgRM16}},
+	{op: SMSW, code: "\x0f\x01", modrm: 4, dataSize: 32, args: []decodeOp{xArgR32M16}},
+	{op: SMSW, code: "\x0f\x01", modrm: 4, dataSize: 64, args: []decodeOp{xArgR64M16}},
+	{op: SQRTPD, prefix: 0x66, code: "\x0f\x51", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: SQRTPS, code: "\x0f\x51", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: SQRTSD, prefix: 0xF2, code: "\x0f\x51", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: SQRTSS, prefix: 0xF3, code: "\x0f\x51", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: STC, code: "\xf9", modrm: encNoModRM},
+	{op: STD, code: "\xfd", modrm: encNoModRM},
+	{op: STI, code: "\xfb", modrm: encNoModRM},
+	{op: STMXCSR, code: "\x0f\xae", modrm: 3, args: []decodeOp{xArgM32}},
+	{op: STOSB, code: "\xaa", modrm: encNoModRM},
+	{op: STOSD, code: "\xab", modrm: encNoModRM, dataSize: 32},
+	{op: STOSQ, code: "\xab", modrm: encNoModRM, is64: 1, dataSize: 64},
+	{op: STOSW, code: "\xab", modrm: encNoModRM, dataSize: 16},
+	{op: STR, code: "\x0f\x00", modrm: 1, dataSize: 16, args: []decodeOp{xArgRM16}},
+	{op: STR, code: "\x0f\x00", modrm: 1, dataSize: 32, args: []decodeOp{xArgR32M16}},
+	{op: STR, code: "\x0f\x00", modrm: 1, dataSize: 64, args: []decodeOp{xArgR64M16}},
+	{op: SUB, code: "\x2c", modrm: encNoModRM, read: []decodeOp{xReadIb}, args: []decodeOp{xArgAL, xArgImm8u}},
+	{op: SUB, code: "\x2d", modrm: encNoModRM, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgAX, xArgImm16}},
+	{op: SUB, code: "\x2d", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgEAX, xArgImm32}},
+	{op: SUB, code: "\x2d", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRAX, xArgImm32}},
+	{op: SUB, code: "\x81", modrm: 5, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgRM16, xArgImm16}},
+	{op: SUB, code: "\x83", modrm: 5, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8}},
+	{op: SUB, code: "\x29", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: SUB, code: "\x81", modrm: 5, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM32, xArgImm32}},
+	{op: SUB, code: "\x83", modrm: 5, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8}},
+	{op: SUB, code: "\x29", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32}},
+	{op: SUB, code: "\x81", modrm: 5, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM64, xArgImm32}},
+	{op: SUB, code: "\x83", modrm: 5, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8}},
+	{op: SUB, code: "\x29", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64}},
+	{op: SUB, code: "\x80", modrm: 5, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM8, xArgImm8u}},
+	{op: SUB, code: "\x28", modrm: encSlashR, args: []decodeOp{xArgRM8, xArgR8}},
+	{op: SUB, code: "\x2b", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: SUB, code: "\x2b", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: SUB, code: "\x2b", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: SUB, code: "\x2a", modrm: encSlashR, args: []decodeOp{xArgR8, xArgRM8}},
+	{op: SUBPD, prefix: 0x66, code: "\x0f\x5c", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: SUBPS, code: "\x0f\x5c", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: SUBSD, prefix: 0xF2, code: "\x0f\x5c", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: SUBSS, prefix: 0xF3, code: "\x0f\x5c", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: SWAPGS, code: "\x0f\x01\xf8", modrm: encNoModRM, is64: 1},
+	{op: SYSCALL, code: "\x0f\x05", modrm: encNoModRM, is64: 1},
+	{op: SYSENTER, code: "\x0f\x34", modrm: encNoModRM},
+	{op: SYSEXIT, code: "\x0f\x35", modrm: encNoModRM},
+	{op: SYSEXIT, code: "\x0f\x35", modrm: encNoModRM, dataSize: 64},
+	{op: SYSRET, code: "\x0f\x07", modrm: encNoModRM, is64: 1},
+	{op: TEST, code: "\xa8", modrm: encNoModRM, read: []decodeOp{xReadIb}, args: []decodeOp{xArgAL, xArgImm8u}},
+	{op: TEST, code: "\xa9", modrm: encNoModRM, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgAX, xArgImm16}},
+	{op: TEST, code: "\xa9", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgEAX, xArgImm32}},
+	{op: TEST, code: "\xa9", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRAX, xArgImm32}},
+	{op: TEST, code: "\xf7", modrm: 0, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgRM16, xArgImm16}},
+	{op: TEST, code: "\x85", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: TEST, code: "\xf7", modrm: 0, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM32, xArgImm32}},
+	{op: TEST, code: "\x85", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32}},
+	{op: TEST, code: "\xf7", modrm: 0, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM64, xArgImm32}},
+	{op: TEST, code: "\x85", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64}},
+	{op: TEST, code: "\xf6", modrm: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM8, xArgImm8u}},
+	{op: TEST, code: "\x84", modrm: encSlashR, args: []decodeOp{xArgRM8, xArgR8}},
+	{op: TZCNT, prefix: 0xF3, code: "\x0f\xbc", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: TZCNT, prefix: 0xF3, code: "\x0f\xbc", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: TZCNT, prefix: 0xF3, code: "\x0f\xbc", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: UCOMISD, prefix: 0x66, code: "\x0f\x2e", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: UCOMISS, code: "\x0f\x2e", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: UD1, code: "\x0f\xb9", modrm: encNoModRM},
+	{op: UD2, code: "\x0f\x0b", modrm: encNoModRM},
+	{op: UNPCKHPD, prefix: 0x66, code: "\x0f\x15", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: UNPCKHPS, code: "\x0f\x15", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: UNPCKLPD, prefix: 0x66, code: "\x0f\x14", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: UNPCKLPS, code: "\x0f\x14", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VADDPD, vex: 0x6601, code: "\x58", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VADDPD, vex: 0x6601, code: "\x58", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VADDPD, vex: 0x6621, code: "\x58", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VADDPD, vex: 0x6621, code: "\x58", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VADDPD, vex: 0x6621, code: "\x58", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VADDPS, vex: 0x1, code: "\x58", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VADDPS, vex: 0x1, code: "\x58", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VADDPS, vex: 0x21, code: "\x58", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VADDPS, vex: 0x21, code: "\x58", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VADDPS, vex: 0x21, code: "\x58", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VADDSD, vex: 0xf201, code: "\x58", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VADDSD, vex: 0xf221, code: "\x58", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VADDSS, vex: 0xf301, code: "\x58", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VADDSS, vex: 0xf321, code: "\x58", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VADDSUBPD, vex: 0x6601, code: "\xd0", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VADDSUBPD, vex: 0x6601, code: "\xd0", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VADDSUBPS, vex: 0xf201, code: "\xd0", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VADDSUBPS, vex: 0xf201, code: "\xd0", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VAESDEC, vex: 0x6602, code: "\xde", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VAESDECLAST, vex: 0x6602, code: "\xdf", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VAESENC, vex: 0x6602, code: "\xdc", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VAESENCLAST, vex: 0x6602, code: "\xdd", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VAESIMC, vex: 0x6602, code: "\xdb", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VAESKEYGENASSIST, vex: 0x6603, code: "\xdf", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: VALIGND, vex: 0x6623, code: "\x03", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VALIGND, vex: 0x6623, code: "\x03", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VALIGND, vex: 0x6623, code: "\x03", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgImm8u}},
+	{op: VALIGNQ, vex: 0x6623, code: "\x03", modrm: encSlashR, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VALIGNQ, vex: 0x6623, code: "\x03", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VALIGNQ, vex: 0x6623, code: "\x03", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgImm8u}},
+	{op: VANDNPD, vex: 0x6601, code: "\x55", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VANDNPD, vex: 0x6601, code: "\x55", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VANDNPD, vex: 0x6621, code: "\x55", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VANDNPD, vex: 0x6621, code: "\x55", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VANDNPD, vex: 0x6621, code: "\x55", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VANDNPS, vex: 0x1, code: "\x55", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VANDNPS, vex: 0x1, code: "\x55", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VANDNPS, vex: 0x21, code: "\x55", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VANDNPS, vex: 0x21, code: "\x55", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VANDNPS, vex: 0x21, code: "\x55", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VANDPD, vex: 0x6601, code: "\x54", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VANDPD, vex: 0x6601, code: "\x54", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VANDPD, vex: 0x6621, code: "\x54", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VANDPD, vex: 0x6621, code: "\x54", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VANDPD, vex: 0x6621, code: "\x54", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VANDPS, vex: 0x1, code: "\x54", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VANDPS, vex: 0x1, code: "\x54", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VANDPS, vex: 0x21, code: "\x54", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VANDPS, vex: 0x21, code: "\x54", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VANDPS, vex: 0x21, code: "\x54", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VBLENDMPD, vex: 0x6622, code: "\x65", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VBLENDMPD, vex: 0x6622, code: "\x65", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VBLENDMPD, vex: 0x6622, code: "\x65", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VBLENDMPS, vex: 0x6622, code: "\x65", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VBLENDMPS, vex: 0x6622, code: "\x65", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VBLENDMPS, vex: 0x6622, code: "\x65", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VBLENDPD, vex: 0x6603, code: "\x0d", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VBLENDPD, vex: 0x6603, code: "\x0d", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VBLENDPS, vex: 0x6603, code: "\x0c", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VBLENDPS, vex: 0x6603, code: "\x0c", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VBLENDVPD, vex: 0x6603, code: "\x4b", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128, xArgXmmIH}},
+	{op: VBLENDVPD, vex: 0x6603, code: "\x4b", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256, xArgYmmIH}},
+	{op: VBLENDVPS, vex: 0x6603, code: "\x4a", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128, xArgXmmIH}},
+	{op: VBLENDVPS, vex: 0x6603, code: "\x4a", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256, xArgYmmIH}},
+	{op: VBROADCASTF128, vex: 0x6602, code: "\x1a", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgM128}},
+	{op: VBROADCASTF32X2, vex: 0x6622, code: "\x19", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VBROADCASTF32X2, vex: 0x6622, code: "\x19", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VBROADCASTF32X4, vex: 0x6622, code: "\x1a", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgM128}},
+	{op: VBROADCASTF32X4, vex: 0x6622, code: "\x1a", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgM128}},
+	{op: VBROADCASTF32X8, vex: 0x6622, code: "\x1b", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgM256}},
+	{op: VBROADCASTF64X2, vex: 0x6622, code: "\x1a", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgM128}},
+	{op: VBROADCASTF64X2, vex: 0x6622, code: "\x1a", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgM128}},
+	{op: VBROADCASTF64X4, vex: 0x6622, code: "\x1b", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgM256}},
+	{op: VBROADCASTI128, vex: 0x6602, code: "\x5a", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgM128}},
+	{op: VBROADCASTI32X2, vex: 0x6622, code: "\x59", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VBROADCASTI32X2, vex: 0x6622, code: "\x59", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VBROADCASTI32X2, vex: 0x6622, code: "\x59", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VBROADCASTI32X4, vex: 0x6622, code: "\x5a", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgM128}},
+	{op: VBROADCASTI32X4, vex: 0x6622, code: "\x5a", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgM128}},
+	{op: VBROADCASTI32X8, vex: 0x6622, code: "\x5b", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgM256}},
+	{op: VBROADCASTI64X2, vex: 0x6622, code: "\x5a", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgM128}},
+	{op: VBROADCASTI64X2, vex: 0x6622, code: "\x5a", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgM128}},
+	{op: VBROADCASTI64X4, vex: 0x6622, code: "\x5b", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgM256}},
+	{op: VBROADCASTSD, vex: 0x6602, code: "\x19", modrm: encSlashR, vexL: 1, vexW: 0, isMem: 1, args: []decodeOp{xArgYmm1, xArgM64}},
+	{op: VBROADCASTSD, vex: 0x6602, code: "\x19", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgYmm1, xArgXmm2}},
+	{op: VBROADCASTSD, vex: 0x6622, code: "\x19", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VBROADCASTSD, vex: 0x6622, code: "\x19", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VBROADCASTSS, vex: 0x6602, code: "\x18", modrm: encSlashR, vexL: 0, vexW: 0, isMem: 1, args: []decodeOp{xArgXmm1, xArgM32}},
+	{op: VBROADCASTSS, vex: 0x6602, code: "\x18", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgXmm1, xArgXmm2}},
+	{op: VBROADCASTSS, vex: 0x6602, code: "\x18", modrm: encSlashR, vexL: 1, vexW: 0, isMem: 1, args: []decodeOp{xArgYmm1, xArgM32}},
+	{op: VBROADCASTSS, vex: 0x6602, code: "\x18", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgYmm1, xArgXmm2}},
+	{op: VBROADCASTSS, vex: 0x6622, code: "\x18", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M32}},
+	{op: VBROADCASTSS, vex: 0x6622, code: "\x18", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M32}},
+	{op: VBROADCASTSS, vex: 0x6622, code: "\x18", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgXmm2M32}},
+	{op: VCMPPD, vex: 0x6601, code: "\xc2", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VCMPPD, vex: 0x6601, code: "\xc2", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VCMPPD, vex: 0x6621, code: "\xc2", modrm: encSlashR, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VCMPPD, vex: 0x6621, code: "\xc2", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VCMPPD, vex: 0x6621, code: "\xc2", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgSAE, xArgImm8u}},
+	{op: VCMPPS, vex: 0x1, code: "\xc2", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VCMPPS, vex: 0x1, code: "\xc2", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VCMPPS, vex: 0x21, code: "\xc2", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VCMPPS, vex: 0x21, code: "\xc2", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VCMPPS, vex: 0x21, code: "\xc2", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgSAE, xArgImm8u}},
+	{op: VCMPSD, vex: 0xf201, code: "\xc2", modrm: encSlashR, vexL: -1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64, xArgImm8u}},
+	{op: VCMPSD, vex: 0xf221, code: "\xc2", modrm: encSlashR, vexL: -1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M64, xArgSAE, xArgImm8u}},
+	{op: VCMPSS, vex: 0xf301, code: "\xc2", modrm: encSlashR, vexL: -1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32, xArgImm8u}},
+	{op: VCMPSS, vex: 0xf321, code: "\xc2", modrm: encSlashR, vexL: -1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M32, xArgSAE, xArgImm8u}},
+	{op: VCOMISD, vex: 0x6601, code: "\x2f", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: VCOMISD, vex: 0x6621, code: "\x2f", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmm2M64, xArgSAE}},
+	{op: VCOMISS, vex: 0x1, code: "\x2f", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: VCOMISS, vex: 0x21, code: "\x2f", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmm2M32, xArgSAE}},
+	{op: VCOMPRESSPD, vex: 0x6622, code: "\x8a", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgXmm1}},
+	{op: VCOMPRESSPD, vex: 0x6622, code: "\x8a", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgYmm1}},
+	{op: VCOMPRESSPD, vex: 0x6622, code: "\x8a", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm2M512, xArgMask, xArgZ, xArgZmm1}},
+	{op: VCOMPRESSPS, vex: 0x6622, code: "\x8a", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgXmm1}},
+	{op: VCOMPRESSPS, vex: 0x6622, code: "\x8a", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgYmm1}},
+	{op: VCOMPRESSPS, vex: 0x6622, code: "\x8a", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm2M512, xArgMask, xArgZ, xArgZmm1}},
+	{op: VCVTDQ2PD, vex: 0xf301, code: "\xe6", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: VCVTDQ2PD, vex: 0xf301, code: "\xe6", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgXmm2M128}},
+	{op: VCVTDQ2PD, vex: 0xf321, code: "\xe6", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M64, xArgM32bcst}},
+	{op: VCVTDQ2PD, vex: 0xf321, code: "\xe6", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VCVTDQ2PD, vex: 0xf321, code: "\xe6", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst}},
+	{op: VCVTDQ2PS, vex: 0x1, code: "\x5b", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VCVTDQ2PS, vex: 0x1, code: "\x5b", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VCVTDQ2PS, vex: 0x21, code: "\x5b", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VCVTDQ2PS, vex: 0x21, code: "\x5b", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst}},
+	{op: VCVTDQ2PS, vex: 0x21, code: "\x5b", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VCVTPD2DQ, vex: 0xf201, code: "\xe6", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VCVTPD2DQ, vex: 0xf201, code: "\xe6", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgXmm1, xArgYmm2M256}},
+	{op: VCVTPD2DQ, vex: 0xf221, code: "\xe6", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VCVTPD2DQ, vex: 0xf221, code: "\xe6", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VCVTPD2DQ, vex: 0xf221, code: "\xe6", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VCVTPD2PS, vex: 0x6601, code: "\x5a", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VCVTPD2PS, vex: 0x6601, code: "\x5a", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgXmm1, xArgYmm2M256}},
+	{op: VCVTPD2PS, vex: 0x6621, code: "\x5a", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VCVTPD2PS, vex: 0x6621, code: "\x5a", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VCVTPD2PS, vex: 0x6621, code: "\x5a", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VCVTPD2QQ, vex: 0x6621, code: "\x7b", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VCVTPD2QQ, vex: 0x6621, code: "\x7b", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VCVTPD2QQ, vex: 0x6621, code: "\x7b", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VCVTPD2UDQ, vex: 0x21, code: "\x79", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VCVTPD2UDQ, vex: 0x21, code: "\x79", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VCVTPD2UDQ, vex: 0x21, code: "\x79", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VCVTPD2UQQ, vex: 0x6621, code: "\x79", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VCVTPD2UQQ, vex: 0x6621, code: "\x79", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VCVTPD2UQQ, vex: 0x6621, code: "\x79", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VCVTPH2PS, vex: 0x6602, code: "\x13", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: VCVTPH2PS, vex: 0x6602, code: "\x13", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgXmm2M128}},
+	{op: VCVTPH2PS, vex: 0x6622, code: "\x13", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VCVTPH2PS, vex: 0x6622, code: "\x13", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VCVTPH2PS, vex: 0x6622, code: "\x13", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgYmm2M256, xArgSAE}},
+	{op: VCVTPS2DQ, vex: 0x6601, code: "\x5b", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VCVTPS2DQ, vex: 0x6601, code: "\x5b", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VCVTPS2DQ, vex: 0x6621, code: "\x5b", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VCVTPS2DQ, vex: 0x6621, code: "\x5b", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst}},
+	{op: VCVTPS2DQ, vex: 0x6621, code: "\x5b", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VCVTPS2PD, vex: 0x1, code: "\x5a", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: VCVTPS2PD, vex: 0x1, code: "\x5a", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgXmm2M128}},
+	{op: VCVTPS2PD, vex: 0x21, code: "\x5a", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M64, xArgM32bcst}},
+	{op: VCVTPS2PD, vex: 0x21, code: "\x5a", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VCVTPS2PD, vex: 0x21, code: "\x5a", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst, xArgSAE}},
+	{op: VCVTPS2PH, vex: 0x6603, code: "\x1d", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2M128, xArgYmm1, xArgImm8u}},
+	{op: VCVTPS2PH, vex: 0x6603, code: "\x1d", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2M64, xArgXmm1, xArgImm8u}},
+	{op: VCVTPS2PH, vex: 0x6623, code: "\x1d", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgXmm1, xArgImm8u}},
+	{op: VCVTPS2PH, vex: 0x6623, code: "\x1d", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgYmm1, xArgImm8u}},
+	{op: VCVTPS2PH, vex: 0x6623, code: "\x1d", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgZmm1, xArgSAE, xArgImm8u}},
+	{op: VCVTPS2QQ, vex: 0x6621, code: "\x7b", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M64, xArgM32bcst}},
+	{op: VCVTPS2QQ, vex: 0x6621, code: "\x7b", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VCVTPS2QQ, vex: 0x6621, code: "\x7b", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst, xArgER}},
+	{op: VCVTPS2UDQ, vex: 0x21, code: "\x79", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VCVTPS2UDQ, vex: 0x21, code: "\x79", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst}},
+	{op: VCVTPS2UDQ, vex: 0x21, code: "\x79", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VCVTPS2UQQ, vex: 0x6621, code: "\x79", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M64, xArgM32bcst}},
+	{op: VCVTPS2UQQ, vex: 0x6621, code: "\x79", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VCVTPS2UQQ, vex: 0x6621, code: "\x79", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst, xArgER}},
+	{op: VCVTQQ2PD, vex: 0xf321, code: "\xe6", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VCVTQQ2PD, vex: 0xf321, code: "\xe6", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VCVTQQ2PD, vex: 0xf321, code: "\xe6", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VCVTQQ2PS, vex: 0x21, code: "\x5b", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VCVTQQ2PS, vex: 0x21, code: "\x5b", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VCVTQQ2PS, vex: 0x21, code: "\x5b", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VCVTSD2SI, vex: 0xf201, code: "\x2d", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgR32, xArgXmm2M64}},
+	{op: VCVTSD2SI, vex: 0xf201, code: "\x2d", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgR64, xArgXmm2M64}},
+	{op: VCVTSD2SI, vex: 0xf221, code: "\x2d", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgR32, xArgXmm2M64, xArgER}},
+	{op: VCVTSD2SI, vex: 0xf221, code: "\x2d", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgR64, xArgXmm2M64, xArgER}},
+	{op: VCVTSD2SS, vex: 0xf201, code: "\x5a", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VCVTSD2SS, vex: 0xf221, code: "\x5a", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VCVTSD2USI, vex: 0xf221, code: "\x79", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgR32, xArgXmm2M64, xArgER}},
+	{op: VCVTSD2USI, vex: 0xf221, code: "\x79", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgR64, xArgXmm2M64, xArgER}},
+	{op: VCVTSI2SD, vex: 0xf201, code: "\x2a", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgRM32}},
+	{op: VCVTSI2SD, vex: 0xf201, code: "\x2a", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgRM64}},
+	{op: VCVTSI2SD, vex: 0xf221, code: "\x2a", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgRM32}},
+	{op: VCVTSI2SD, vex: 0xf221, code: "\x2a", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgRM64, xArgER}},
+	{op: VCVTSI2SS, vex: 0xf301, code: "\x2a", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgRM32}},
+	{op: VCVTSI2SS, vex: 0xf301, code: "\x2a", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgRM64}},
+	{op: VCVTSI2SS, vex: 0xf321, code: "\x2a", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgRM32, xArgER}},
+	{op: VCVTSI2SS, vex: 0xf321, code: "\x2a", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgRM64, xArgER}},
+	{op: VCVTSS2SD, vex: 0xf301, code: "\x5a", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VCVTSS2SD, vex: 0xf321, code: "\x5a", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgSAE}},
+	{op: VCVTSS2SI, vex: 0xf301, code: "\x2d", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgR32, xArgXmm2M32}},
+	{op: VCVTSS2SI, vex: 0xf301, code: "\x2d", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgR64, xArgXmm2M32}},
+	{op: VCVTSS2SI, vex: 0xf321, code: "\x2d", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgR32, xArgXmm2M32, xArgER}},
+	{op: VCVTSS2SI, vex: 0xf321, code: "\x2d", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgR64, xArgXmm2M32, xArgER}},
+	{op: VCVTSS2USI, vex: 0xf321, code: "\x79", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgR32, xArgXmm2M32, xArgER}},
+	{op: VCVTSS2USI, vex: 0xf321, code: "\x79", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgR64, xArgXmm2M32, xArgER}},
+	{op: VCVTTPD2DQ, vex: 0x6601, code: "\xe6", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VCVTTPD2DQ, vex: 0x6601, code: "\xe6", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgXmm1, xArgYmm2M256}},
+	{op: VCVTTPD2DQ, vex: 0x6621, code: "\xe6", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VCVTTPD2DQ, vex: 0x6621, code: "\xe6", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VCVTTPD2DQ, vex: 0x6621, code: "\xe6", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgSAE}},
+	{op: VCVTTPD2QQ, vex: 0x6621, code: "\x7a", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VCVTTPD2QQ, vex: 0x6621, code: "\x7a", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VCVTTPD2QQ, vex: 0x6621, code: "\x7a", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgSAE}},
+	{op: VCVTTPD2UDQ, vex: 0x21, code: "\x78", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VCVTTPD2UDQ, vex: 0x21, code: "\x78", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VCVTTPD2UDQ, vex: 0x21, code: "\x78", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgSAE}},
+	{op: VCVTTPD2UQQ, vex: 0x6621, code: "\x78", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VCVTTPD2UQQ, vex: 0x6621, code: "\x78", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VCVTTPD2UQQ, vex: 0x6621, code: "\x78", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgSAE}},
+	{op: VCVTTPS2DQ, vex: 0xf301, code: "\x5b", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VCVTTPS2DQ, vex: 0xf301, code: "\x5b", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VCVTTPS2DQ, vex: 0xf321, code: "\x5b", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VCVTTPS2DQ, vex: 0xf321, code: "\x5b", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst}},
+	{op: VCVTTPS2DQ, vex: 0xf321, code: "\x5b", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgSAE}},
+	{op: VCVTTPS2QQ, vex: 0x6621, code: "\x7a", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M64, xArgM32bcst}},
+	{op: VCVTTPS2QQ, vex: 0x6621, code: "\x7a", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VCVTTPS2QQ, vex: 0x6621, code: "\x7a", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst, xArgSAE}},
+	{op: VCVTTPS2UDQ, vex: 0x21, code: "\x78", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VCVTTPS2UDQ, vex: 0x21, code: "\x78", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst}},
+	{op: VCVTTPS2UDQ, vex: 0x21, code: "\x78", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgSAE}},
+	{op: VCVTTPS2UQQ, vex: 0x6621, code: "\x78", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M64, xArgM32bcst}},
+	{op: VCVTTPS2UQQ, vex: 0x6621, code: "\x78", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VCVTTPS2UQQ, vex: 0x6621, code: "\x78", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst, xArgSAE}},
+	{op: VCVTTSD2SI, vex: 0xf201, code: "\x2c", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgR32, xArgXmm2M64}},
+	{op: VCVTTSD2SI, vex: 0xf201, code: "\x2c", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgR64, xArgXmm2M64}},
+	{op: VCVTTSD2SI, vex: 0xf221, code: "\x2c", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgR32, xArgXmm2M64, xArgSAE}},
+	{op: VCVTTSD2SI, vex: 0xf221, code: "\x2c", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgR64, xArgXmm2M64, xArgSAE}},
+	{op: VCVTTSD2USI, vex: 0xf221, code: "\x78", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgR32, xArgXmm2M64, xArgSAE}},
+	{op: VCVTTSD2USI, vex: 0xf221, code: "\x78", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgR64, xArgXmm2M64, xArgSAE}},
+	{op: VCVTTSS2SI, vex: 0xf301, code: "\x2c", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgR32, xArgXmm2M32}},
+	{op: VCVTTSS2SI, vex: 0xf301, code: "\x2c", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgR64, xArgXmm2M32}},
+	{op: VCVTTSS2SI, vex: 0xf321, code: "\x2c", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgR32, xArgXmm2M32, xArgSAE}},
+	{op: VCVTTSS2SI, vex: 0xf321, code: "\x2c", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgR64, xArgXmm2M32, xArgSAE}},
+	{op: VCVTTSS2USI, vex: 0xf321, code: "\x78", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgR32, xArgXmm2M32, xArgSAE}},
+	{op: VCVTTSS2USI, vex: 0xf321, code: "\x78", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgR64, xArgXmm2M32, xArgSAE}},
+	{op: VCVTUDQ2PD, vex: 0xf321, code: "\x7a", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M64, xArgM32bcst}},
+	{op: VCVTUDQ2PD, vex: 0xf321, code: "\x7a", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VCVTUDQ2PD, vex: 0xf321, code: "\x7a", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst}},
+	{op: VCVTUDQ2PS, vex: 0xf221, code: "\x7a", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VCVTUDQ2PS, vex: 0xf221, code: "\x7a", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst}},
+	{op: VCVTUDQ2PS, vex: 0xf221, code: "\x7a", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VCVTUQQ2PD, vex: 0xf321, code: "\x7a", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VCVTUQQ2PD, vex: 0xf321, code: "\x7a", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VCVTUQQ2PD, vex: 0xf321, code: "\x7a", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VCVTUQQ2PS, vex: 0xf221, code: "\x7a", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VCVTUQQ2PS, vex: 0xf221, code: "\x7a", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VCVTUQQ2PS, vex: 0xf221, code: "\x7a", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VCVTUSI2SD, vex: 0xf221, code: "\x7b", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgRM32}},
+	{op: VCVTUSI2SD, vex: 0xf221, code: "\x7b", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgRM64, xArgER}},
+	{op: VCVTUSI2SS, vex: 0xf321, code: "\x7b", modrm: encSlashR, dataSize: 32, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgRM32, xArgER}},
+	{op: VCVTUSI2SS, vex: 0xf321, code: "\x7b", modrm: encSlashR, is64: 1, dataSize: 64, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgRM64, xArgER}},
+	{op: VDBPSADBW, vex: 0x6623, code: "\x42", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VDBPSADBW, vex: 0x6623, code: "\x42", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VDBPSADBW, vex: 0x6623, code: "\x42", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgImm8u}},
+	{op: VDIVPD, vex: 0x6601, code: "\x5e", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VDIVPD, vex: 0x6601, code: "\x5e", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VDIVPD, vex: 0x6621, code: "\x5e", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VDIVPD, vex: 0x6621, code: "\x5e", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VDIVPD, vex: 0x6621, code: "\x5e", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VDIVPS, vex: 0x1, code: "\x5e", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VDIVPS, vex: 0x1, code: "\x5e", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VDIVPS, vex: 0x21, code: "\x5e", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VDIVPS, vex: 0x21, code: "\x5e", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VDIVPS, vex: 0x21, code: "\x5e", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VDIVSD, vex: 0xf201, code: "\x5e", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VDIVSD, vex: 0xf221, code: "\x5e", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VDIVSS, vex: 0xf301, code: "\x5e", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VDIVSS, vex: 0xf321, code: "\x5e", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VDPPD, vex: 0x6603, code: "\x41", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VDPPS, vex: 0x6603, code: "\x40", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VDPPS, vex: 0x6603, code: "\x40", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VERR, code: "\x0f\x00", modrm: 4, args: []decodeOp{xArgRM16}},
+	{op: VERW, code: "\x0f\x00", modrm: 5, args: []decodeOp{xArgRM16}},
+	{op: VEXPANDPD, vex: 0x6622, code: "\x88", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VEXPANDPD, vex: 0x6622, code: "\x88", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VEXPANDPD, vex: 0x6622, code: "\x88", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VEXPANDPS, vex: 0x6622, code: "\x88", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VEXPANDPS, vex: 0x6622, code: "\x88", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VEXPANDPS, vex: 0x6622, code: "\x88", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VEXTRACTF128, vex: 0x6603, code: "\x19", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2M128, xArgYmm1, xArgImm8u}},
+	{op: VEXTRACTF32X4, vex: 0x6623, code: "\x19", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgYmm1, xArgImm8u}},
+	{op: VEXTRACTF32X4, vex: 0x6623, code: "\x19", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgZmm1, xArgImm8u}},
+	{op: VEXTRACTF32X8, vex: 0x6623, code: "\x1b", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgZmm1, xArgImm8u}},
+	{op: VEXTRACTF64X2, vex: 0x6623, code: "\x19", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgYmm1, xArgImm8u}},
+	{op: VEXTRACTF64X2, vex: 0x6623, code: "\x19", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgZmm1, xArgImm8u}},
+	{op: VEXTRACTF64X4, vex: 0x6623, code: "\x1b", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgZmm1, xArgImm8u}},
+	{op: VEXTRACTI128, vex: 0x6603, code: "\x39", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2M128, xArgYmm1, xArgImm8u}},
+	{op: VEXTRACTI32X4, vex: 0x6623, code: "\x39", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgYmm1, xArgImm8u}},
+	{op: VEXTRACTI32X4, vex: 0x6623, code: "\x39", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgZmm1, xArgImm8u}},
+	{op: VEXTRACTI32X8, vex: 0x6623, code: "\x3b", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgZmm1, xArgImm8u}},
+	{op: VEXTRACTI64X2, vex: 0x6623, code: "\x39", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgYmm1, xArgImm8u}},
+	{op: VEXTRACTI64X2, vex: 0x6623, code: "\x39", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgZmm1, xArgImm8u}},
+	{op: VEXTRACTI64X4, vex: 0x6623, code: "\x3b", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgZmm1, xArgImm8u}},
+	{op: VEXTRACTPS, vex: 0x6603, code: "\x17", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgXmm1, xArgImm8u}},
+	{op: VEXTRACTPS, vex: 0x6623, code: "\x17", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgXmm1, xArgImm8u}},
+	{op: VFIXUPIMMPD, vex: 0x6623, code: "\x54", modrm: encSlashR, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VFIXUPIMMPD, vex: 0x6623, code: "\x54", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VFIXUPIMMPD, vex: 0x6623, code: "\x54", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgSAE, xArgImm8u}},
+	{op: VFIXUPIMMPS, vex: 0x6623, code: "\x54", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VFIXUPIMMPS, vex: 0x6623, code: "\x54", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VFIXUPIMMPS, vex: 0x6623, code: "\x54", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgSAE, xArgImm8u}},
+	{op: VFIXUPIMMSD, vex: 0x6623, code: "\x55", modrm: encSlashR, vexL: -1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgSAE, xArgImm8u}},
+	{op: VFIXUPIMMSS, vex: 0x6623, code: "\x55", modrm: encSlashR, vexL: -1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgSAE, xArgImm8u}},
+	{op: VFMADD132PD, vex: 0x6602, code: "\x98", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMADD132PD, vex: 0x6602, code: "\x98", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMADD132PD, vex: 0x6622, code: "\x98", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFMADD132PD, vex: 0x6622, code: "\x98", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFMADD132PD, vex: 0x6622, code: "\x98", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFMADD132PS, vex: 0x6602, code: "\x98", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMADD132PS, vex: 0x6602, code: "\x98", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMADD132PS, vex: 0x6622, code: "\x98", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFMADD132PS, vex: 0x6622, code: "\x98", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFMADD132PS, vex: 0x6622, code: "\x98", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFMADD132SD, vex: 0x6602, code: "\x99", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VFMADD132SD, vex: 0x6622, code: "\x99", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VFMADD132SS, vex: 0x6602, code: "\x99", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VFMADD132SS, vex: 0x6622, code: "\x99", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VFMADD213PD, vex: 0x6602, code: "\xa8", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMADD213PD, vex: 0x6602, code: "\xa8", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMADD213PD, vex: 0x6622, code: "\xa8", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFMADD213PD, vex: 0x6622, code: "\xa8", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFMADD213PD, vex: 0x6622, code: "\xa8", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFMADD213PS, vex: 0x6602, code: "\xa8", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMADD213PS, vex: 0x6602, code: "\xa8", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMADD213PS, vex: 0x6622, code: "\xa8", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFMADD213PS, vex: 0x6622, code: "\xa8", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFMADD213PS, vex: 0x6622, code: "\xa8", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFMADD213SD, vex: 0x6602, code: "\xa9", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VFMADD213SD, vex: 0x6622, code: "\xa9", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VFMADD213SS, vex: 0x6602, code: "\xa9", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VFMADD213SS, vex: 0x6622, code: "\xa9", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VFMADD231PD, vex: 0x6602, code: "\xb8", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMADD231PD, vex: 0x6602, code: "\xb8", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMADD231PD, vex: 0x6622, code: "\xb8", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFMADD231PD, vex: 0x6622, code: "\xb8", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFMADD231PD, vex: 0x6622, code: "\xb8", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFMADD231PS, vex: 0x6602, code: "\xb8", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMADD231PS, vex: 0x6602, code: "\xb8", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMADD231PS, vex: 0x6622, code: "\xb8", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFMADD231PS, vex: 0x6622, code: "\xb8", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFMADD231PS, vex: 0x6622, code: "\xb8", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFMADD231SD, vex: 0x6602, code: "\xb9", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VFMADD231SD, vex: 0x6622, code: "\xb9", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VFMADD231SS, vex: 0x6602, code: "\xb9", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VFMADD231SS, vex: 0x6622, code: "\xb9", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VFMADDSUB132PD, vex: 0x6602, code: "\x96", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMADDSUB132PD, vex: 0x6602, code: "\x96", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMADDSUB132PD, vex: 0x6622, code: "\x96", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFMADDSUB132PD, vex: 0x6622, code: "\x96", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFMADDSUB132PD, vex: 0x6622, code: "\x96", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFMADDSUB132PS, vex: 0x6602, code: "\x96", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMADDSUB132PS, vex: 0x6602, code: "\x96", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMADDSUB132PS, vex: 0x6622, code: "\x96", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFMADDSUB132PS, vex: 0x6622, code: "\x96", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFMADDSUB132PS, vex: 0x6622, code: "\x96", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFMADDSUB213PD, vex: 0x6602, code: "\xa6", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMADDSUB213PD, vex: 0x6602, code: "\xa6", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMADDSUB213PD, vex: 0x6622, code: "\xa6", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFMADDSUB213PD, vex: 0x6622, code: "\xa6", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFMADDSUB213PD, vex: 0x6622, code: "\xa6", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFMADDSUB213PS, vex: 0x6602, code: "\xa6", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMADDSUB213PS, vex: 0x6602, code: "\xa6", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMADDSUB213PS, vex: 0x6622, code: "\xa6", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFMADDSUB213PS, vex: 0x6622, code: "\xa6", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFMADDSUB213PS, vex: 0x6622, code: "\xa6", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFMADDSUB231PD, vex: 0x6602, code: "\xb6", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMADDSUB231PD, vex: 0x6602, code: "\xb6", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMADDSUB231PD, vex: 0x6622, code: "\xb6", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFMADDSUB231PD, vex: 0x6622, code: "\xb6", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFMADDSUB231PD, vex: 0x6622, code: "\xb6", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFMADDSUB231PS, vex: 0x6602, code: "\xb6", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMADDSUB231PS, vex: 0x6602, code: "\xb6", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMADDSUB231PS, vex: 0x6622, code: "\xb6", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFMADDSUB231PS, vex: 0x6622, code: "\xb6", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFMADDSUB231PS, vex: 0x6622, code: "\xb6", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFMSUB132PD, vex: 0x6602, code: "\x9a", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMSUB132PD, vex: 0x6602, code: "\x9a", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMSUB132PD, vex: 0x6622, code: "\x9a", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFMSUB132PD, vex: 0x6622, code: "\x9a", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFMSUB132PD, vex: 0x6622, code: "\x9a", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFMSUB132PS, vex: 0x6602, code: "\x9a", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMSUB132PS, vex: 0x6602, code: "\x9a", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMSUB132PS, vex: 0x6622, code: "\x9a", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFMSUB132PS, vex: 0x6622, code: "\x9a", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFMSUB132PS, vex: 0x6622, code: "\x9a", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFMSUB132SD, vex: 0x6602, code: "\x9b", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VFMSUB132SD, vex: 0x6622, code: "\x9b", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VFMSUB132SS, vex: 0x6602, code: "\x9b", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VFMSUB132SS, vex: 0x6622, code: "\x9b", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VFMSUB213PD, vex: 0x6602, code: "\xaa", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMSUB213PD, vex: 0x6602, code: "\xaa", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMSUB213PD, vex: 0x6622, code: "\xaa", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFMSUB213PD, vex: 0x6622, code: "\xaa", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFMSUB213PD, vex: 0x6622, code: "\xaa", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFMSUB213PS, vex: 0x6602, code: "\xaa", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMSUB213PS, vex: 0x6602, code: "\xaa", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMSUB213PS, vex: 0x6622, code: "\xaa", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFMSUB213PS, vex: 0x6622, code: "\xaa", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFMSUB213PS, vex: 0x6622, code: "\xaa", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFMSUB213SD, vex: 0x6602, code: "\xab", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VFMSUB213SD, vex: 0x6622, code: "\xab", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VFMSUB213SS, vex: 0x6602, code: "\xab", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VFMSUB213SS, vex: 0x6622, code: "\xab", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VFMSUB231PD, vex: 0x6602, code: "\xba", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMSUB231PD, vex: 0x6602, code: "\xba", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMSUB231PD, vex: 0x6622, code: "\xba", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFMSUB231PD, vex: 0x6622, code: "\xba", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFMSUB231PD, vex: 0x6622, code: "\xba", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFMSUB231PS, vex: 0x6602, code: "\xba", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMSUB231PS, vex: 0x6602, code: "\xba", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMSUB231PS, vex: 0x6622, code: "\xba", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFMSUB231PS, vex: 0x6622, code: "\xba", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFMSUB231PS, vex: 0x6622, code: "\xba", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFMSUB231SD, vex: 0x6602, code: "\xbb", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VFMSUB231SD, vex: 0x6622, code: "\xbb", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VFMSUB231SS, vex: 0x6602, code: "\xbb", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VFMSUB231SS, vex: 0x6622, code: "\xbb", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VFMSUBADD132PD, vex: 0x6602, code: "\x97", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMSUBADD132PD, vex: 0x6602, code: "\x97", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMSUBADD132PD, vex: 0x6622, code: "\x97", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFMSUBADD132PD, vex: 0x6622, code: "\x97", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFMSUBADD132PD, vex: 0x6622, code: "\x97", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFMSUBADD132PS, vex: 0x6602, code: "\x97", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMSUBADD132PS, vex: 0x6602, code: "\x97", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMSUBADD132PS, vex: 0x6622, code: "\x97", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFMSUBADD132PS, vex: 0x6622, code: "\x97", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFMSUBADD132PS, vex: 0x6622, code: "\x97", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFMSUBADD213PD, vex: 0x6602, code: "\xa7", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMSUBADD213PD, vex: 0x6602, code: "\xa7", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMSUBADD213PD, vex: 0x6622, code: "\xa7", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFMSUBADD213PD, vex: 0x6622, code: "\xa7", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFMSUBADD213PD, vex: 0x6622, code: "\xa7", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFMSUBADD213PS, vex: 0x6602, code: "\xa7", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMSUBADD213PS, vex: 0x6602, code: "\xa7", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMSUBADD213PS, vex: 0x6622, code: "\xa7", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFMSUBADD213PS, vex: 0x6622, code: "\xa7", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFMSUBADD213PS, vex: 0x6622, code: "\xa7", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFMSUBADD231PD, vex: 0x6602, code: "\xb7", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMSUBADD231PD, vex: 0x6602, code: "\xb7", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMSUBADD231PD, vex: 0x6622, code: "\xb7", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFMSUBADD231PD, vex: 0x6622, code: "\xb7", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFMSUBADD231PD, vex: 0x6622, code: "\xb7", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFMSUBADD231PS, vex: 0x6602, code: "\xb7", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFMSUBADD231PS, vex: 0x6602, code: "\xb7", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFMSUBADD231PS, vex: 0x6622, code: "\xb7", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFMSUBADD231PS, vex: 0x6622, code: "\xb7", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFMSUBADD231PS, vex: 0x6622, code: "\xb7", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFNMADD132PD, vex: 0x6602, code: "\x9c", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFNMADD132PD, vex: 0x6602, code: "\x9c", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFNMADD132PD, vex: 0x6622, code: "\x9c", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFNMADD132PD, vex: 0x6622, code: "\x9c", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFNMADD132PD, vex: 0x6622, code: "\x9c", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFNMADD132PS, vex: 0x6602, code: "\x9c", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFNMADD132PS, vex: 0x6602, code: "\x9c", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFNMADD132PS, vex: 0x6622, code: "\x9c", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFNMADD132PS, vex: 0x6622, code: "\x9c", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFNMADD132PS, vex: 0x6622, code: "\x9c", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFNMADD132SD, vex: 0x6602, code: "\x9d", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VFNMADD132SD, vex: 0x6622, code: "\x9d", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VFNMADD132SS, vex: 0x6602, code: "\x9d", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VFNMADD132SS, vex: 0x6622, code: "\x9d", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VFNMADD213PD, vex: 0x6602, code: "\xac", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFNMADD213PD, vex: 0x6602, code: "\xac", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFNMADD213PD, vex: 0x6622, code: "\xac", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFNMADD213PD, vex: 0x6622, code: "\xac", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFNMADD213PD, vex: 0x6622, code: "\xac", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFNMADD213PS, vex: 0x6602, code: "\xac", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFNMADD213PS, vex: 0x6602, code: "\xac", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFNMADD213PS, vex: 0x6622, code: "\xac", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFNMADD213PS, vex: 0x6622, code: "\xac", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFNMADD213PS, vex: 0x6622, code: "\xac", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFNMADD213SD, vex: 0x6602, code: "\xad", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VFNMADD213SD, vex: 0x6622, code: "\xad", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VFNMADD213SS, vex: 0x6602, code: "\xad", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VFNMADD213SS, vex: 0x6622, code: "\xad", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VFNMADD231PD, vex: 0x6602, code: "\xbc", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFNMADD231PD, vex: 0x6602, code: "\xbc", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFNMADD231PD, vex: 0x6622, code: "\xbc", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFNMADD231PD, vex: 0x6622, code: "\xbc", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFNMADD231PD, vex: 0x6622, code: "\xbc", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFNMADD231PS, vex: 0x6602, code: "\xbc", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFNMADD231PS, vex: 0x6602, code: "\xbc", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFNMADD231PS, vex: 0x6622, code: "\xbc", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFNMADD231PS, vex: 0x6622, code: "\xbc", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFNMADD231PS, vex: 0x6622, code: "\xbc", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFNMADD231SD, vex: 0x6602, code: "\xbd", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VFNMADD231SD, vex: 0x6622, code: "\xbd", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VFNMADD231SS, vex: 0x6602, code: "\xbd", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VFNMADD231SS, vex: 0x6622, code: "\xbd", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VFNMSUB132PD, vex: 0x6602, code: "\x9e", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFNMSUB132PD, vex: 0x6602, code: "\x9e", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFNMSUB132PD, vex: 0x6622, code: "\x9e", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFNMSUB132PD, vex: 0x6622, code: "\x9e", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFNMSUB132PD, vex: 0x6622, code: "\x9e", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFNMSUB132PS, vex: 0x6602, code: "\x9e", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFNMSUB132PS, vex: 0x6602, code: "\x9e", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFNMSUB132PS, vex: 0x6622, code: "\x9e", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFNMSUB132PS, vex: 0x6622, code: "\x9e", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFNMSUB132PS, vex: 0x6622, code: "\x9e", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFNMSUB132SD, vex: 0x6602, code: "\x9f", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VFNMSUB132SD, vex: 0x6622, code: "\x9f", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VFNMSUB132SS, vex: 0x6602, code: "\x9f", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VFNMSUB132SS, vex: 0x6622, code: "\x9f", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VFNMSUB213PD, vex: 0x6602, code: "\xae", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFNMSUB213PD, vex: 0x6602, code: "\xae", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFNMSUB213PD, vex: 0x6622, code: "\xae", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFNMSUB213PD, vex: 0x6622, code: "\xae", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFNMSUB213PD, vex: 0x6622, code: "\xae", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFNMSUB213PS, vex: 0x6602, code: "\xae", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFNMSUB213PS, vex: 0x6602, code: "\xae", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFNMSUB213PS, vex: 0x6622, code: "\xae", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFNMSUB213PS, vex: 0x6622, code: "\xae", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFNMSUB213PS, vex: 0x6622, code: "\xae", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFNMSUB213SD, vex: 0x6602, code: "\xaf", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VFNMSUB213SD, vex: 0x6622, code: "\xaf", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VFNMSUB213SS, vex: 0x6602, code: "\xaf", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VFNMSUB213SS, vex: 0x6622, code: "\xaf", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VFNMSUB231PD, vex: 0x6602, code: "\xbe", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFNMSUB231PD, vex: 0x6602, code: "\xbe", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFNMSUB231PD, vex: 0x6622, code: "\xbe", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VFNMSUB231PD, vex: 0x6622, code: "\xbe", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VFNMSUB231PD, vex: 0x6622, code: "\xbe", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VFNMSUB231PS, vex: 0x6602, code: "\xbe", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VFNMSUB231PS, vex: 0x6602, code: "\xbe", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VFNMSUB231PS, vex: 0x6622, code: "\xbe", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VFNMSUB231PS, vex: 0x6622, code: "\xbe", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VFNMSUB231PS, vex: 0x6622, code: "\xbe", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VFNMSUB231SD, vex: 0x6602, code: "\xbf", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VFNMSUB231SD, vex: 0x6622, code: "\xbf", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VFNMSUB231SS, vex: 0x6602, code: "\xbf", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VFNMSUB231SS, vex: 0x6622, code: "\xbf", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VFPCLASSPD, vex: 0x6623, code: "\x66", modrm: encSlashR, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VFPCLASSPD, vex: 0x6623, code: "\x66", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VFPCLASSPD, vex: 0x6623, code: "\x66", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgZmm2M512, xArgM64bcst, xArgImm8u}},
+	{op: VFPCLASSPS, vex: 0x6623, code: "\x66", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VFPCLASSPS, vex: 0x6623, code: "\x66", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VFPCLASSPS, vex: 0x6623, code: "\x66", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgZmm2M512, xArgM32bcst, xArgImm8u}},
+	{op: VFPCLASSSD, vex: 0x6623, code: "\x67", modrm: encSlashR, vexL: -1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgXmm2M64, xArgImm8u}},
+	{op: VFPCLASSSS, vex: 0x6623, code: "\x67", modrm: encSlashR, vexL: -1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgXmm2M32, xArgImm8u}},
+	{op: VGATHERDPD, vex: 0x6602, code: "\x92", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgVm32x, xArgXmmV}},
+	{op: VGATHERDPD, vex: 0x6602, code: "\x92", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgVm32x, xArgYmmV}},
+	{op: VGATHERDPD, vex: 0x6622, code: "\x92", modrm: encSlashR, vexL: 0, vexW: 1, isMem: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgVm32x}},
+	{op: VGATHERDPD, vex: 0x6622, code: "\x92", modrm: encSlashR, vexL: 1, vexW: 1, isMem: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgVm32x}},
+	{op: VGATHERDPD, vex: 0x6622, code: "\x92", modrm: encSlashR, vexL: 2, vexW: 1, isMem: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgVm32y}},
+	{op: VGATHERDPS, vex: 0x6602, code: "\x92", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgVm32x, xArgXmmV}},
+	{op: VGATHERDPS, vex: 0x6602, code: "\x92", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgVm32y, xArgYmmV}},
+	{op: VGATHERDPS, vex: 0x6622, code: "\x92", modrm: encSlashR, vexL: 0, vexW: 0, isMem: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgVm32x}},
+	{op: VGATHERDPS, vex: 0x6622, code: "\x92", modrm: encSlashR, vexL: 1, vexW: 0, isMem: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgVm32y}},
+	{op: VGATHERDPS, vex: 0x6622, code: "\x92", modrm: encSlashR, vexL: 2, vexW: 0, isMem: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgVm32z}},
+	{op: VGATHERQPD, vex: 0x6602, code: "\x93", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgVm64x, xArgXmmV}},
+	{op: VGATHERQPD, vex: 0x6602, code: "\x93", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgVm64y, xArgYmmV}},
+	{op: VGATHERQPD, vex: 0x6622, code: "\x93", modrm: encSlashR, vexL: 0, vexW: 1, isMem: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgVm64x}},
+	{op: VGATHERQPD, vex: 0x6622, code: "\x93", modrm: encSlashR, vexL: 1, vexW: 1, isMem: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgVm64y}},
+	{op: VGATHERQPD, vex: 0x6622, code: "\x93", modrm: encSlashR, vexL: 2, vexW: 1, isMem: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgVm64z}},
+	{op: VGATHERQPS, vex: 0x6602, code: "\x93", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgVm64x, xArgXmmV}},
+	{op: VGATHERQPS, vex: 0x6602, code: "\x93", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm1, xArgVm64y, xArgXmmV}},
+	{op: VGATHERQPS, vex: 0x6622, code: "\x93", modrm: encSlashR, vexL: 0, vexW: 0, isMem: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgVm64x}},
+	{op: VGATHERQPS, vex: 0x6622, code: "\x93", modrm: encSlashR, vexL: 1, vexW: 0, isMem: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgVm64y}},
+	{op: VGATHERQPS, vex: 0x6622, code: "\x93", modrm: encSlashR, vexL: 2, vexW: 0, isMem: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgVm64z}},
+	{op: VGETEXPPD, vex: 0x6622, code: "\x42", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VGETEXPPD, vex: 0x6622, code: "\x42", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VGETEXPPD, vex: 0x6622, code: "\x42", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgSAE}},
+	{op: VGETEXPPS, vex: 0x6622, code: "\x42", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VGETEXPPS, vex: 0x6622, code: "\x42", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst}},
+	{op: VGETEXPPS, vex: 0x6622, code: "\x42", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgSAE}},
+	{op: VGETEXPSD, vex: 0x6622, code: "\x43", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgSAE}},
+	{op: VGETEXPSS, vex: 0x6622, code: "\x43", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgSAE}},
+	{op: VGETMANTPD, vex: 0x6623, code: "\x26", modrm: encSlashR, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VGETMANTPD, vex: 0x6623, code: "\x26", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VGETMANTPD, vex: 0x6623, code: "\x26", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgSAE, xArgImm8u}},
+	{op: VGETMANTPS, vex: 0x6623, code: "\x26", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VGETMANTPS, vex: 0x6623, code: "\x26", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VGETMANTPS, vex: 0x6623, code: "\x26", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgSAE, xArgImm8u}},
+	{op: VGETMANTSD, vex: 0x6623, code: "\x27", modrm: encSlashR, vexL: -1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgSAE, xArgImm8u}},
+	{op: VGETMANTSS, vex: 0x6623, code: "\x27", modrm: encSlashR, vexL: -1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgSAE, xArgImm8u}},
+	{op: VHADDPD, vex: 0x6601, code: "\x7c", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VHADDPD, vex: 0x6601, code: "\x7c", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VHADDPS, vex: 0xf201, code: "\x7c", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VHADDPS, vex: 0xf201, code: "\x7c", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VHSUBPD, vex: 0x6601, code: "\x7d", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VHSUBPD, vex: 0x6601, code: "\x7d", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VHSUBPS, vex: 0xf201, code: "\x7d", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VHSUBPS, vex: 0xf201, code: "\x7d", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VINSERTF128, vex: 0x6603, code: "\x18", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VINSERTF32X4, vex: 0x6623, code: "\x18", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VINSERTF32X4, vex: 0x6623, code: "\x18", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VINSERTF32X8, vex: 0x6623, code: "\x1a", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VINSERTF64X2, vex: 0x6623, code: "\x18", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VINSERTF64X2, vex: 0x6623, code: "\x18", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VINSERTF64X4, vex: 0x6623, code: "\x1a", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VINSERTI128, vex: 0x6603, code: "\x38", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VINSERTI32X4, vex: 0x6623, code: "\x38", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VINSERTI32X4, vex: 0x6623, code: "\x38", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VINSERTI32X8, vex: 0x6623, code: "\x3a", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VINSERTI64X2, vex: 0x6623, code: "\x38", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VINSERTI64X2, vex: 0x6623, code: "\x38", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VINSERTI64X4, vex: 0x6623, code: "\x3a", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VINSERTPS, vex: 0x6603, code: "\x21", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32, xArgImm8u}},
+	{op: VINSERTPS, vex: 0x6623, code: "\x21", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32, xArgImm8u}},
+	{op: VLDDQU, vex: 0xf201, code: "\xf0", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgM128}},
+	{op: VLDDQU, vex: 0xf201, code: "\xf0", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgM256}},
+	{op: VLDMXCSR, vex: 0x1, code: "\xae", modrm: 2, vexL: 0, vexW: -1, args: []decodeOp{xArgM32}},
+	{op: VMASKMOVDQU, vex: 0x6601, code: "\xf7", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2}},
+	{op: VMASKMOVPD, vex: 0x6602, code: "\x2f", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgM128, xArgXmmV, xArgXmm1}},
+	{op: VMASKMOVPD, vex: 0x6602, code: "\x2f", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgM256, xArgYmmV, xArgYmm1}},
+	{op: VMASKMOVPD, vex: 0x6602, code: "\x2d", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgM128}},
+	{op: VMASKMOVPD, vex: 0x6602, code: "\x2d", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgM256}},
+	{op: VMASKMOVPS, vex: 0x6602, code: "\x2e", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgM128, xArgXmmV, xArgXmm1}},
+	{op: VMASKMOVPS, vex: 0x6602, code: "\x2e", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgM256, xArgYmmV, xArgYmm1}},
+	{op: VMASKMOVPS, vex: 0x6602, code: "\x2c", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgM128}},
+	{op: VMASKMOVPS, vex: 0x6602, code: "\x2c", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgM256}},
+	{op: VMAXPD, vex: 0x6601, code: "\x5f", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VMAXPD, vex: 0x6601, code: "\x5f", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VMAXPD, vex: 0x6621, code: "\x5f", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VMAXPD, vex: 0x6621, code: "\x5f", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VMAXPD, vex: 0x6621, code: "\x5f", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgSAE}},
+	{op: VMAXPS, vex: 0x1, code: "\x5f", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VMAXPS, vex: 0x1, code: "\x5f", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VMAXPS, vex: 0x21, code: "\x5f", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VMAXPS, vex: 0x21, code: "\x5f", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VMAXPS, vex: 0x21, code: "\x5f", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgSAE}},
+	{op: VMAXSD, vex: 0xf201, code: "\x5f", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VMAXSD, vex: 0xf221, code: "\x5f", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgSAE}},
+	{op: VMAXSS, vex: 0xf301, code: "\x5f", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VMAXSS, vex: 0xf321, code: "\x5f", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgSAE}},
+	{op: VMINPD, vex: 0x6601, code: "\x5d", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VMINPD, vex: 0x6601, code: "\x5d", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VMINPD, vex: 0x6621, code: "\x5d", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VMINPD, vex: 0x6621, code: "\x5d", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VMINPD, vex: 0x6621, code: "\x5d", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgSAE}},
+	{op: VMINPS, vex: 0x1, code: "\x5d", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VMINPS, vex: 0x1, code: "\x5d", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VMINPS, vex: 0x21, code: "\x5d", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VMINPS, vex: 0x21, code: "\x5d", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VMINPS, vex: 0x21, code: "\x5d", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgSAE}},
+	{op: VMINSD, vex: 0xf201, code: "\x5d", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VMINSD, vex: 0xf221, code: "\x5d", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgSAE}},
+	{op: VMINSS, vex: 0xf301, code: "\x5d", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VMINSS, vex: 0xf321, code: "\x5d", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgSAE}},
+	{op: VMOVAPD, vex: 0x6601, code: "\x28", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VMOVAPD, vex: 0x6601, code: "\x29", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm2M128, xArgXmm1}},
+	{op: VMOVAPD, vex: 0x6601, code: "\x28", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VMOVAPD, vex: 0x6601, code: "\x29", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm2M256, xArgYmm1}},
+	{op: VMOVAPD, vex: 0x6621, code: "\x28", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VMOVAPD, vex: 0x6621, code: "\x29", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgXmm1}},
+	{op: VMOVAPD, vex: 0x6621, code: "\x28", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VMOVAPD, vex: 0x6621, code: "\x29", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgYmm1}},
+	{op: VMOVAPD, vex: 0x6621, code: "\x28", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VMOVAPD, vex: 0x6621, code: "\x29", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm2M512, xArgMask, xArgZ, xArgZmm1}},
+	{op: VMOVAPS, vex: 0x1, code: "\x28", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VMOVAPS, vex: 0x1, code: "\x29", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm2M128, xArgXmm1}},
+	{op: VMOVAPS, vex: 0x1, code: "\x28", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VMOVAPS, vex: 0x1, code: "\x29", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm2M256, xArgYmm1}},
+	{op: VMOVAPS, vex: 0x21, code: "\x28", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VMOVAPS, vex: 0x21, code: "\x29", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgXmm1}},
+	{op: VMOVAPS, vex: 0x21, code: "\x28", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VMOVAPS, vex: 0x21, code: "\x29", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgYmm1}},
+	{op: VMOVAPS, vex: 0x21, code: "\x28", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VMOVAPS, vex: 0x21, code: "\x29", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm2M512, xArgMask, xArgZ, xArgZmm1}},
+	{op: VMOVD, vex: 0x6601, code: "\x7e", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, args: []decodeOp{xArgRM32, xArgXmm1}},
+	{op: VMOVD, vex: 0x6601, code: "\x6e", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgRM32}},
+	{op: VMOVD, vex: 0x6621, code: "\x6e", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgRM32}},
+	{op: VMOVD, vex: 0x6621, code: "\x7e", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, args: []decodeOp{xArgRM32, xArgXmm1}},
+	{op: VMOVDDUP, vex: 0xf201, code: "\x12", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: VMOVDDUP, vex: 0xf201, code: "\x12", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VMOVDDUP, vex: 0xf221, code: "\x12", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VMOVDDUP, vex: 0xf221, code: "\x12", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VMOVDDUP, vex: 0xf221, code: "\x12", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VMOVDQA, vex: 0x6601, code: "\x6f", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VMOVDQA, vex: 0x6601, code: "\x7f", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm2M128, xArgXmm1}},
+	{op: VMOVDQA, vex: 0x6601, code: "\x6f", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VMOVDQA, vex: 0x6601, code: "\x7f", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm2M256, xArgYmm1}},
+	{op: VMOVDQA32, vex: 0x6621, code: "\x6f", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VMOVDQA32, vex: 0x6621, code: "\x7f", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgXmm1}},
+	{op: VMOVDQA32, vex: 0x6621, code: "\x6f", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VMOVDQA32, vex: 0x6621, code: "\x7f", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgYmm1}},
+	{op: VMOVDQA32, vex: 0x6621, code: "\x6f", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VMOVDQA32, vex: 0x6621, code: "\x7f", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm2M512, xArgMask, xArgZ, xArgZmm1}},
+	{op: VMOVDQA64, vex: 0x6621, code: "\x6f", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VMOVDQA64, vex: 0x6621, code: "\x7f", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgXmm1}},
+	{op: VMOVDQA64, vex: 0x6621, code: "\x6f", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VMOVDQA64, vex: 0x6621, code: "\x7f", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgYmm1}},
+	{op: VMOVDQA64, vex: 0x6621, code: "\x6f", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VMOVDQA64, vex: 0x6621, code: "\x7f", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm2M512, xArgMask, xArgZ, xArgZmm1}},
+	{op: VMOVDQU, vex: 0xf301, code: "\x6f", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VMOVDQU, vex: 0xf301, code: "\x7f", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm2M128, xArgXmm1}},
+	{op: VMOVDQU, vex: 0xf301, code: "\x6f", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VMOVDQU, vex: 0xf301, code: "\x7f", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm2M256, xArgYmm1}},
+	{op: VMOVDQU16, vex: 0xf221, code: "\x6f", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VMOVDQU16, vex: 0xf221, code: "\x7f", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgXmm1}},
+	{op: VMOVDQU16, vex: 0xf221, code: "\x6f", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VMOVDQU16, vex: 0xf221, code: "\x7f", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgYmm1}},
+	{op: VMOVDQU16, vex: 0xf221, code: "\x6f", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VMOVDQU16, vex: 0xf221, code: "\x7f", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm2M512, xArgMask, xArgZ, xArgZmm1}},
+	{op: VMOVDQU32, vex: 0xf321, code: "\x6f", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VMOVDQU32, vex: 0xf321, code: "\x7f", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgXmm1}},
+	{op: VMOVDQU32, vex: 0xf321, code: "\x6f", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VMOVDQU32, vex: 0xf321, code: "\x7f", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgYmm1}},
+	{op: VMOVDQU32, vex: 0xf321, code: "\x6f", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VMOVDQU32, vex: 0xf321, code: "\x7f", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm2M512, xArgMask, xArgZ, xArgZmm1}},
+	{op: VMOVDQU64, vex: 0xf321, code: "\x6f", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VMOVDQU64, vex: 0xf321, code: "\x7f", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgXmm1}},
+	{op: VMOVDQU64, vex: 0xf321, code: "\x6f", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VMOVDQU64, vex: 0xf321, code: "\x7f", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgYmm1}},
+	{op: VMOVDQU64, vex: 0xf321, code: "\x6f", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VMOVDQU64, vex: 0xf321, code: "\x7f", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm2M512, xArgMask, xArgZ, xArgZmm1}},
+	{op: VMOVDQU8, vex: 0xf221, code: "\x6f", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VMOVDQU8, vex: 0xf221, code: "\x7f", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgXmm1}},
+	{op: VMOVDQU8, vex: 0xf221, code: "\x6f", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VMOVDQU8, vex: 0xf221, code: "\x7f", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgYmm1}},
+	{op: VMOVDQU8, vex: 0xf221, code: "\x6f", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VMOVDQU8, vex: 0xf221, code: "\x7f", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm2M512, xArgMask, xArgZ, xArgZmm1}},
+	{op: VMOVHLPS, vex: 0x1, code: "\x12", modrm: encSlashR, vexL: 0, vexW: -1, isMem: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2}},
+	{op: VMOVHLPS, vex: 0x21, code: "\x12", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2}},
+	{op: VMOVHPD, vex: 0x6601, code: "\x17", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgM64, xArgXmm1}},
+	{op: VMOVHPD, vex: 0x6601, code: "\x16", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgM64}},
+	{op: VMOVHPD, vex: 0x6621, code: "\x16", modrm: encSlashR, vexL: 0, vexW: 1, isMem: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgM64}},
+	{op: VMOVHPD, vex: 0x6621, code: "\x17", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgM64, xArgXmm1}},
+	{op: VMOVHPS, vex: 0x1, code: "\x17", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgM64, xArgXmm1}},
+	{op: VMOVHPS, vex: 0x1, code: "\x16", modrm: encSlashR, vexL: 0, vexW: -1, isMem: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgM64}},
+	{op: VMOVHPS, vex: 0x21, code: "\x16", modrm: encSlashR, vexL: 0, vexW: 0, isMem: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgM64}},
+	{op: VMOVHPS, vex: 0x21, code: "\x17", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgM64, xArgXmm1}},
+	{op: VMOVLHPS, vex: 0x1, code: "\x16", modrm: encSlashR, vexL: 0, vexW: -1, isMem: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2}},
+	{op: VMOVLHPS, vex: 0x21, code: "\x16", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2}},
+	{op: VMOVLPD, vex: 0x6601, code: "\x13", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgM64, xArgXmm1}},
+	{op: VMOVLPD, vex: 0x6601, code: "\x12", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgM64}},
+	{op: VMOVLPD, vex: 0x6621, code: "\x12", modrm: encSlashR, vexL: 0, vexW: 1, isMem: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgM64}},
+	{op: VMOVLPD, vex: 0x6621, code: "\x13", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgM64, xArgXmm1}},
+	{op: VMOVLPS, vex: 0x1, code: "\x13", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgM64, xArgXmm1}},
+	{op: VMOVLPS, vex: 0x1, code: "\x12", modrm: encSlashR, vexL: 0, vexW: -1, isMem: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgM64}},
+	{op: VMOVLPS, vex: 0x21, code: "\x12", modrm: encSlashR, vexL: 0, vexW: 0, isMem: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgM64}},
+	{op: VMOVLPS, vex: 0x21, code: "\x13", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgM64, xArgXmm1}},
+	{op: VMOVMSKPD, vex: 0x6601, code: "\x50", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgR32, xArgXmm2}},
+	{op: VMOVMSKPD, vex: 0x6601, code: "\x50", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgR32, xArgYmm2}},
+	{op: VMOVMSKPS, vex: 0x1, code: "\x50", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgR32, xArgXmm2}},
+	{op: VMOVMSKPS, vex: 0x1, code: "\x50", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgR32, xArgYmm2}},
+	{op: VMOVNTDQ, vex: 0x6601, code: "\xe7", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgM128, xArgXmm1}},
+	{op: VMOVNTDQ, vex: 0x6601, code: "\xe7", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgM256, xArgYmm1}},
+	{op: VMOVNTDQ, vex: 0x6621, code: "\xe7", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgM128, xArgXmm1}},
+	{op: VMOVNTDQ, vex: 0x6621, code: "\xe7", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgM256, xArgYmm1}},
+	{op: VMOVNTDQ, vex: 0x6621, code: "\xe7", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgM512, xArgZmm1}},
+	{op: VMOVNTDQA, vex: 0x6602, code: "\x2a", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgM128}},
+	{op: VMOVNTDQA, vex: 0x6602, code: "\x2a", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgM256}},
+	{op: VMOVNTDQA, vex: 0x6622, code: "\x2a", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgM128}},
+	{op: VMOVNTDQA, vex: 0x6622, code: "\x2a", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgM256}},
+	{op: VMOVNTDQA, vex: 0x6622, code: "\x2a", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgM512}},
+	{op: VMOVNTPD, vex: 0x6601, code: "\x2b", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgM128, xArgXmm1}},
+	{op: VMOVNTPD, vex: 0x6601, code: "\x2b", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgM256, xArgYmm1}},
+	{op: VMOVNTPD, vex: 0x6621, code: "\x2b", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgM128, xArgXmm1}},
+	{op: VMOVNTPD, vex: 0x6621, code: "\x2b", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgM256, xArgYmm1}},
+	{op: VMOVNTPD, vex: 0x6621, code: "\x2b", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgM512, xArgZmm1}},
+	{op: VMOVNTPS, vex: 0x1, code: "\x2b", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgM128, xArgXmm1}},
+	{op: VMOVNTPS, vex: 0x1, code: "\x2b", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgM256, xArgYmm1}},
+	{op: VMOVNTPS, vex: 0x21, code: "\x2b", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgM128, xArgXmm1}},
+	{op: VMOVNTPS, vex: 0x21, code: "\x2b", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgM256, xArgYmm1}},
+	{op: VMOVNTPS, vex: 0x21, code: "\x2b", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgM512, xArgZmm1}},
+	{op: VMOVQ, vex: 0x6601, code: "\x7e", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, args: []decodeOp{xArgRM64, xArgXmm1}},
+	{op: VMOVQ, vex: 0x6601, code: "\x6e", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgRM64}},
+	{op: VMOVQ, vex: 0xf301, code: "\x7e", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: VMOVQ, vex: 0x6601, code: "\xd6", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm2M64, xArgXmm1}},
+	{op: VMOVQ, vex: 0x6621, code: "\x6e", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgRM64}},
+	{op: VMOVQ, vex: 0x6621, code: "\x7e", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, args: []decodeOp{xArgRM64, xArgXmm1}},
+	{op: VMOVQ, vex: 0xf321, code: "\x7e", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: VMOVQ, vex: 0x6621, code: "\xd6", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm2M64, xArgXmm1}},
+	{op: VMOVSD, vex: 0xf201, code: "\x11", modrm: encSlashR, vexL: -1, vexW: -1, isMem: 1, args: []decodeOp{xArgM64, xArgXmm1}},
+	{op: VMOVSD, vex: 0xf201, code: "\x10", modrm: encSlashR, vexL: -1, vexW: -1, isMem: 1, args: []decodeOp{xArgXmm1, xArgM64}},
+	{op: VMOVSD, vex: 0xf201, code: "\x10", modrm: encSlashR, vexL: -1, vexW: -1, isMem: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2}},
+	{op: VMOVSD, vex: 0xf201, code: "\x11", modrm: encSlashR, vexL: -1, vexW: -1, isMem: -1, args: []decodeOp{xArgXmm2, xArgXmmV, xArgXmm1}},
+	{op: VMOVSD, vex: 0xf221, code: "\x10", modrm: encSlashR, vexL: -1, vexW: 1, isMem: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgM64}},
+	{op: VMOVSD, vex: 0xf221, code: "\x10", modrm: encSlashR, vexL: -1, vexW: 1, isMem: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2}},
+	{op: VMOVSD, vex: 0xf221, code: "\x11", modrm: encSlashR, vexL: -1, vexW: 1, isMem: 1, args: []decodeOp{xArgM64, xArgMask, xArgXmm1}},
+	{op: VMOVSD, vex: 0xf221, code: "\x11", modrm: encSlashR, vexL: -1, vexW: 1, isMem: -1, args: []decodeOp{xArgXmm2, xArgMask, xArgZ, xArgXmmV, xArgXmm1}},
+	{op: VMOVSHDUP, vex: 0xf301, code: "\x16", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VMOVSHDUP, vex: 0xf301, code: "\x16", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VMOVSHDUP, vex: 0xf321, code: "\x16", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VMOVSHDUP, vex: 0xf321, code: "\x16", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VMOVSHDUP, vex: 0xf321, code: "\x16", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VMOVSLDUP, vex: 0xf301, code: "\x12", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VMOVSLDUP, vex: 0xf301, code: "\x12", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VMOVSLDUP, vex: 0xf321, code: "\x12", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VMOVSLDUP, vex: 0xf321, code: "\x12", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VMOVSLDUP, vex: 0xf321, code: "\x12", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VMOVSS, vex: 0xf301, code: "\x11", modrm: encSlashR, vexL: -1, vexW: -1, isMem: 1, args: []decodeOp{xArgM32, xArgXmm1}},
+	{op: VMOVSS, vex: 0xf301, code: "\x10", modrm: encSlashR, vexL: -1, vexW: -1, isMem: 1, args: []decodeOp{xArgXmm1, xArgM32}},
+	{op: VMOVSS, vex: 0xf301, code: "\x10", modrm: encSlashR, vexL: -1, vexW: -1, isMem: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2}},
+	{op: VMOVSS, vex: 0xf301, code: "\x11", modrm: encSlashR, vexL: -1, vexW: -1, isMem: -1, args: []decodeOp{xArgXmm2, xArgXmmV, xArgXmm1}},
+	{op: VMOVSS, vex: 0xf321, code: "\x10", modrm: encSlashR, vexL: -1, vexW: 0, isMem: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgM32}},
+	{op: VMOVSS, vex: 0xf321, code: "\x10", modrm: encSlashR, vexL: -1, vexW: 0, isMem: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2}},
+	{op: VMOVSS, vex: 0xf321, code: "\x11", modrm: encSlashR, vexL: -1, vexW: 0, isMem: 1, args: []decodeOp{xArgM32, xArgMask, xArgXmm1}},
+	{op: VMOVSS, vex: 0xf321, code: "\x11", modrm: encSlashR, vexL: -1, vexW: 0, isMem: -1, args: []decodeOp{xArgXmm2, xArgMask, xArgZ, xArgXmmV, xArgXmm1}},
+	{op: VMOVUPD, vex: 0x6601, code: "\x10", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VMOVUPD, vex: 0x6601, code: "\x11", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm2M128, xArgXmm1}},
+	{op: VMOVUPD, vex: 0x6601, code: "\x10", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VMOVUPD, vex: 0x6601, code: "\x11", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm2M256, xArgYmm1}},
+	{op: VMOVUPD, vex: 0x6621, code: "\x10", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VMOVUPD, vex: 0x6621, code: "\x11", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgXmm1}},
+	{op: VMOVUPD, vex: 0x6621, code: "\x10", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VMOVUPD, vex: 0x6621, code: "\x11", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgYmm1}},
+	{op: VMOVUPD, vex: 0x6621, code: "\x10", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VMOVUPD, vex: 0x6621, code: "\x11", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm2M512, xArgMask, xArgZ, xArgZmm1}},
+	{op: VMOVUPS, vex: 0x1, code: "\x10", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VMOVUPS, vex: 0x1, code: "\x11", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm2M128, xArgXmm1}},
+	{op: VMOVUPS, vex: 0x1, code: "\x10", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VMOVUPS, vex: 0x1, code: "\x11", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm2M256, xArgYmm1}},
+	{op: VMOVUPS, vex: 0x21, code: "\x10", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VMOVUPS, vex: 0x21, code: "\x11", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgXmm1}},
+	{op: VMOVUPS, vex: 0x21, code: "\x10", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VMOVUPS, vex: 0x21, code: "\x11", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgYmm1}},
+	{op: VMOVUPS, vex: 0x21, code: "\x10", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VMOVUPS, vex: 0x21, code: "\x11", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm2M512, xArgMask, xArgZ, xArgZmm1}},
+	{op: VMPSADBW, vex: 0x6603, code: "\x42", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VMPSADBW, vex: 0x6603, code: "\x42", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VMULPD, vex: 0x6601, code: "\x59", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VMULPD, vex: 0x6601, code: "\x59", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VMULPD, vex: 0x6621, code: "\x59", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VMULPD, vex: 0x6621, code: "\x59", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VMULPD, vex: 0x6621, code: "\x59", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VMULPS, vex: 0x1, code: "\x59", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VMULPS, vex: 0x1, code: "\x59", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VMULPS, vex: 0x21, code: "\x59", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VMULPS, vex: 0x21, code: "\x59", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VMULPS, vex: 0x21, code: "\x59", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VMULSD, vex: 0xf201, code: "\x59", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VMULSD, vex: 0xf221, code: "\x59", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VMULSS, vex: 0xf301, code: "\x59", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VMULSS, vex: 0xf321, code: "\x59", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VORPD, vex: 0x6601, code: "\x56", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VORPD, vex: 0x6601, code: "\x56", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VORPD, vex: 0x6621, code: "\x56", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VORPD, vex: 0x6621, code: "\x56", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VORPD, vex: 0x6621, code: "\x56", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VORPS, vex: 0x1, code: "\x56", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VORPS, vex: 0x1, code: "\x56", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VORPS, vex: 0x21, code: "\x56", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VORPS, vex: 0x21, code: "\x56", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VORPS, vex: 0x21, code: "\x56", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPABSB, vex: 0x6602, code: "\x1c", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VPABSB, vex: 0x6602, code: "\x1c", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VPABSB, vex: 0x6622, code: "\x1c", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VPABSB, vex: 0x6622, code: "\x1c", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VPABSB, vex: 0x6622, code: "\x1c", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VPABSD, vex: 0x6602, code: "\x1e", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VPABSD, vex: 0x6602, code: "\x1e", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VPABSD, vex: 0x6622, code: "\x1e", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VPABSD, vex: 0x6622, code: "\x1e", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst}},
+	{op: VPABSD, vex: 0x6622, code: "\x1e", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst}},
+	{op: VPABSQ, vex: 0x6622, code: "\x1f", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VPABSQ, vex: 0x6622, code: "\x1f", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VPABSQ, vex: 0x6622, code: "\x1f", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst}},
+	{op: VPABSW, vex: 0x6602, code: "\x1d", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VPABSW, vex: 0x6602, code: "\x1d", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VPABSW, vex: 0x6622, code: "\x1d", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VPABSW, vex: 0x6622, code: "\x1d", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VPABSW, vex: 0x6622, code: "\x1d", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VPACKSSDW, vex: 0x6601, code: "\x6b", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPACKSSDW, vex: 0x6601, code: "\x6b", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPACKSSDW, vex: 0x6621, code: "\x6b", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPACKSSDW, vex: 0x6621, code: "\x6b", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPACKSSDW, vex: 0x6621, code: "\x6b", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPACKSSWB, vex: 0x6601, code: "\x63", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPACKSSWB, vex: 0x6601, code: "\x63", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPACKSSWB, vex: 0x6621, code: "\x63", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPACKSSWB, vex: 0x6621, code: "\x63", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPACKSSWB, vex: 0x6621, code: "\x63", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPACKUSDW, vex: 0x6602, code: "\x2b", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPACKUSDW, vex: 0x6602, code: "\x2b", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPACKUSDW, vex: 0x6622, code: "\x2b", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPACKUSDW, vex: 0x6622, code: "\x2b", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPACKUSDW, vex: 0x6622, code: "\x2b", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPACKUSWB, vex: 0x6601, code: "\x67", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPACKUSWB, vex: 0x6601, code: "\x67", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPACKUSWB, vex: 0x6621, code: "\x67", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPACKUSWB, vex: 0x6621, code: "\x67", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPACKUSWB, vex: 0x6621, code: "\x67", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPADDB, vex: 0x6601, code: "\xfc", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPADDB, vex: 0x6601, code: "\xfc", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPADDB, vex: 0x6621, code: "\xfc", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPADDB, vex: 0x6621, code: "\xfc", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPADDB, vex: 0x6621, code: "\xfc", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPADDD, vex: 0x6601, code: "\xfe", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPADDD, vex: 0x6601, code: "\xfe", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPADDD, vex: 0x6621, code: "\xfe", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPADDD, vex: 0x6621, code: "\xfe", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPADDD, vex: 0x6621, code: "\xfe", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPADDQ, vex: 0x6601, code: "\xd4", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPADDQ, vex: 0x6601, code: "\xd4", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPADDQ, vex: 0x6621, code: "\xd4", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPADDQ, vex: 0x6621, code: "\xd4", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPADDQ, vex: 0x6621, code: "\xd4", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPADDSB, vex: 0x6601, code: "\xec", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPADDSB, vex: 0x6601, code: "\xec", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPADDSB, vex: 0x6621, code: "\xec", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPADDSB, vex: 0x6621, code: "\xec", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPADDSB, vex: 0x6621, code: "\xec", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPADDSW, vex: 0x6601, code: "\xed", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPADDSW, vex: 0x6601, code: "\xed", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPADDSW, vex: 0x6621, code: "\xed", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPADDSW, vex: 0x6621, code: "\xed", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPADDSW, vex: 0x6621, code: "\xed", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPADDUSB, vex: 0x6601, code: "\xdc", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPADDUSB, vex: 0x6601, code: "\xdc", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPADDUSB, vex: 0x6621, code: "\xdc", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPADDUSB, vex: 0x6621, code: "\xdc", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPADDUSB, vex: 0x6621, code: "\xdc", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPADDUSW, vex: 0x6601, code: "\xdd", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPADDUSW, vex: 0x6601, code: "\xdd", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPADDUSW, vex: 0x6621, code: "\xdd", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPADDUSW, vex: 0x6621, code: "\xdd", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPADDUSW, vex: 0x6621, code: "\xdd", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPADDW, vex: 0x6601, code: "\xfd", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPADDW, vex: 0x6601, code: "\xfd", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPADDW, vex: 0x6621, code: "\xfd", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPADDW, vex: 0x6621, code: "\xfd", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPADDW, vex: 0x6621, code: "\xfd", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPALIGNR, vex: 0x6603, code: "\x0f", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VPALIGNR, vex: 0x6603, code: "\x0f", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VPALIGNR, vex: 0x6623, code: "\x0f", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VPALIGNR, vex: 0x6623, code: "\x0f", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VPALIGNR, vex: 0x6623, code: "\x0f", modrm: encSlashR, vexL: 2, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgImm8u}},
+	{op: VPAND, vex: 0x6601, code: "\xdb", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPAND, vex: 0x6601, code: "\xdb", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPANDD, vex: 0x6621, code: "\xdb", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPANDD, vex: 0x6621, code: "\xdb", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPANDD, vex: 0x6621, code: "\xdb", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPANDN, vex: 0x6601, code: "\xdf", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPANDN, vex: 0x6601, code: "\xdf", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPANDND, vex: 0x6621, code: "\xdf", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPANDND, vex: 0x6621, code: "\xdf", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPANDND, vex: 0x6621, code: "\xdf", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPANDNQ, vex: 0x6621, code: "\xdf", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPANDNQ, vex: 0x6621, code: "\xdf", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPANDNQ, vex: 0x6621, code: "\xdf", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPANDQ, vex: 0x6621, code: "\xdb", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPANDQ, vex: 0x6621, code: "\xdb", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPANDQ, vex: 0x6621, code: "\xdb", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPAVGB, vex: 0x6601, code: "\xe0", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPAVGB, vex: 0x6601, code: "\xe0", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPAVGB, vex: 0x6621, code: "\xe0", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPAVGB, vex: 0x6621, code: "\xe0", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPAVGB, vex: 0x6621, code: "\xe0", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPAVGW, vex: 0x6601, code: "\xe3", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPAVGW, vex: 0x6601, code: "\xe3", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPAVGW, vex: 0x6621, code: "\xe3", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPAVGW, vex: 0x6621, code: "\xe3", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPAVGW, vex: 0x6621, code: "\xe3", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPBLENDD, vex: 0x6603, code: "\x02", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VPBLENDD, vex: 0x6603, code: "\x02", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VPBLENDMB, vex: 0x6622, code: "\x66", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPBLENDMB, vex: 0x6622, code: "\x66", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPBLENDMB, vex: 0x6622, code: "\x66", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPBLENDMD, vex: 0x6622, code: "\x64", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPBLENDMD, vex: 0x6622, code: "\x64", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPBLENDMD, vex: 0x6622, code: "\x64", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPBLENDMQ, vex: 0x6622, code: "\x64", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPBLENDMQ, vex: 0x6622, code: "\x64", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPBLENDMQ, vex: 0x6622, code: "\x64", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPBLENDMW, vex: 0x6622, code: "\x66", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPBLENDMW, vex: 0x6622, code: "\x66", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPBLENDMW, vex: 0x6622, code: "\x66", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPBLENDVB, vex: 0x6603, code: "\x4c", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128, xArgXmmIH}},
+	{op: VPBLENDVB, vex: 0x6603, code: "\x4c", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256, xArgYmmIH}},
+	{op: VPBLENDW, vex: 0x6603, code: "\x0e", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VPBLENDW, vex: 0x6603, code: "\x0e", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VPBROADCASTB, vex: 0x6602, code: "\x78", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmm2M8}},
+	{op: VPBROADCASTB, vex: 0x6602, code: "\x78", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgXmm2M8}},
+	{op: VPBROADCASTB, vex: 0x6622, code: "\x78", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M8}},
+	{op: VPBROADCASTB, vex: 0x6622, code: "\x7a", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgRmf32}},
+	{op: VPBROADCASTB, vex: 0x6622, code: "\x78", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M8}},
+	{op: VPBROADCASTB, vex: 0x6622, code: "\x7a", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgRmf32}},
+	{op: VPBROADCASTB, vex: 0x6622, code: "\x78", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgXmm2M8}},
+	{op: VPBROADCASTB, vex: 0x6622, code: "\x7a", modrm: encSlashR, vexL: 2, vexW: 0, isMem: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgRmf32}},
+	{op: VPBROADCASTD, vex: 0x6602, code: "\x58", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: VPBROADCASTD, vex: 0x6602, code: "\x58", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgXmm2M32}},
+	{op: VPBROADCASTD, vex: 0x6622, code: "\x58", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M32}},
+	{op: VPBROADCASTD, vex: 0x6622, code: "\x7c", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgRmf32}},
+	{op: VPBROADCASTD, vex: 0x6622, code: "\x58", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M32}},
+	{op: VPBROADCASTD, vex: 0x6622, code: "\x7c", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgRmf32}},
+	{op: VPBROADCASTD, vex: 0x6622, code: "\x58", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgXmm2M32}},
+	{op: VPBROADCASTD, vex: 0x6622, code: "\x7c", modrm: encSlashR, vexL: 2, vexW: 0, isMem: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgRmf32}},
+	{op: VPBROADCASTMB2Q, vex: 0xf322, code: "\x2a", modrm: encSlashR, vexL: 0, vexW: 1, isMem: -1, args: []decodeOp{xArgXmm1, xArgK2}},
+	{op: VPBROADCASTMB2Q, vex: 0xf322, code: "\x2a", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgYmm1, xArgK2}},
+	{op: VPBROADCASTMB2Q, vex: 0xf322, code: "\x2a", modrm: encSlashR, vexL: 2, vexW: 1, isMem: -1, args: []decodeOp{xArgZmm1, xArgK2}},
+	{op: VPBROADCASTMW2D, vex: 0xf322, code: "\x3a", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgXmm1, xArgK2}},
+	{op: VPBROADCASTMW2D, vex: 0xf322, code: "\x3a", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgYmm1, xArgK2}},
+	{op: VPBROADCASTMW2D, vex: 0xf322, code: "\x3a", modrm: encSlashR, vexL: 2, vexW: 0, isMem: -1, args: []decodeOp{xArgZmm1, xArgK2}},
+	{op: VPBROADCASTQ, vex: 0x6602, code: "\x59", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: VPBROADCASTQ, vex: 0x6602, code: "\x59", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgXmm2M64}},
+	{op: VPBROADCASTQ, vex: 0x6622, code: "\x59", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VPBROADCASTQ, vex: 0x6622, code: "\x7c", modrm: encSlashR, is64: 1, vexL: 0, vexW: 1, isMem: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgRmf64}},
+	{op: VPBROADCASTQ, vex: 0x6622, code: "\x59", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VPBROADCASTQ, vex: 0x6622, code: "\x7c", modrm: encSlashR, is64: 1, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgRmf64}},
+	{op: VPBROADCASTQ, vex: 0x6622, code: "\x59", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VPBROADCASTQ, vex: 0x6622, code: "\x7c", modrm: encSlashR, is64: 1, vexL: 2, vexW: 1, isMem: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgRmf64}},
+	{op: VPBROADCASTW, vex: 0x6602, code: "\x79", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmm2M16}},
+	{op: VPBROADCASTW, vex: 0x6602, code: "\x79", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgXmm2M16}},
+	{op: VPBROADCASTW, vex: 0x6622, code: "\x79", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M16}},
+	{op: VPBROADCASTW, vex: 0x6622, code: "\x7b", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgRmf32}},
+	{op: VPBROADCASTW, vex: 0x6622, code: "\x79", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M16}},
+	{op: VPBROADCASTW, vex: 0x6622, code: "\x7b", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgRmf32}},
+	{op: VPBROADCASTW, vex: 0x6622, code: "\x79", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgXmm2M16}},
+	{op: VPBROADCASTW, vex: 0x6622, code: "\x7b", modrm: encSlashR, vexL: 2, vexW: 0, isMem: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgRmf32}},
+	{op: VPCLMULQDQ, vex: 0x6603, code: "\x44", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VPCMPB, vex: 0x6623, code: "\x3f", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VPCMPB, vex: 0x6623, code: "\x3f", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VPCMPB, vex: 0x6623, code: "\x3f", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgImm8u}},
+	{op: VPCMPD, vex: 0x6623, code: "\x1f", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VPCMPD, vex: 0x6623, code: "\x1f", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VPCMPD, vex: 0x6623, code: "\x1f", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgImm8u}},
+	{op: VPCMPEQB, vex: 0x6601, code: "\x74", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPCMPEQB, vex: 0x6601, code: "\x74", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPCMPEQB, vex: 0x6621, code: "\x74", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128}},
+	{op: VPCMPEQB, vex: 0x6621, code: "\x74", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256}},
+	{op: VPCMPEQB, vex: 0x6621, code: "\x74", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512}},
+	{op: VPCMPEQD, vex: 0x6601, code: "\x76", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPCMPEQD, vex: 0x6601, code: "\x76", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPCMPEQD, vex: 0x6621, code: "\x76", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPCMPEQD, vex: 0x6621, code: "\x76", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPCMPEQD, vex: 0x6621, code: "\x76", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPCMPEQQ, vex: 0x6602, code: "\x29", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPCMPEQQ, vex: 0x6602, code: "\x29", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPCMPEQQ, vex: 0x6622, code: "\x29", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPCMPEQQ, vex: 0x6622, code: "\x29", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPCMPEQQ, vex: 0x6622, code: "\x29", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPCMPEQW, vex: 0x6601, code: "\x75", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPCMPEQW, vex: 0x6601, code: "\x75", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPCMPEQW, vex: 0x6621, code: "\x75", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128}},
+	{op: VPCMPEQW, vex: 0x6621, code: "\x75", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256}},
+	{op: VPCMPEQW, vex: 0x6621, code: "\x75", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512}},
+	{op: VPCMPESTRI, vex: 0x6603, code: "\x61", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: VPCMPESTRM, vex: 0x6603, code: "\x60", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: VPCMPGTB, vex: 0x6601, code: "\x64", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPCMPGTB, vex: 0x6601, code: "\x64", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPCMPGTB, vex: 0x6621, code: "\x64", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128}},
+	{op: VPCMPGTB, vex: 0x6621, code: "\x64", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256}},
+	{op: VPCMPGTB, vex: 0x6621, code: "\x64", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512}},
+	{op: VPCMPGTD, vex: 0x6601, code: "\x66", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPCMPGTD, vex: 0x6601, code: "\x66", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPCMPGTD, vex: 0x6621, code: "\x66", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPCMPGTD, vex: 0x6621, code: "\x66", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPCMPGTD, vex: 0x6621, code: "\x66", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPCMPGTQ, vex: 0x6602, code: "\x37", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPCMPGTQ, vex: 0x6602, code: "\x37", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPCMPGTQ, vex: 0x6622, code: "\x37", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPCMPGTQ, vex: 0x6622, code: "\x37", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPCMPGTQ, vex: 0x6622, code: "\x37", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPCMPGTW, vex: 0x6601, code: "\x65", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPCMPGTW, vex: 0x6601, code: "\x65", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPCMPGTW, vex: 0x6621, code: "\x65", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128}},
+	{op: VPCMPGTW, vex: 0x6621, code: "\x65", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256}},
+	{op: VPCMPGTW, vex: 0x6621, code: "\x65", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512}},
+	{op: VPCMPISTRI, vex: 0x6603, code: "\x63", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: VPCMPISTRM, vex: 0x6603, code: "\x62", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: VPCMPQ, vex: 0x6623, code: "\x1f", modrm: encSlashR, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VPCMPQ, vex: 0x6623, code: "\x1f", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VPCMPQ, vex: 0x6623, code: "\x1f", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgImm8u}},
+	{op: VPCMPUB, vex: 0x6623, code: "\x3e", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VPCMPUB, vex: 0x6623, code: "\x3e", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VPCMPUB, vex: 0x6623, code: "\x3e", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgImm8u}},
+	{op: VPCMPUD, vex: 0x6623, code: "\x1e", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VPCMPUD, vex: 0x6623, code: "\x1e", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VPCMPUD, vex: 0x6623, code: "\x1e", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgImm8u}},
+	{op: VPCMPUQ, vex: 0x6623, code: "\x1e", modrm: encSlashR, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VPCMPUQ, vex: 0x6623, code: "\x1e", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VPCMPUQ, vex: 0x6623, code: "\x1e", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgImm8u}},
+	{op: VPCMPUW, vex: 0x6623, code: "\x3e", modrm: encSlashR, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VPCMPUW, vex: 0x6623, code: "\x3e", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VPCMPUW, vex: 0x6623, code: "\x3e", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgImm8u}},
+	{op: VPCMPW, vex: 0x6623, code: "\x3f", modrm: encSlashR, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VPCMPW, vex: 0x6623, code: "\x3f", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VPCMPW, vex: 0x6623, code: "\x3f", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgImm8u}},
+	{op: VPCOMPRESSD, vex: 0x6622, code: "\x8b", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPCOMPRESSD, vex: 0x6622, code: "\x8b", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPCOMPRESSD, vex: 0x6622, code: "\x8b", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm2M512, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPCOMPRESSQ, vex: 0x6622, code: "\x8b", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPCOMPRESSQ, vex: 0x6622, code: "\x8b", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPCOMPRESSQ, vex: 0x6622, code: "\x8b", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm2M512, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPCONFLICTD, vex: 0x6622, code: "\xc4", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VPCONFLICTD, vex: 0x6622, code: "\xc4", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst}},
+	{op: VPCONFLICTD, vex: 0x6622, code: "\xc4", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst}},
+	{op: VPCONFLICTQ, vex: 0x6622, code: "\xc4", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VPCONFLICTQ, vex: 0x6622, code: "\xc4", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VPCONFLICTQ, vex: 0x6622, code: "\xc4", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst}},
+	{op: VPERM2F128, vex: 0x6603, code: "\x06", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VPERM2I128, vex: 0x6603, code: "\x46", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VPERMD, vex: 0x6602, code: "\x36", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPERMD, vex: 0x6622, code: "\x36", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPERMD, vex: 0x6622, code: "\x36", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPERMI2D, vex: 0x6622, code: "\x76", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPERMI2D, vex: 0x6622, code: "\x76", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPERMI2D, vex: 0x6622, code: "\x76", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPERMI2PD, vex: 0x6622, code: "\x77", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPERMI2PD, vex: 0x6622, code: "\x77", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPERMI2PD, vex: 0x6622, code: "\x77", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPERMI2PS, vex: 0x6622, code: "\x77", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPERMI2PS, vex: 0x6622, code: "\x77", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPERMI2PS, vex: 0x6622, code: "\x77", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPERMI2Q, vex: 0x6622, code: "\x76", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPERMI2Q, vex: 0x6622, code: "\x76", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPERMI2Q, vex: 0x6622, code: "\x76", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPERMI2W, vex: 0x6622, code: "\x75", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPERMI2W, vex: 0x6622, code: "\x75", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPERMI2W, vex: 0x6622, code: "\x75", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPERMILPD, vex: 0x6602, code: "\x0d", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPERMILPD, vex: 0x6603, code: "\x05", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: VPERMILPD, vex: 0x6602, code: "\x0d", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPERMILPD, vex: 0x6603, code: "\x05", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmm2M256, xArgImm8u}},
+	{op: VPERMILPD, vex: 0x6622, code: "\x0d", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPERMILPD, vex: 0x6622, code: "\x0d", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPERMILPD, vex: 0x6622, code: "\x0d", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPERMILPD, vex: 0x6623, code: "\x05", modrm: encSlashR, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VPERMILPD, vex: 0x6623, code: "\x05", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VPERMILPD, vex: 0x6623, code: "\x05", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgImm8u}},
+	{op: VPERMILPS, vex: 0x6602, code: "\x0c", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPERMILPS, vex: 0x6603, code: "\x04", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: VPERMILPS, vex: 0x6602, code: "\x0c", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPERMILPS, vex: 0x6603, code: "\x04", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmm2M256, xArgImm8u}},
+	{op: VPERMILPS, vex: 0x6622, code: "\x0c", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPERMILPS, vex: 0x6622, code: "\x0c", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPERMILPS, vex: 0x6622, code: "\x0c", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPERMILPS, vex: 0x6623, code: "\x04", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VPERMILPS, vex: 0x6623, code: "\x04", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VPERMILPS, vex: 0x6623, code: "\x04", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgImm8u}},
+	{op: VPERMPD, vex: 0x6603, code: "\x01", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmm2M256, xArgImm8u}},
+	{op: VPERMPD, vex: 0x6622, code: "\x16", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPERMPD, vex: 0x6622, code: "\x16", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPERMPD, vex: 0x6623, code: "\x01", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VPERMPD, vex: 0x6623, code: "\x01", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgImm8u}},
+	{op: VPERMPS, vex: 0x6602, code: "\x16", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPERMPS, vex: 0x6622, code: "\x16", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPERMPS, vex: 0x6622, code: "\x16", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPERMQ, vex: 0x6603, code: "\x00", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmm2M256, xArgImm8u}},
+	{op: VPERMQ, vex: 0x6622, code: "\x36", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPERMQ, vex: 0x6622, code: "\x36", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPERMQ, vex: 0x6623, code: "\x00", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VPERMQ, vex: 0x6623, code: "\x00", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgImm8u}},
+	{op: VPERMT2D, vex: 0x6622, code: "\x7e", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPERMT2D, vex: 0x6622, code: "\x7e", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPERMT2D, vex: 0x6622, code: "\x7e", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPERMT2PD, vex: 0x6622, code: "\x7f", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPERMT2PD, vex: 0x6622, code: "\x7f", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPERMT2PD, vex: 0x6622, code: "\x7f", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPERMT2PS, vex: 0x6622, code: "\x7f", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPERMT2PS, vex: 0x6622, code: "\x7f", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPERMT2PS, vex: 0x6622, code: "\x7f", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPERMT2Q, vex: 0x6622, code: "\x7e", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPERMT2Q, vex: 0x6622, code: "\x7e", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPERMT2Q, vex: 0x6622, code: "\x7e", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPERMT2W, vex: 0x6622, code: "\x7d", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPERMT2W, vex: 0x6622, code: "\x7d", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPERMT2W, vex: 0x6622, code: "\x7d", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPERMW, vex: 0x6622, code: "\x8d", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPERMW, vex: 0x6622, code: "\x8d", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPERMW, vex: 0x6622, code: "\x8d", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPEXPANDD, vex: 0x6622, code: "\x89", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VPEXPANDD, vex: 0x6622, code: "\x89", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VPEXPANDD, vex: 0x6622, code: "\x89", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VPEXPANDQ, vex: 0x6622, code: "\x89", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VPEXPANDQ, vex: 0x6622, code: "\x89", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VPEXPANDQ, vex: 0x6622, code: "\x89", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512}},
+	{op: VPEXTRB, vex: 0x6603, code: "\x14", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgR32M8, xArgXmm1, xArgImm8u}},
+	{op: VPEXTRB, vex: 0x6623, code: "\x14", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgR32M8, xArgXmm1, xArgImm8u}},
+	{op: VPEXTRD, vex: 0x6603, code: "\x16", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgXmm1, xArgImm8u}},
+	{op: VPEXTRD, vex: 0x6623, code: "\x16", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgXmm1, xArgImm8u}},
+	{op: VPEXTRQ, vex: 0x6603, code: "\x16", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgXmm1, xArgImm8u}},
+	{op: VPEXTRQ, vex: 0x6623, code: "\x16", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgXmm1, xArgImm8u}},
+	{op: VPEXTRW, vex: 0x6601, code: "\xc5", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgR32, xArgXmm2, xArgImm8u}},
+	{op: VPEXTRW, vex: 0x6603, code: "\x15", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgR32M16, xArgXmm1, xArgImm8u}},
+	{op: VPEXTRW, vex: 0x6623, code: "\x15", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgR32M16, xArgXmm1, xArgImm8u}},
+	{op: VPEXTRW, vex: 0x6621, code: "\xc5", modrm: encSlashR, vexL: 0, vexW: -1, isMem: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgR32, xArgXmm2, xArgImm8u}},
+	{op: VPGATHERDD, vex: 0x6602, code: "\x90", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgVm32x, xArgXmmV}},
+	{op: VPGATHERDD, vex: 0x6602, code: "\x90", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgVm32y, xArgYmmV}},
+	{op: VPGATHERDD, vex: 0x6622, code: "\x90", modrm: encSlashR, vexL: 0, vexW: 0, isMem: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgVm32x}},
+	{op: VPGATHERDD, vex: 0x6622, code: "\x90", modrm: encSlashR, vexL: 1, vexW: 0, isMem: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgVm32y}},
+	{op: VPGATHERDD, vex: 0x6622, code: "\x90", modrm: encSlashR, vexL: 2, vexW: 0, isMem: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgVm32z}},
+	{op: VPGATHERDQ, vex: 0x6602, code: "\x90", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgVm32x, xArgXmmV}},
+	{op: VPGATHERDQ, vex: 0x6602, code: "\x90", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgVm32x, xArgYmmV}},
+	{op: VPGATHERDQ, vex: 0x6622, code: "\x90", modrm: encSlashR, vexL: 0, vexW: 1, isMem: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgVm32x}},
+	{op: VPGATHERDQ, vex: 0x6622, code: "\x90", modrm: encSlashR, vexL: 1, vexW: 1, isMem: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgVm32x}},
+	{op: VPGATHERDQ, vex: 0x6622, code: "\x90", modrm: encSlashR, vexL: 2, vexW: 1, isMem: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgVm32y}},
+	{op: VPGATHERQD, vex: 0x6602, code: "\x91", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgVm64x, xArgXmmV}},
+	{op: VPGATHERQD, vex: 0x6602, code: "\x91", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm1, xArgVm64y, xArgXmmV}},
+	{op: VPGATHERQD, vex: 0x6622, code: "\x91", modrm: encSlashR, vexL: 0, vexW: 0, isMem: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgVm64x}},
+	{op: VPGATHERQD, vex: 0x6622, code: "\x91", modrm: encSlashR, vexL: 1, vexW: 0, isMem: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgVm64y}},
+	{op: VPGATHERQD, vex: 0x6622, code: "\x91", modrm: encSlashR, vexL: 2, vexW: 0, isMem: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgVm64z}},
+	{op: VPGATHERQQ, vex: 0x6602, code: "\x91", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgVm64x, xArgXmmV}},
+	{op: VPGATHERQQ, vex: 0x6602, code: "\x91", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgVm64y, xArgYmmV}},
+	{op: VPGATHERQQ, vex: 0x6622, code: "\x91", modrm: encSlashR, vexL: 0, vexW: 1, isMem: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgVm64x}},
+	{op: VPGATHERQQ, vex: 0x6622, code: "\x91", modrm: encSlashR, vexL: 1, vexW: 1, isMem: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgVm64y}},
+	{op: VPGATHERQQ, vex: 0x6622, code: "\x91", modrm: encSlashR, vexL: 2, vexW: 1, isMem: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgVm64z}},
+	{op: VPHADDD, vex: 0x6602, code: "\x02", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPHADDD, vex: 0x6602, code: "\x02", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPHADDSW, vex: 0x6602, code: "\x03", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPHADDSW, vex: 0x6602, code: "\x03", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPHADDW, vex: 0x6602, code: "\x01", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPHADDW, vex: 0x6602, code: "\x01", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPHMINPOSUW, vex: 0x6602, code: "\x41", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VPHSUBD, vex: 0x6602, code: "\x06", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPHSUBD, vex: 0x6602, code: "\x06", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPHSUBSW, vex: 0x6602, code: "\x07", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPHSUBSW, vex: 0x6602, code: "\x07", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPHSUBW, vex: 0x6602, code: "\x05", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPHSUBW, vex: 0x6602, code: "\x05", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPINSRB, vex: 0x6603, code: "\x20", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgR32M8, xArgImm8u}},
+	{op: VPINSRB, vex: 0x6623, code: "\x20", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgR32M8, xArgImm8u}},
+	{op: VPINSRD, vex: 0x6603, code: "\x22", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgRM32, xArgImm8u}},
+	{op: VPINSRD, vex: 0x6623, code: "\x22", modrm: encSlashR, dataSize: 32, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgRM32, xArgImm8u}},
+	{op: VPINSRQ, vex: 0x6603, code: "\x22", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgRM64, xArgImm8u}},
+	{op: VPINSRQ, vex: 0x6623, code: "\x22", modrm: encSlashR, is64: 1, dataSize: 64, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgRM64, xArgImm8u}},
+	{op: VPINSRW, vex: 0x6601, code: "\xc4", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgR32M16, xArgImm8u}},
+	{op: VPINSRW, vex: 0x6621, code: "\xc4", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgR32M16, xArgImm8u}},
+	{op: VPLZCNTD, vex: 0x6622, code: "\x44", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VPLZCNTD, vex: 0x6622, code: "\x44", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst}},
+	{op: VPLZCNTD, vex: 0x6622, code: "\x44", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst}},
+	{op: VPLZCNTQ, vex: 0x6622, code: "\x44", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VPLZCNTQ, vex: 0x6622, code: "\x44", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VPLZCNTQ, vex: 0x6622, code: "\x44", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst}},
+	{op: VPMADDUBSW, vex: 0x6602, code: "\x04", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMADDUBSW, vex: 0x6602, code: "\x04", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMADDUBSW, vex: 0x6622, code: "\x04", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPMADDUBSW, vex: 0x6622, code: "\x04", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPMADDUBSW, vex: 0x6622, code: "\x04", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPMADDWD, vex: 0x6601, code: "\xf5", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMADDWD, vex: 0x6601, code: "\xf5", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMADDWD, vex: 0x6621, code: "\xf5", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPMADDWD, vex: 0x6621, code: "\xf5", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPMADDWD, vex: 0x6621, code: "\xf5", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPMASKMOVD, vex: 0x6602, code: "\x8e", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgM128, xArgXmmV, xArgXmm1}},
+	{op: VPMASKMOVD, vex: 0x6602, code: "\x8e", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgM256, xArgYmmV, xArgYmm1}},
+	{op: VPMASKMOVD, vex: 0x6602, code: "\x8c", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgM128}},
+	{op: VPMASKMOVD, vex: 0x6602, code: "\x8c", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgM256}},
+	{op: VPMASKMOVQ, vex: 0x6602, code: "\x8e", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgM128, xArgXmmV, xArgXmm1}},
+	{op: VPMASKMOVQ, vex: 0x6602, code: "\x8e", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgM256, xArgYmmV, xArgYmm1}},
+	{op: VPMASKMOVQ, vex: 0x6602, code: "\x8c", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgM128}},
+	{op: VPMASKMOVQ, vex: 0x6602, code: "\x8c", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgM256}},
+	{op: VPMAXSB, vex: 0x6602, code: "\x3c", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMAXSB, vex: 0x6602, code: "\x3c", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMAXSB, vex: 0x6622, code: "\x3c", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPMAXSB, vex: 0x6622, code: "\x3c", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPMAXSB, vex: 0x6622, code: "\x3c", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPMAXSD, vex: 0x6602, code: "\x3d", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMAXSD, vex: 0x6602, code: "\x3d", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMAXSD, vex: 0x6622, code: "\x3d", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPMAXSD, vex: 0x6622, code: "\x3d", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPMAXSD, vex: 0x6622, code: "\x3d", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPMAXSQ, vex: 0x6622, code: "\x3d", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPMAXSQ, vex: 0x6622, code: "\x3d", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPMAXSQ, vex: 0x6622, code: "\x3d", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPMAXSW, vex: 0x6601, code: "\xee", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMAXSW, vex: 0x6601, code: "\xee", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMAXSW, vex: 0x6621, code: "\xee", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPMAXSW, vex: 0x6621, code: "\xee", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPMAXSW, vex: 0x6621, code: "\xee", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPMAXUB, vex: 0x6601, code: "\xde", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMAXUB, vex: 0x6601, code: "\xde", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMAXUB, vex: 0x6621, code: "\xde", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPMAXUB, vex: 0x6621, code: "\xde", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPMAXUB, vex: 0x6621, code: "\xde", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPMAXUD, vex: 0x6602, code: "\x3f", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMAXUD, vex: 0x6602, code: "\x3f", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMAXUD, vex: 0x6622, code: "\x3f", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPMAXUD, vex: 0x6622, code: "\x3f", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPMAXUD, vex: 0x6622, code: "\x3f", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPMAXUQ, vex: 0x6622, code: "\x3f", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPMAXUQ, vex: 0x6622, code: "\x3f", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPMAXUQ, vex: 0x6622, code: "\x3f", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPMAXUW, vex: 0x6602, code: "\x3e", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMAXUW, vex: 0x6602, code: "\x3e", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMAXUW, vex: 0x6622, code: "\x3e", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPMAXUW, vex: 0x6622, code: "\x3e", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPMAXUW, vex: 0x6622, code: "\x3e", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPMINSB, vex: 0x6602, code: "\x38", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMINSB, vex: 0x6602, code: "\x38", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMINSB, vex: 0x6622, code: "\x38", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPMINSB, vex: 0x6622, code: "\x38", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPMINSB, vex: 0x6622, code: "\x38", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPMINSD, vex: 0x6602, code: "\x39", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMINSD, vex: 0x6602, code: "\x39", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMINSD, vex: 0x6622, code: "\x39", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPMINSD, vex: 0x6622, code: "\x39", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPMINSD, vex: 0x6622, code: "\x39", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPMINSQ, vex: 0x6622, code: "\x39", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPMINSQ, vex: 0x6622, code: "\x39", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPMINSQ, vex: 0x6622, code: "\x39", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPMINSW, vex: 0x6601, code: "\xea", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMINSW, vex: 0x6601, code: "\xea", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMINSW, vex: 0x6621, code: "\xea", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPMINSW, vex: 0x6621, code: "\xea", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPMINSW, vex: 0x6621, code: "\xea", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPMINUB, vex: 0x6601, code: "\xda", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMINUB, vex: 0x6601, code: "\xda", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMINUB, vex: 0x6621, code: "\xda", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPMINUB, vex: 0x6621, code: "\xda", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPMINUB, vex: 0x6621, code: "\xda", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPMINUD, vex: 0x6602, code: "\x3b", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMINUD, vex: 0x6602, code: "\x3b", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMINUD, vex: 0x6622, code: "\x3b", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPMINUD, vex: 0x6622, code: "\x3b", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPMINUD, vex: 0x6622, code: "\x3b", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPMINUQ, vex: 0x6622, code: "\x3b", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPMINUQ, vex: 0x6622, code: "\x3b", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPMINUQ, vex: 0x6622, code: "\x3b", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPMINUW, vex: 0x6602, code: "\x3a", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMINUW, vex: 0x6602, code: "\x3a", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMINUW, vex: 0x6622, code: "\x3a", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPMINUW, vex: 0x6622, code: "\x3a", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPMINUW, vex: 0x6622, code: "\x3a", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPMOVB2M, vex: 0xf322, code: "\x29", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgXmm2}},
+	{op: VPMOVB2M, vex: 0xf322, code: "\x29", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgYmm2}},
+	{op: VPMOVB2M, vex: 0xf322, code: "\x29", modrm: encSlashR, vexL: 2, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgZmm2}},
+	{op: VPMOVD2M, vex: 0xf322, code: "\x39", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgXmm2}},
+	{op: VPMOVD2M, vex: 0xf322, code: "\x39", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgYmm2}},
+	{op: VPMOVD2M, vex: 0xf322, code: "\x39", modrm: encSlashR, vexL: 2, vexW: 0, isMem: -1, args: []decodeOp{xArgK1, xArgZmm2}},
+	{op: VPMOVDB, vex: 0xf322, code: "\x31", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M32, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVDB, vex: 0xf322, code: "\x31", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVDB, vex: 0xf322, code: "\x31", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVDW, vex: 0xf322, code: "\x33", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVDW, vex: 0xf322, code: "\x33", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVDW, vex: 0xf322, code: "\x33", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVM2B, vex: 0xf322, code: "\x28", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgXmm1, xArgK2}},
+	{op: VPMOVM2B, vex: 0xf322, code: "\x28", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgYmm1, xArgK2}},
+	{op: VPMOVM2B, vex: 0xf322, code: "\x28", modrm: encSlashR, vexL: 2, vexW: 0, isMem: -1, args: []decodeOp{xArgZmm1, xArgK2}},
+	{op: VPMOVM2D, vex: 0xf322, code: "\x38", modrm: encSlashR, vexL: 0, vexW: 0, isMem: -1, args: []decodeOp{xArgXmm1, xArgK2}},
+	{op: VPMOVM2D, vex: 0xf322, code: "\x38", modrm: encSlashR, vexL: 1, vexW: 0, isMem: -1, args: []decodeOp{xArgYmm1, xArgK2}},
+	{op: VPMOVM2D, vex: 0xf322, code: "\x38", modrm: encSlashR, vexL: 2, vexW: 0, isMem: -1, args: []decodeOp{xArgZmm1, xArgK2}},
+	{op: VPMOVM2Q, vex: 0xf322, code: "\x38", modrm: encSlashR, vexL: 0, vexW: 1, isMem: -1, args: []decodeOp{xArgXmm1, xArgK2}},
+	{op: VPMOVM2Q, vex: 0xf322, code: "\x38", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgYmm1, xArgK2}},
+	{op: VPMOVM2Q, vex: 0xf322, code: "\x38", modrm: encSlashR, vexL: 2, vexW: 1, isMem: -1, args: []decodeOp{xArgZmm1, xArgK2}},
+	{op: VPMOVM2W, vex: 0xf322, code: "\x28", modrm: encSlashR, vexL: 0, vexW: 1, isMem: -1, args: []decodeOp{xArgXmm1, xArgK2}},
+	{op: VPMOVM2W, vex: 0xf322, code: "\x28", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgYmm1, xArgK2}},
+	{op: VPMOVM2W, vex: 0xf322, code: "\x28", modrm: encSlashR, vexL: 2, vexW: 1, isMem: -1, args: []decodeOp{xArgZmm1, xArgK2}},
+	{op: VPMOVMSKB, vex: 0x6601, code: "\xd7", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgR32, xArgXmm2}},
+	{op: VPMOVMSKB, vex: 0x6601, code: "\xd7", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgR32, xArgYmm2}},
+	{op: VPMOVQ2M, vex: 0xf322, code: "\x39", modrm: encSlashR, vexL: 0, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgXmm2}},
+	{op: VPMOVQ2M, vex: 0xf322, code: "\x39", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgYmm2}},
+	{op: VPMOVQ2M, vex: 0xf322, code: "\x39", modrm: encSlashR, vexL: 2, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgZmm2}},
+	{op: VPMOVQB, vex: 0xf322, code: "\x32", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M16, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVQB, vex: 0xf322, code: "\x32", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M32, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVQB, vex: 0xf322, code: "\x32", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVQD, vex: 0xf322, code: "\x35", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVQD, vex: 0xf322, code: "\x35", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVQD, vex: 0xf322, code: "\x35", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVQW, vex: 0xf322, code: "\x34", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M32, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVQW, vex: 0xf322, code: "\x34", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVQW, vex: 0xf322, code: "\x34", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVSDB, vex: 0xf322, code: "\x21", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M32, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVSDB, vex: 0xf322, code: "\x21", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVSDB, vex: 0xf322, code: "\x21", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVSDW, vex: 0xf322, code: "\x23", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVSDW, vex: 0xf322, code: "\x23", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVSDW, vex: 0xf322, code: "\x23", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVSQB, vex: 0xf322, code: "\x22", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M16, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVSQB, vex: 0xf322, code: "\x22", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M32, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVSQB, vex: 0xf322, code: "\x22", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVSQD, vex: 0xf322, code: "\x25", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVSQD, vex: 0xf322, code: "\x25", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVSQD, vex: 0xf322, code: "\x25", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVSQW, vex: 0xf322, code: "\x24", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M32, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVSQW, vex: 0xf322, code: "\x24", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVSQW, vex: 0xf322, code: "\x24", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVSWB, vex: 0xf322, code: "\x20", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVSWB, vex: 0xf322, code: "\x20", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVSWB, vex: 0xf322, code: "\x20", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVSXBD, vex: 0x6602, code: "\x21", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: VPMOVSXBD, vex: 0x6602, code: "\x21", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgXmm2M64}},
+	{op: VPMOVSXBD, vex: 0x6622, code: "\x21", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M32}},
+	{op: VPMOVSXBD, vex: 0x6622, code: "\x21", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VPMOVSXBD, vex: 0x6622, code: "\x21", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VPMOVSXBQ, vex: 0x6602, code: "\x22", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M16}},
+	{op: VPMOVSXBQ, vex: 0x6602, code: "\x22", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgXmm2M32}},
+	{op: VPMOVSXBQ, vex: 0x6622, code: "\x22", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M16}},
+	{op: VPMOVSXBQ, vex: 0x6622, code: "\x22", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M32}},
+	{op: VPMOVSXBQ, vex: 0x6622, code: "\x22", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VPMOVSXBW, vex: 0x6602, code: "\x20", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: VPMOVSXBW, vex: 0x6602, code: "\x20", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgXmm2M128}},
+	{op: VPMOVSXBW, vex: 0x6622, code: "\x20", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VPMOVSXBW, vex: 0x6622, code: "\x20", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VPMOVSXBW, vex: 0x6622, code: "\x20", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VPMOVSXDQ, vex: 0x6602, code: "\x25", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: VPMOVSXDQ, vex: 0x6602, code: "\x25", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgXmm2M128}},
+	{op: VPMOVSXDQ, vex: 0x6622, code: "\x25", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VPMOVSXDQ, vex: 0x6622, code: "\x25", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VPMOVSXDQ, vex: 0x6622, code: "\x25", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VPMOVSXWD, vex: 0x6602, code: "\x23", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: VPMOVSXWD, vex: 0x6602, code: "\x23", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgXmm2M128}},
+	{op: VPMOVSXWD, vex: 0x6622, code: "\x23", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VPMOVSXWD, vex: 0x6622, code: "\x23", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VPMOVSXWD, vex: 0x6622, code: "\x23", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VPMOVSXWQ, vex: 0x6602, code: "\x24", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: VPMOVSXWQ, vex: 0x6602, code: "\x24", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgXmm2M64}},
+	{op: VPMOVSXWQ, vex: 0x6622, code: "\x24", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M32}},
+	{op: VPMOVSXWQ, vex: 0x6622, code: "\x24", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VPMOVSXWQ, vex: 0x6622, code: "\x24", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VPMOVUSDB, vex: 0xf322, code: "\x11", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M32, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVUSDB, vex: 0xf322, code: "\x11", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVUSDB, vex: 0xf322, code: "\x11", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVUSDW, vex: 0xf322, code: "\x13", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVUSDW, vex: 0xf322, code: "\x13", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVUSDW, vex: 0xf322, code: "\x13", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVUSQB, vex: 0xf322, code: "\x12", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M16, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVUSQB, vex: 0xf322, code: "\x12", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M32, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVUSQB, vex: 0xf322, code: "\x12", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVUSQD, vex: 0xf322, code: "\x15", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVUSQD, vex: 0xf322, code: "\x15", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVUSQD, vex: 0xf322, code: "\x15", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVUSQW, vex: 0xf322, code: "\x14", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M32, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVUSQW, vex: 0xf322, code: "\x14", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVUSQW, vex: 0xf322, code: "\x14", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVUSWB, vex: 0xf322, code: "\x10", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVUSWB, vex: 0xf322, code: "\x10", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVUSWB, vex: 0xf322, code: "\x10", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVW2M, vex: 0xf322, code: "\x29", modrm: encSlashR, vexL: 0, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgXmm2}},
+	{op: VPMOVW2M, vex: 0xf322, code: "\x29", modrm: encSlashR, vexL: 1, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgYmm2}},
+	{op: VPMOVW2M, vex: 0xf322, code: "\x29", modrm: encSlashR, vexL: 2, vexW: 1, isMem: -1, args: []decodeOp{xArgK1, xArgZmm2}},
+	{op: VPMOVWB, vex: 0xf322, code: "\x30", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm2M64, xArgMask, xArgZ, xArgXmm1}},
+	{op: VPMOVWB, vex: 0xf322, code: "\x30", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgXmm2M128, xArgMask, xArgZ, xArgYmm1}},
+	{op: VPMOVWB, vex: 0xf322, code: "\x30", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgYmm2M256, xArgMask, xArgZ, xArgZmm1}},
+	{op: VPMOVZXBD, vex: 0x6602, code: "\x31", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: VPMOVZXBD, vex: 0x6602, code: "\x31", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgXmm2M64}},
+	{op: VPMOVZXBD, vex: 0x6622, code: "\x31", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M32}},
+	{op: VPMOVZXBD, vex: 0x6622, code: "\x31", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VPMOVZXBD, vex: 0x6622, code: "\x31", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VPMOVZXBQ, vex: 0x6602, code: "\x32", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M16}},
+	{op: VPMOVZXBQ, vex: 0x6602, code: "\x32", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgXmm2M32}},
+	{op: VPMOVZXBQ, vex: 0x6622, code: "\x32", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M16}},
+	{op: VPMOVZXBQ, vex: 0x6622, code: "\x32", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M32}},
+	{op: VPMOVZXBQ, vex: 0x6622, code: "\x32", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VPMOVZXBW, vex: 0x6602, code: "\x30", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: VPMOVZXBW, vex: 0x6602, code: "\x30", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgXmm2M128}},
+	{op: VPMOVZXBW, vex: 0x6622, code: "\x30", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VPMOVZXBW, vex: 0x6622, code: "\x30", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VPMOVZXBW, vex: 0x6622, code: "\x30", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VPMOVZXDQ, vex: 0x6602, code: "\x35", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: VPMOVZXDQ, vex: 0x6602, code: "\x35", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgXmm2M128}},
+	{op: VPMOVZXDQ, vex: 0x6622, code: "\x35", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VPMOVZXDQ, vex: 0x6622, code: "\x35", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VPMOVZXDQ, vex: 0x6622, code: "\x35", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VPMOVZXWD, vex: 0x6602, code: "\x33", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: VPMOVZXWD, vex: 0x6602, code: "\x33", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgXmm2M128}},
+	{op: VPMOVZXWD, vex: 0x6622, code: "\x33", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VPMOVZXWD, vex: 0x6622, code: "\x33", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VPMOVZXWD, vex: 0x6622, code: "\x33", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgYmm2M256}},
+	{op: VPMOVZXWQ, vex: 0x6602, code: "\x34", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: VPMOVZXWQ, vex: 0x6602, code: "\x34", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgXmm2M64}},
+	{op: VPMOVZXWQ, vex: 0x6622, code: "\x34", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M32}},
+	{op: VPMOVZXWQ, vex: 0x6622, code: "\x34", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgXmm2M64}},
+	{op: VPMOVZXWQ, vex: 0x6622, code: "\x34", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgXmm2M128}},
+	{op: VPMULDQ, vex: 0x6602, code: "\x28", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMULDQ, vex: 0x6602, code: "\x28", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMULDQ, vex: 0x6622, code: "\x28", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPMULDQ, vex: 0x6622, code: "\x28", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPMULDQ, vex: 0x6622, code: "\x28", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPMULHRSW, vex: 0x6602, code: "\x0b", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMULHRSW, vex: 0x6602, code: "\x0b", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMULHRSW, vex: 0x6622, code: "\x0b", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPMULHRSW, vex: 0x6622, code: "\x0b", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPMULHRSW, vex: 0x6622, code: "\x0b", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPMULHUW, vex: 0x6601, code: "\xe4", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMULHUW, vex: 0x6601, code: "\xe4", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMULHUW, vex: 0x6621, code: "\xe4", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPMULHUW, vex: 0x6621, code: "\xe4", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPMULHUW, vex: 0x6621, code: "\xe4", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPMULHW, vex: 0x6601, code: "\xe5", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMULHW, vex: 0x6601, code: "\xe5", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMULHW, vex: 0x6621, code: "\xe5", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPMULHW, vex: 0x6621, code: "\xe5", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPMULHW, vex: 0x6621, code: "\xe5", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPMULLD, vex: 0x6602, code: "\x40", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMULLD, vex: 0x6602, code: "\x40", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMULLD, vex: 0x6622, code: "\x40", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPMULLD, vex: 0x6622, code: "\x40", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPMULLD, vex: 0x6622, code: "\x40", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPMULLQ, vex: 0x6622, code: "\x40", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPMULLQ, vex: 0x6622, code: "\x40", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPMULLQ, vex: 0x6622, code: "\x40", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPMULLW, vex: 0x6601, code: "\xd5", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMULLW, vex: 0x6601, code: "\xd5", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMULLW, vex: 0x6621, code: "\xd5", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPMULLW, vex: 0x6621, code: "\xd5", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPMULLW, vex: 0x6621, code: "\xd5", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPMULUDQ, vex: 0x6601, code: "\xf4", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPMULUDQ, vex: 0x6601, code: "\xf4", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPMULUDQ, vex: 0x6621, code: "\xf4", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPMULUDQ, vex: 0x6621, code: "\xf4", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPMULUDQ, vex: 0x6621, code: "\xf4", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPOR, vex: 0x6601, code: "\xeb", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPOR, vex: 0x6601, code: "\xeb", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPORD, vex: 0x6621, code: "\xeb", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPORD, vex: 0x6621, code: "\xeb", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPORD, vex: 0x6621, code: "\xeb", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPORQ, vex: 0x6621, code: "\xeb", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPORQ, vex: 0x6621, code: "\xeb", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPORQ, vex: 0x6621, code: "\xeb", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPROLD, vex: 0x6621, code: "\x72", modrm: 1, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VPROLD, vex: 0x6621, code: "\x72", modrm: 1, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VPROLD, vex: 0x6621, code: "\x72", modrm: 1, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmmV, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgImm8u}},
+	{op: VPROLQ, vex: 0x6621, code: "\x72", modrm: 1, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VPROLQ, vex: 0x6621, code: "\x72", modrm: 1, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VPROLQ, vex: 0x6621, code: "\x72", modrm: 1, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmmV, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgImm8u}},
+	{op: VPROLVD, vex: 0x6622, code: "\x15", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPROLVD, vex: 0x6622, code: "\x15", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPROLVD, vex: 0x6622, code: "\x15", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPROLVQ, vex: 0x6622, code: "\x15", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPROLVQ, vex: 0x6622, code: "\x15", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPROLVQ, vex: 0x6622, code: "\x15", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPRORD, vex: 0x6621, code: "\x72", modrm: 0, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VPRORD, vex: 0x6621, code: "\x72", modrm: 0, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VPRORD, vex: 0x6621, code: "\x72", modrm: 0, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmmV, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgImm8u}},
+	{op: VPRORQ, vex: 0x6621, code: "\x72", modrm: 0, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VPRORQ, vex: 0x6621, code: "\x72", modrm: 0, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VPRORQ, vex: 0x6621, code: "\x72", modrm: 0, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmmV, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgImm8u}},
+	{op: VPRORVD, vex: 0x6622, code: "\x14", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPRORVD, vex: 0x6622, code: "\x14", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPRORVD, vex: 0x6622, code: "\x14", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPRORVQ, vex: 0x6622, code: "\x14", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPRORVQ, vex: 0x6622, code: "\x14", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPRORVQ, vex: 0x6622, code: "\x14", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPSADBW, vex: 0x6601, code: "\xf6", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSADBW, vex: 0x6601, code: "\xf6", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSADBW, vex: 0x6621, code: "\xf6", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSADBW, vex: 0x6621, code: "\xf6", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSADBW, vex: 0x6621, code: "\xf6", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgZmmV, xArgZmm2M512}},
+	{op: VPSCATTERDD, vex: 0x6622, code: "\xa0", modrm: encSlashR, vexL: 0, vexW: 0, isMem: 1, args: []decodeOp{xArgVm32x, xArgMask, xArgXmm1}},
+	{op: VPSCATTERDD, vex: 0x6622, code: "\xa0", modrm: encSlashR, vexL: 1, vexW: 0, isMem: 1, args: []decodeOp{xArgVm32y, xArgMask, xArgYmm1}},
+	{op: VPSCATTERDD, vex: 0x6622, code: "\xa0", modrm: encSlashR, vexL: 2, vexW: 0, isMem: 1, args: []decodeOp{xArgVm32z, xArgMask, xArgZmm1}},
+	{op: VPSCATTERDQ, vex: 0x6622, code: "\xa0", modrm: encSlashR, vexL: 0, vexW: 1, isMem: 1, args: []decodeOp{xArgVm32x, xArgMask, xArgXmm1}},
+	{op: VPSCATTERDQ, vex: 0x6622, code: "\xa0", modrm: encSlashR, vexL: 1, vexW: 1, isMem: 1, args: []decodeOp{xArgVm32x, xArgMask, xArgYmm1}},
+	{op: VPSCATTERDQ, vex: 0x6622, code: "\xa0", modrm: encSlashR, vexL: 2, vexW: 1, isMem: 1, args: []decodeOp{xArgVm32y, xArgMask, xArgZmm1}},
+	{op: VPSCATTERQD, vex: 0x6622, code: "\xa1", modrm: encSlashR, vexL: 0, vexW: 0, isMem: 1, args: []decodeOp{xArgVm64x, xArgMask, xArgXmm1}},
+	{op: VPSCATTERQD, vex: 0x6622, code: "\xa1", modrm: encSlashR, vexL: 1, vexW: 0, isMem: 1, args: []decodeOp{xArgVm64y, xArgMask, xArgXmm1}},
+	{op: VPSCATTERQD, vex: 0x6622, code: "\xa1", modrm: encSlashR, vexL: 2, vexW: 0, isMem: 1, args: []decodeOp{xArgVm64z, xArgMask, xArgYmm1}},
+	{op: VPSCATTERQQ, vex: 0x6622, code: "\xa1", modrm: encSlashR, vexL: 0, vexW: 1, isMem: 1, args: []decodeOp{xArgVm64x, xArgMask, xArgXmm1}},
+	{op: VPSCATTERQQ, vex: 0x6622, code: "\xa1", modrm: encSlashR, vexL: 1, vexW: 1, isMem: 1, args: []decodeOp{xArgVm64y, xArgMask, xArgYmm1}},
+	{op: VPSCATTERQQ, vex: 0x6622, code: "\xa1", modrm: encSlashR, vexL: 2, vexW: 1, isMem: 1, args: []decodeOp{xArgVm64z, xArgMask, xArgZmm1}},
+	{op: VPSHUFB, vex: 0x6602, code: "\x00", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSHUFB, vex: 0x6602, code: "\x00", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSHUFB, vex: 0x6622, code: "\x00", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSHUFB, vex: 0x6622, code: "\x00", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPSHUFB, vex: 0x6622, code: "\x00", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPSHUFD, vex: 0x6601, code: "\x70", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: VPSHUFD, vex: 0x6601, code: "\x70", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmm2M256, xArgImm8u}},
+	{op: VPSHUFD, vex: 0x6621, code: "\x70", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VPSHUFD, vex: 0x6621, code: "\x70", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VPSHUFD, vex: 0x6621, code: "\x70", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgImm8u}},
+	{op: VPSHUFHW, vex: 0xf301, code: "\x70", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: VPSHUFHW, vex: 0xf301, code: "\x70", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmm2M256, xArgImm8u}},
+	{op: VPSHUFHW, vex: 0xf321, code: "\x70", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgImm8u}},
+	{op: VPSHUFHW, vex: 0xf321, code: "\x70", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgImm8u}},
+	{op: VPSHUFHW, vex: 0xf321, code: "\x70", modrm: encSlashR, vexL: 2, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgImm8u}},
+	{op: VPSHUFLW, vex: 0xf201, code: "\x70", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: VPSHUFLW, vex: 0xf201, code: "\x70", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmm2M256, xArgImm8u}},
+	{op: VPSHUFLW, vex: 0xf221, code: "\x70", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgImm8u}},
+	{op: VPSHUFLW, vex: 0xf221, code: "\x70", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgImm8u}},
+	{op: VPSHUFLW, vex: 0xf221, code: "\x70", modrm: encSlashR, vexL: 2, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgImm8u}},
+	{op: VPSIGNB, vex: 0x6602, code: "\x08", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSIGNB, vex: 0x6602, code: "\x08", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSIGND, vex: 0x6602, code: "\x0a", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSIGND, vex: 0x6602, code: "\x0a", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSIGNW, vex: 0x6602, code: "\x09", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSIGNW, vex: 0x6602, code: "\x09", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSLLD, vex: 0x6601, code: "\x72", modrm: 6, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgXmm2, xArgImm8u}},
+	{op: VPSLLD, vex: 0x6601, code: "\xf2", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSLLD, vex: 0x6601, code: "\xf2", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgXmm2M128}},
+	{op: VPSLLD, vex: 0x6601, code: "\x72", modrm: 6, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgYmm2, xArgImm8u}},
+	{op: VPSLLD, vex: 0x6621, code: "\x72", modrm: 6, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VPSLLD, vex: 0x6621, code: "\x72", modrm: 6, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VPSLLD, vex: 0x6621, code: "\x72", modrm: 6, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmmV, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgImm8u}},
+	{op: VPSLLD, vex: 0x6621, code: "\xf2", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSLLD, vex: 0x6621, code: "\xf2", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgXmm2M128}},
+	{op: VPSLLD, vex: 0x6621, code: "\xf2", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgXmm2M128}},
+	{op: VPSLLDQ, vex: 0x6601, code: "\x73", modrm: 7, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgXmm2, xArgImm8u}},
+	{op: VPSLLDQ, vex: 0x6601, code: "\x73", modrm: 7, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgYmm2, xArgImm8u}},
+	{op: VPSLLDQ, vex: 0x6621, code: "\x73", modrm: 7, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VPSLLDQ, vex: 0x6621, code: "\x73", modrm: 7, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VPSLLDQ, vex: 0x6621, code: "\x73", modrm: 7, vexL: 2, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmmV, xArgZmm2M512, xArgImm8u}},
+	{op: VPSLLQ, vex: 0x6601, code: "\x73", modrm: 6, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgXmm2, xArgImm8u}},
+	{op: VPSLLQ, vex: 0x6601, code: "\xf3", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSLLQ, vex: 0x6601, code: "\xf3", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgXmm2M128}},
+	{op: VPSLLQ, vex: 0x6601, code: "\x73", modrm: 6, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgYmm2, xArgImm8u}},
+	{op: VPSLLQ, vex: 0x6621, code: "\x73", modrm: 6, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VPSLLQ, vex: 0x6621, code: "\x73", modrm: 6, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VPSLLQ, vex: 0x6621, code: "\x73", modrm: 6, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmmV, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgImm8u}},
+	{op: VPSLLQ, vex: 0x6621, code: "\xf3", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSLLQ, vex: 0x6621, code: "\xf3", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgXmm2M128}},
+	{op: VPSLLQ, vex: 0x6621, code: "\xf3", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgXmm2M128}},
+	{op: VPSLLVD, vex: 0x6602, code: "\x47", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSLLVD, vex: 0x6602, code: "\x47", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSLLVD, vex: 0x6622, code: "\x47", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPSLLVD, vex: 0x6622, code: "\x47", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPSLLVD, vex: 0x6622, code: "\x47", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPSLLVQ, vex: 0x6602, code: "\x47", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSLLVQ, vex: 0x6602, code: "\x47", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSLLVQ, vex: 0x6622, code: "\x47", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPSLLVQ, vex: 0x6622, code: "\x47", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPSLLVQ, vex: 0x6622, code: "\x47", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPSLLVW, vex: 0x6622, code: "\x12", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSLLVW, vex: 0x6622, code: "\x12", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPSLLVW, vex: 0x6622, code: "\x12", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPSLLW, vex: 0x6601, code: "\x71", modrm: 6, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgXmm2, xArgImm8u}},
+	{op: VPSLLW, vex: 0x6601, code: "\xf1", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSLLW, vex: 0x6601, code: "\x71", modrm: 6, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgYmm2, xArgImm8u}},
+	{op: VPSLLW, vex: 0x6601, code: "\xf1", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgXmm2M128}},
+	{op: VPSLLW, vex: 0x6621, code: "\x71", modrm: 6, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgMask, xArgZ, xArgXmm2M128, xArgImm8u}},
+	{op: VPSLLW, vex: 0x6621, code: "\x71", modrm: 6, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgMask, xArgZ, xArgYmm2M256, xArgImm8u}},
+	{op: VPSLLW, vex: 0x6621, code: "\x71", modrm: 6, vexL: 2, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmmV, xArgMask, xArgZ, xArgZmm2M512, xArgImm8u}},
+	{op: VPSLLW, vex: 0x6621, code: "\xf1", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSLLW, vex: 0x6621, code: "\xf1", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgXmm2M128}},
+	{op: VPSLLW, vex: 0x6621, code: "\xf1", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgXmm2M128}},
+	{op: VPSRAD, vex: 0x6601, code: "\x72", modrm: 4, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgXmm2, xArgImm8u}},
+	{op: VPSRAD, vex: 0x6601, code: "\xe2", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSRAD, vex: 0x6601, code: "\x72", modrm: 4, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgYmm2, xArgImm8u}},
+	{op: VPSRAD, vex: 0x6601, code: "\xe2", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgXmm2M128}},
+	{op: VPSRAD, vex: 0x6621, code: "\x72", modrm: 4, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VPSRAD, vex: 0x6621, code: "\x72", modrm: 4, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VPSRAD, vex: 0x6621, code: "\x72", modrm: 4, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmmV, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgImm8u}},
+	{op: VPSRAD, vex: 0x6621, code: "\xe2", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSRAD, vex: 0x6621, code: "\xe2", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgXmm2M128}},
+	{op: VPSRAD, vex: 0x6621, code: "\xe2", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgXmm2M128}},
+	{op: VPSRAQ, vex: 0x6621, code: "\x72", modrm: 4, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VPSRAQ, vex: 0x6621, code: "\x72", modrm: 4, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VPSRAQ, vex: 0x6621, code: "\x72", modrm: 4, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmmV, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgImm8u}},
+	{op: VPSRAQ, vex: 0x6621, code: "\xe2", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSRAQ, vex: 0x6621, code: "\xe2", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgXmm2M128}},
+	{op: VPSRAQ, vex: 0x6621, code: "\xe2", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgXmm2M128}},
+	{op: VPSRAVD, vex: 0x6602, code: "\x46", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSRAVD, vex: 0x6602, code: "\x46", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSRAVD, vex: 0x6622, code: "\x46", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPSRAVD, vex: 0x6622, code: "\x46", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPSRAVD, vex: 0x6622, code: "\x46", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPSRAVQ, vex: 0x6622, code: "\x46", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPSRAVQ, vex: 0x6622, code: "\x46", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPSRAVQ, vex: 0x6622, code: "\x46", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPSRAVW, vex: 0x6622, code: "\x11", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSRAVW, vex: 0x6622, code: "\x11", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPSRAVW, vex: 0x6622, code: "\x11", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPSRAW, vex: 0x6601, code: "\x71", modrm: 4, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgXmm2, xArgImm8u}},
+	{op: VPSRAW, vex: 0x6601, code: "\xe1", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSRAW, vex: 0x6601, code: "\x71", modrm: 4, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgYmm2, xArgImm8u}},
+	{op: VPSRAW, vex: 0x6601, code: "\xe1", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgXmm2M128}},
+	{op: VPSRAW, vex: 0x6621, code: "\x71", modrm: 4, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgMask, xArgZ, xArgXmm2M128, xArgImm8u}},
+	{op: VPSRAW, vex: 0x6621, code: "\x71", modrm: 4, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgMask, xArgZ, xArgYmm2M256, xArgImm8u}},
+	{op: VPSRAW, vex: 0x6621, code: "\x71", modrm: 4, vexL: 2, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmmV, xArgMask, xArgZ, xArgZmm2M512, xArgImm8u}},
+	{op: VPSRAW, vex: 0x6621, code: "\xe1", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSRAW, vex: 0x6621, code: "\xe1", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgXmm2M128}},
+	{op: VPSRAW, vex: 0x6621, code: "\xe1", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgXmm2M128}},
+	{op: VPSRLD, vex: 0x6601, code: "\x72", modrm: 2, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgXmm2, xArgImm8u}},
+	{op: VPSRLD, vex: 0x6601, code: "\xd2", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSRLD, vex: 0x6601, code: "\xd2", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgXmm2M128}},
+	{op: VPSRLD, vex: 0x6601, code: "\x72", modrm: 2, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgYmm2, xArgImm8u}},
+	{op: VPSRLD, vex: 0x6621, code: "\x72", modrm: 2, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VPSRLD, vex: 0x6621, code: "\x72", modrm: 2, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VPSRLD, vex: 0x6621, code: "\x72", modrm: 2, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmmV, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgImm8u}},
+	{op: VPSRLD, vex: 0x6621, code: "\xd2", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSRLD, vex: 0x6621, code: "\xd2", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgXmm2M128}},
+	{op: VPSRLD, vex: 0x6621, code: "\xd2", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgXmm2M128}},
+	{op: VPSRLDQ, vex: 0x6601, code: "\x73", modrm: 3, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgXmm2, xArgImm8u}},
+	{op: VPSRLDQ, vex: 0x6601, code: "\x73", modrm: 3, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgYmm2, xArgImm8u}},
+	{op: VPSRLDQ, vex: 0x6621, code: "\x73", modrm: 3, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VPSRLDQ, vex: 0x6621, code: "\x73", modrm: 3, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VPSRLDQ, vex: 0x6621, code: "\x73", modrm: 3, vexL: 2, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmmV, xArgZmm2M512, xArgImm8u}},
+	{op: VPSRLQ, vex: 0x6601, code: "\x73", modrm: 2, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgXmm2, xArgImm8u}},
+	{op: VPSRLQ, vex: 0x6601, code: "\xd3", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSRLQ, vex: 0x6601, code: "\xd3", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgXmm2M128}},
+	{op: VPSRLQ, vex: 0x6601, code: "\x73", modrm: 2, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgYmm2, xArgImm8u}},
+	{op: VPSRLQ, vex: 0x6621, code: "\x73", modrm: 2, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VPSRLQ, vex: 0x6621, code: "\x73", modrm: 2, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VPSRLQ, vex: 0x6621, code: "\x73", modrm: 2, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmmV, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgImm8u}},
+	{op: VPSRLQ, vex: 0x6621, code: "\xd3", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSRLQ, vex: 0x6621, code: "\xd3", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgXmm2M128}},
+	{op: VPSRLQ, vex: 0x6621, code: "\xd3", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgXmm2M128}},
+	{op: VPSRLVD, vex: 0x6602, code: "\x45", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSRLVD, vex: 0x6602, code: "\x45", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSRLVD, vex: 0x6622, code: "\x45", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPSRLVD, vex: 0x6622, code: "\x45", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPSRLVD, vex: 0x6622, code: "\x45", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPSRLVQ, vex: 0x6602, code: "\x45", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSRLVQ, vex: 0x6602, code: "\x45", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSRLVQ, vex: 0x6622, code: "\x45", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPSRLVQ, vex: 0x6622, code: "\x45", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPSRLVQ, vex: 0x6622, code: "\x45", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPSRLVW, vex: 0x6622, code: "\x10", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSRLVW, vex: 0x6622, code: "\x10", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPSRLVW, vex: 0x6622, code: "\x10", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPSRLW, vex: 0x6601, code: "\x71", modrm: 2, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgXmm2, xArgImm8u}},
+	{op: VPSRLW, vex: 0x6601, code: "\xd1", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSRLW, vex: 0x6601, code: "\x71", modrm: 2, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgYmm2, xArgImm8u}},
+	{op: VPSRLW, vex: 0x6601, code: "\xd1", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgXmm2M128}},
+	{op: VPSRLW, vex: 0x6621, code: "\x71", modrm: 2, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmmV, xArgMask, xArgZ, xArgXmm2M128, xArgImm8u}},
+	{op: VPSRLW, vex: 0x6621, code: "\x71", modrm: 2, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmmV, xArgMask, xArgZ, xArgYmm2M256, xArgImm8u}},
+	{op: VPSRLW, vex: 0x6621, code: "\x71", modrm: 2, vexL: 2, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmmV, xArgMask, xArgZ, xArgZmm2M512, xArgImm8u}},
+	{op: VPSRLW, vex: 0x6621, code: "\xd1", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSRLW, vex: 0x6621, code: "\xd1", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgXmm2M128}},
+	{op: VPSRLW, vex: 0x6621, code: "\xd1", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgXmm2M128}},
+	{op: VPSUBB, vex: 0x6601, code: "\xf8", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSUBB, vex: 0x6601, code: "\xf8", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSUBB, vex: 0x6621, code: "\xf8", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSUBB, vex: 0x6621, code: "\xf8", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPSUBB, vex: 0x6621, code: "\xf8", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPSUBD, vex: 0x6601, code: "\xfa", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSUBD, vex: 0x6601, code: "\xfa", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSUBD, vex: 0x6621, code: "\xfa", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPSUBD, vex: 0x6621, code: "\xfa", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPSUBD, vex: 0x6621, code: "\xfa", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPSUBQ, vex: 0x6601, code: "\xfb", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSUBQ, vex: 0x6601, code: "\xfb", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSUBQ, vex: 0x6621, code: "\xfb", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPSUBQ, vex: 0x6621, code: "\xfb", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPSUBQ, vex: 0x6621, code: "\xfb", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPSUBSB, vex: 0x6601, code: "\xe8", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSUBSB, vex: 0x6601, code: "\xe8", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSUBSB, vex: 0x6621, code: "\xe8", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSUBSB, vex: 0x6621, code: "\xe8", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPSUBSB, vex: 0x6621, code: "\xe8", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPSUBSW, vex: 0x6601, code: "\xe9", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSUBSW, vex: 0x6601, code: "\xe9", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSUBSW, vex: 0x6621, code: "\xe9", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSUBSW, vex: 0x6621, code: "\xe9", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPSUBSW, vex: 0x6621, code: "\xe9", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPSUBUSB, vex: 0x6601, code: "\xd8", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSUBUSB, vex: 0x6601, code: "\xd8", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSUBUSB, vex: 0x6621, code: "\xd8", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSUBUSB, vex: 0x6621, code: "\xd8", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPSUBUSB, vex: 0x6621, code: "\xd8", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPSUBUSW, vex: 0x6601, code: "\xd9", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSUBUSW, vex: 0x6601, code: "\xd9", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSUBUSW, vex: 0x6621, code: "\xd9", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSUBUSW, vex: 0x6621, code: "\xd9", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPSUBUSW, vex: 0x6621, code: "\xd9", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPSUBW, vex: 0x6601, code: "\xf9", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPSUBW, vex: 0x6601, code: "\xf9", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPSUBW, vex: 0x6621, code: "\xf9", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPSUBW, vex: 0x6621, code: "\xf9", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPSUBW, vex: 0x6621, code: "\xf9", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPTERNLOGD, vex: 0x6623, code: "\x25", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VPTERNLOGD, vex: 0x6623, code: "\x25", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VPTERNLOGD, vex: 0x6623, code: "\x25", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgImm8u}},
+	{op: VPTERNLOGQ, vex: 0x6623, code: "\x25", modrm: encSlashR, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VPTERNLOGQ, vex: 0x6623, code: "\x25", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VPTERNLOGQ, vex: 0x6623, code: "\x25", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgImm8u}},
+	{op: VPTEST, vex: 0x6602, code: "\x17", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VPTEST, vex: 0x6602, code: "\x17", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VPTESTMB, vex: 0x6622, code: "\x26", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128}},
+	{op: VPTESTMB, vex: 0x6622, code: "\x26", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256}},
+	{op: VPTESTMB, vex: 0x6622, code: "\x26", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512}},
+	{op: VPTESTMD, vex: 0x6622, code: "\x27", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPTESTMD, vex: 0x6622, code: "\x27", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPTESTMD, vex: 0x6622, code: "\x27", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPTESTMQ, vex: 0x6622, code: "\x27", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPTESTMQ, vex: 0x6622, code: "\x27", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPTESTMQ, vex: 0x6622, code: "\x27", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPTESTMW, vex: 0x6622, code: "\x26", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128}},
+	{op: VPTESTMW, vex: 0x6622, code: "\x26", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256}},
+	{op: VPTESTMW, vex: 0x6622, code: "\x26", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512}},
+	{op: VPTESTNMB, vex: 0xf322, code: "\x26", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128}},
+	{op: VPTESTNMB, vex: 0xf322, code: "\x26", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256}},
+	{op: VPTESTNMB, vex: 0xf322, code: "\x26", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512}},
+	{op: VPTESTNMD, vex: 0xf322, code: "\x27", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPTESTNMD, vex: 0xf322, code: "\x27", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPTESTNMD, vex: 0xf322, code: "\x27", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPTESTNMQ, vex: 0xf322, code: "\x27", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPTESTNMQ, vex: 0xf322, code: "\x27", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPTESTNMQ, vex: 0xf322, code: "\x27", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPTESTNMW, vex: 0xf322, code: "\x26", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgXmmV, xArgXmm2M128}},
+	{op: VPTESTNMW, vex: 0xf322, code: "\x26", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgYmmV, xArgYmm2M256}},
+	{op: VPTESTNMW, vex: 0xf322, code: "\x26", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgK1, xArgMask, xArgZmmV, xArgZmm2M512}},
+	{op: VPUNPCKHBW, vex: 0x6601, code: "\x68", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPUNPCKHBW, vex: 0x6601, code: "\x68", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPUNPCKHBW, vex: 0x6621, code: "\x68", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPUNPCKHBW, vex: 0x6621, code: "\x68", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPUNPCKHBW, vex: 0x6621, code: "\x68", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPUNPCKHDQ, vex: 0x6601, code: "\x6a", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPUNPCKHDQ, vex: 0x6601, code: "\x6a", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPUNPCKHDQ, vex: 0x6621, code: "\x6a", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPUNPCKHDQ, vex: 0x6621, code: "\x6a", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPUNPCKHDQ, vex: 0x6621, code: "\x6a", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPUNPCKHQDQ, vex: 0x6601, code: "\x6d", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPUNPCKHQDQ, vex: 0x6601, code: "\x6d", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPUNPCKHQDQ, vex: 0x6621, code: "\x6d", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPUNPCKHQDQ, vex: 0x6621, code: "\x6d", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPUNPCKHQDQ, vex: 0x6621, code: "\x6d", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPUNPCKHWD, vex: 0x6601, code: "\x69", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPUNPCKHWD, vex: 0x6601, code: "\x69", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPUNPCKHWD, vex: 0x6621, code: "\x69", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPUNPCKHWD, vex: 0x6621, code: "\x69", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPUNPCKHWD, vex: 0x6621, code: "\x69", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPUNPCKLBW, vex: 0x6601, code: "\x60", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPUNPCKLBW, vex: 0x6601, code: "\x60", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPUNPCKLBW, vex: 0x6621, code: "\x60", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPUNPCKLBW, vex: 0x6621, code: "\x60", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPUNPCKLBW, vex: 0x6621, code: "\x60", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPUNPCKLDQ, vex: 0x6601, code: "\x62", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPUNPCKLDQ, vex: 0x6601, code: "\x62", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPUNPCKLDQ, vex: 0x6621, code: "\x62", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPUNPCKLDQ, vex: 0x6621, code: "\x62", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPUNPCKLDQ, vex: 0x6621, code: "\x62", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPUNPCKLQDQ, vex: 0x6601, code: "\x6c", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPUNPCKLQDQ, vex: 0x6601, code: "\x6c", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPUNPCKLQDQ, vex: 0x6621, code: "\x6c", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPUNPCKLQDQ, vex: 0x6621, code: "\x6c", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPUNPCKLQDQ, vex: 0x6621, code: "\x6c", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VPUNPCKLWD, vex: 0x6601, code: "\x61", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPUNPCKLWD, vex: 0x6601, code: "\x61", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPUNPCKLWD, vex: 0x6621, code: "\x61", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128}},
+	{op: VPUNPCKLWD, vex: 0x6621, code: "\x61", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256}},
+	{op: VPUNPCKLWD, vex: 0x6621, code: "\x61", modrm: encSlashR, vexL: 2, vexW: -1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512}},
+	{op: VPXOR, vex: 0x6601, code: "\xef", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VPXOR, vex: 0x6601, code: "\xef", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VPXORD, vex: 0x6621, code: "\xef", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VPXORD, vex: 0x6621, code: "\xef", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VPXORD, vex: 0x6621, code: "\xef", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VPXORQ, vex: 0x6621, code: "\xef", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VPXORQ, vex: 0x6621, code: "\xef", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VPXORQ, vex: 0x6621, code: "\xef", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VRANGEPD, vex: 0x6623, code: "\x50", modrm: encSlashR, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VRANGEPD, vex: 0x6623, code: "\x50", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VRANGEPD, vex: 0x6623, code: "\x50", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgSAE, xArgImm8u}},
+	{op: VRANGEPS, vex: 0x6623, code: "\x50", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VRANGEPS, vex: 0x6623, code: "\x50", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VRANGEPS, vex: 0x6623, code: "\x50", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgSAE, xArgImm8u}},
+	{op: VRANGESD, vex: 0x6623, code: "\x51", modrm: encSlashR, vexL: -1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgSAE, xArgImm8u}},
+	{op: VRANGESS, vex: 0x6623, code: "\x51", modrm: encSlashR, vexL: -1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgSAE, xArgImm8u}},
+	{op: VRCP14PD, vex: 0x6622, code: "\x4c", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VRCP14PD, vex: 0x6622, code: "\x4c", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VRCP14PD, vex: 0x6622, code: "\x4c", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst}},
+	{op: VRCP14PS, vex: 0x6622, code: "\x4c", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VRCP14PS, vex: 0x6622, code: "\x4c", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst}},
+	{op: VRCP14PS, vex: 0x6622, code: "\x4c", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst}},
+	{op: VRCP14SD, vex: 0x6622, code: "\x4d", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64}},
+	{op: VRCP14SS, vex: 0x6622, code: "\x4d", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32}},
+	{op: VRCPPS, vex: 0x1, code: "\x53", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VRCPPS, vex: 0x1, code: "\x53", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VRCPSS, vex: 0xf301, code: "\x53", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VREDUCEPD, vex: 0x6623, code: "\x56", modrm: encSlashR, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VREDUCEPD, vex: 0x6623, code: "\x56", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VREDUCEPD, vex: 0x6623, code: "\x56", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgSAE, xArgImm8u}},
+	{op: VREDUCEPS, vex: 0x6623, code: "\x56", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VREDUCEPS, vex: 0x6623, code: "\x56", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VREDUCEPS, vex: 0x6623, code: "\x56", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgSAE, xArgImm8u}},
+	{op: VREDUCESD, vex: 0x6623, code: "\x57", modrm: encSlashR, vexL: -1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgSAE, xArgImm8u}},
+	{op: VREDUCESS, vex: 0x6623, code: "\x57", modrm: encSlashR, vexL: -1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgSAE, xArgImm8u}},
+	{op: VRNDSCALEPD, vex: 0x6623, code: "\x09", modrm: encSlashR, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VRNDSCALEPD, vex: 0x6623, code: "\x09", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VRNDSCALEPD, vex: 0x6623, code: "\x09", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgSAE, xArgImm8u}},
+	{op: VRNDSCALEPS, vex: 0x6623, code: "\x08", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VRNDSCALEPS, vex: 0x6623, code: "\x08", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VRNDSCALEPS, vex: 0x6623, code: "\x08", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgSAE, xArgImm8u}},
+	{op: VRNDSCALESD, vex: 0x6623, code: "\x0b", modrm: encSlashR, vexL: -1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgSAE, xArgImm8u}},
+	{op: VRNDSCALESS, vex: 0x6623, code: "\x0a", modrm: encSlashR, vexL: -1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgSAE, xArgImm8u}},
+	{op: VROUNDPD, vex: 0x6603, code: "\x09", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: VROUNDPD, vex: 0x6603, code: "\x09", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmm2M256, xArgImm8u}},
+	{op: VROUNDPS, vex: 0x6603, code: "\x08", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmm2M128, xArgImm8u}},
+	{op: VROUNDPS, vex: 0x6603, code: "\x08", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmm2M256, xArgImm8u}},
+	{op: VROUNDSD, vex: 0x6603, code: "\x0b", modrm: encSlashR, vexL: -1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64, xArgImm8u}},
+	{op: VROUNDSS, vex: 0x6603, code: "\x0a", modrm: encSlashR, vexL: -1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32, xArgImm8u}},
+	{op: VRSQRT14PD, vex: 0x6622, code: "\x4e", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VRSQRT14PD, vex: 0x6622, code: "\x4e", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VRSQRT14PD, vex: 0x6622, code: "\x4e", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst}},
+	{op: VRSQRT14PS, vex: 0x6622, code: "\x4e", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VRSQRT14PS, vex: 0x6622, code: "\x4e", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst}},
+	{op: VRSQRT14PS, vex: 0x6622, code: "\x4e", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst}},
+	{op: VRSQRT14SD, vex: 0x6622, code: "\x4f", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64}},
+	{op: VRSQRT14SS, vex: 0x6622, code: "\x4f", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32}},
+	{op: VRSQRTPS, vex: 0x1, code: "\x52", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VRSQRTPS, vex: 0x1, code: "\x52", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VRSQRTSS, vex: 0xf301, code: "\x52", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VSCALEFPD, vex: 0x6622, code: "\x2c", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VSCALEFPD, vex: 0x6622, code: "\x2c", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VSCALEFPD, vex: 0x6622, code: "\x2c", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VSCALEFPS, vex: 0x6622, code: "\x2c", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VSCALEFPS, vex: 0x6622, code: "\x2c", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VSCALEFPS, vex: 0x6622, code: "\x2c", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VSCALEFSD, vex: 0x6622, code: "\x2d", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VSCALEFSS, vex: 0x6622, code: "\x2d", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VSCATTERDPD, vex: 0x6622, code: "\xa2", modrm: encSlashR, vexL: 0, vexW: 1, isMem: 1, args: []decodeOp{xArgVm32x, xArgMask, xArgXmm1}},
+	{op: VSCATTERDPD, vex: 0x6622, code: "\xa2", modrm: encSlashR, vexL: 1, vexW: 1, isMem: 1, args: []decodeOp{xArgVm32x, xArgMask, xArgYmm1}},
+	{op: VSCATTERDPD, vex: 0x6622, code: "\xa2", modrm: encSlashR, vexL: 2, vexW: 1, isMem: 1, args: []decodeOp{xArgVm32y, xArgMask, xArgZmm1}},
+	{op: VSCATTERDPS, vex: 0x6622, code: "\xa2", modrm: encSlashR, vexL: 0, vexW: 0, isMem: 1, args: []decodeOp{xArgVm32x, xArgMask, xArgXmm1}},
+	{op: VSCATTERDPS, vex: 0x6622, code: "\xa2", modrm: encSlashR, vexL: 1, vexW: 0, isMem: 1, args: []decodeOp{xArgVm32y, xArgMask, xArgYmm1}},
+	{op: VSCATTERDPS, vex: 0x6622, code: "\xa2", modrm: encSlashR, vexL: 2, vexW: 0, isMem: 1, args: []decodeOp{xArgVm32z, xArgMask, xArgZmm1}},
+	{op: VSCATTERQPD, vex: 0x6622, code: "\xa3", modrm: encSlashR, vexL: 0, vexW: 1, isMem: 1, args: []decodeOp{xArgVm64x, xArgMask, xArgXmm1}},
+	{op: VSCATTERQPD, vex: 0x6622, code: "\xa3", modrm: encSlashR, vexL: 1, vexW: 1, isMem: 1, args: []decodeOp{xArgVm64y, xArgMask, xArgYmm1}},
+	{op: VSCATTERQPD, vex: 0x6622, code: "\xa3", modrm: encSlashR, vexL: 2, vexW: 1, isMem: 1, args: []decodeOp{xArgVm64z, xArgMask, xArgZmm1}},
+	{op: VSCATTERQPS, vex: 0x6622, code: "\xa3", modrm: encSlashR, vexL: 0, vexW: 0, isMem: 1, args: []decodeOp{xArgVm64x, xArgMask, xArgXmm1}},
+	{op: VSCATTERQPS, vex: 0x6622, code: "\xa3", modrm: encSlashR, vexL: 1, vexW: 0, isMem: 1, args: []decodeOp{xArgVm64y, xArgMask, xArgXmm1}},
+	{op: VSCATTERQPS, vex: 0x6622, code: "\xa3", modrm: encSlashR, vexL: 2, vexW: 0, isMem: 1, args: []decodeOp{xArgVm64z, xArgMask, xArgYmm1}},
+	{op: VSHUFF32X4, vex: 0x6623, code: "\x23", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VSHUFF32X4, vex: 0x6623, code: "\x23", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgImm8u}},
+	{op: VSHUFF64X2, vex: 0x6623, code: "\x23", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VSHUFF64X2, vex: 0x6623, code: "\x23", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgImm8u}},
+	{op: VSHUFI32X4, vex: 0x6623, code: "\x43", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VSHUFI32X4, vex: 0x6623, code: "\x43", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgImm8u}},
+	{op: VSHUFI64X2, vex: 0x6623, code: "\x43", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VSHUFI64X2, vex: 0x6623, code: "\x43", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgImm8u}},
+	{op: VSHUFPD, vex: 0x6601, code: "\xc6", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VSHUFPD, vex: 0x6601, code: "\xc6", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VSHUFPD, vex: 0x6621, code: "\xc6", modrm: encSlashR, vexL: 0, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst, xArgImm8u}},
+	{op: VSHUFPD, vex: 0x6621, code: "\xc6", modrm: encSlashR, vexL: 1, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst, xArgImm8u}},
+	{op: VSHUFPD, vex: 0x6621, code: "\xc6", modrm: encSlashR, vexL: 2, vexW: 1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgImm8u}},
+	{op: VSHUFPS, vex: 0x1, code: "\xc6", modrm: encSlashR, vexL: 0, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128, xArgImm8u}},
+	{op: VSHUFPS, vex: 0x1, code: "\xc6", modrm: encSlashR, vexL: 1, vexW: -1, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256, xArgImm8u}},
+	{op: VSHUFPS, vex: 0x21, code: "\xc6", modrm: encSlashR, vexL: 0, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst, xArgImm8u}},
+	{op: VSHUFPS, vex: 0x21, code: "\xc6", modrm: encSlashR, vexL: 1, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst, xArgImm8u}},
+	{op: VSHUFPS, vex: 0x21, code: "\xc6", modrm: encSlashR, vexL: 2, vexW: 0, read: []decodeOp{xReadIb}, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgImm8u}},
+	{op: VSQRTPD, vex: 0x6601, code: "\x51", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VSQRTPD, vex: 0x6601, code: "\x51", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VSQRTPD, vex: 0x6621, code: "\x51", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM64bcst}},
+	{op: VSQRTPD, vex: 0x6621, code: "\x51", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM64bcst}},
+	{op: VSQRTPD, vex: 0x6621, code: "\x51", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VSQRTPS, vex: 0x1, code: "\x51", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VSQRTPS, vex: 0x1, code: "\x51", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VSQRTPS, vex: 0x21, code: "\x51", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmm2M128, xArgM32bcst}},
+	{op: VSQRTPS, vex: 0x21, code: "\x51", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmm2M256, xArgM32bcst}},
+	{op: VSQRTPS, vex: 0x21, code: "\x51", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VSQRTSD, vex: 0xf201, code: "\x51", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VSQRTSD, vex: 0xf221, code: "\x51", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VSQRTSS, vex: 0xf301, code: "\x51", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VSQRTSS, vex: 0xf321, code: "\x51", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VSTMXCSR, vex: 0x1, code: "\xae", modrm: 3, vexL: 0, vexW: -1, args: []decodeOp{xArgM32}},
+	{op: VSUBPD, vex: 0x6601, code: "\x5c", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VSUBPD, vex: 0x6601, code: "\x5c", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VSUBPD, vex: 0x6621, code: "\x5c", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VSUBPD, vex: 0x6621, code: "\x5c", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VSUBPD, vex: 0x6621, code: "\x5c", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst, xArgER}},
+	{op: VSUBPS, vex: 0x1, code: "\x5c", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VSUBPS, vex: 0x1, code: "\x5c", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VSUBPS, vex: 0x21, code: "\x5c", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VSUBPS, vex: 0x21, code: "\x5c", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VSUBPS, vex: 0x21, code: "\x5c", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst, xArgER}},
+	{op: VSUBSD, vex: 0xf201, code: "\x5c", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M64}},
+	{op: VSUBSD, vex: 0xf221, code: "\x5c", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M64, xArgER}},
+	{op: VSUBSS, vex: 0xf301, code: "\x5c", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M32}},
+	{op: VSUBSS, vex: 0xf321, code: "\x5c", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M32, xArgER}},
+	{op: VTESTPD, vex: 0x6602, code: "\x0f", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VTESTPD, vex: 0x6602, code: "\x0f", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VTESTPS, vex: 0x6602, code: "\x0e", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: VTESTPS, vex: 0x6602, code: "\x0e", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgYmm2M256}},
+	{op: VUCOMISD, vex: 0x6601, code: "\x2e", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M64}},
+	{op: VUCOMISD, vex: 0x6621, code: "\x2e", modrm: encSlashR, vexL: -1, vexW: 1, args: []decodeOp{xArgXmm1, xArgXmm2M64, xArgSAE}},
+	{op: VUCOMISS, vex: 0x1, code: "\x2e", modrm: encSlashR, vexL: -1, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmm2M32}},
+	{op: VUCOMISS, vex: 0x21, code: "\x2e", modrm: encSlashR, vexL: -1, vexW: 0, args: []decodeOp{xArgXmm1, xArgXmm2M32, xArgSAE}},
+	{op: VUNPCKHPD, vex: 0x6601, code: "\x15", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VUNPCKHPD, vex: 0x6601, code: "\x15", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VUNPCKHPD, vex: 0x6621, code: "\x15", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VUNPCKHPD, vex: 0x6621, code: "\x15", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VUNPCKHPD, vex: 0x6621, code: "\x15", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VUNPCKHPS, vex: 0x1, code: "\x15", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VUNPCKHPS, vex: 0x1, code: "\x15", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VUNPCKHPS, vex: 0x21, code: "\x15", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VUNPCKHPS, vex: 0x21, code: "\x15", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VUNPCKHPS, vex: 0x21, code: "\x15", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VUNPCKLPD, vex: 0x6601, code: "\x14", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VUNPCKLPD, vex: 0x6601, code: "\x14", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VUNPCKLPD, vex: 0x6621, code: "\x14", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VUNPCKLPD, vex: 0x6621, code: "\x14", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VUNPCKLPD, vex: 0x6621, code: "\x14", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VUNPCKLPS, vex: 0x1, code: "\x14", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VUNPCKLPS, vex: 0x1, code: "\x14", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VUNPCKLPS, vex: 0x21, code: "\x14", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VUNPCKLPS, vex: 0x21, code: "\x14", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VUNPCKLPS, vex: 0x21, code: "\x14", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VXORPD, vex: 0x6601, code: "\x57", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VXORPD, vex: 0x6601, code: "\x57", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VXORPD, vex: 0x6621, code: "\x57", modrm: encSlashR, vexL: 0, vexW: 1, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM64bcst}},
+	{op: VXORPD, vex: 0x6621, code: "\x57", modrm: encSlashR, vexL: 1, vexW: 1, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM64bcst}},
+	{op: VXORPD, vex: 0x6621, code: "\x57", modrm: encSlashR, vexL: 2, vexW: 1, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM64bcst}},
+	{op: VXORPS, vex: 0x1, code: "\x57", modrm: encSlashR, vexL: 0, vexW: -1, args: []decodeOp{xArgXmm1, xArgXmmV, xArgXmm2M128}},
+	{op: VXORPS, vex: 0x1, code: "\x57", modrm: encSlashR, vexL: 1, vexW: -1, args: []decodeOp{xArgYmm1, xArgYmmV, xArgYmm2M256}},
+	{op: VXORPS, vex: 0x21, code: "\x57", modrm: encSlashR, vexL: 0, vexW: 0, args: []decodeOp{xArgXmm1, xArgMask, xArgZ, xArgXmmV, xArgXmm2M128, xArgM32bcst}},
+	{op: VXORPS, vex: 0x21, code: "\x57", modrm: encSlashR, vexL: 1, vexW: 0, args: []decodeOp{xArgYmm1, xArgMask, xArgZ, xArgYmmV, xArgYmm2M256, xArgM32bcst}},
+	{op: VXORPS, vex: 0x21, code: "\x57", modrm: encSlashR, vexL: 2, vexW: 0, args: []decodeOp{xArgZmm1, xArgMask, xArgZ, xArgZmmV, xArgZmm2M512, xArgM32bcst}},
+	{op: VZEROALL, vex: 0x1, code: "\x77", modrm: encNoModRM, vexL: 1, vexW: -1},
+	{op: VZEROUPPER, vex: 0x1, code: "\x77", modrm: encNoModRM, vexL: 0, vexW: -1},
+	{op: WBINVD, code: "\x0f\x09", modrm: encNoModRM},
+	{op: WRFSBASE, prefix: 0xF3, code: "\x0f\xae", modrm: 2, is64: 1, dataSize: 16, args: []decodeOp{xArgRM32}},
+	{op: WRFSBASE, prefix: 0xF3, code: "\x0f\xae", modrm: 2, is64: 1, dataSize: 32, args: []decodeOp{xArgRM32}},
+	{op: WRFSBASE, prefix: 0xF3, code: "\x0f\xae", modrm: 2, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64}},
+	{op: WRGSBASE, prefix: 0xF3, code: "\x0f\xae", modrm: 3, is64: 1, dataSize: 16, args: []decodeOp{xArgRM32}},
+	{op: WRGSBASE, prefix: 0xF3, code: "\x0f\xae", modrm: 3, is64: 1, dataSize: 32, args: []decodeOp{xArgRM32}},
+	{op: WRGSBASE, prefix: 0xF3, code: "\x0f\xae", modrm: 3, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64}},
+	{op: WRMSR, code: "\x0f\x30", modrm: encNoModRM},
+	{op: XABORT, code: "\xc6\xf8", modrm: encNoModRM, read: []decodeOp{xReadIb}, args: []decodeOp{xArgImm8u}},
+	{op: XADD, code: "\x0f\xc1", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: XADD, code: "\x0f\xc1", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32}},
+	{op: XADD, code: "\x0f\xc1", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64}},
+	{op: XADD, code: "\x0f\xc0", modrm: encSlashR, args: []decodeOp{xArgRM8, xArgR8}},
+	{op: XBEGIN, code: "\xc7\xf8", modrm: encNoModRM, dataSize: 16, read: []decodeOp{xReadCw}, args: []decodeOp{xArgRel16}},
+	{op: XBEGIN, code: "\xc7\xf8", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: XBEGIN, code: "\xc7\xf8", modrm: encNoModRM, dataSize: 64, read: []decodeOp{xReadCd}, args: []decodeOp{xArgRel32}},
+	{op: XCHG, code: "\x87", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: XCHG, code: "\x87", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32}},
+	{op: XCHG, code: "\x87", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64}},
+	{op: XCHG, code: "\x86", modrm: encSlashR, args: []decodeOp{xArgRM8, xArgR8}},
+	{op: XCHG, code: "\x90", plus: true, modrm: encNoModRM, dataSize: 16, args: []decodeOp{xArgR16op, xArgAX}},
+	{op: XCHG, code: "\x90", plus: true, modrm: encNoModRM, dataSize: 32, args: []decodeOp{xArgR32op, xArgEAX}},
+	{op: XCHG, code: "\x90", plus: true, modrm: encNoModRM, is64: 1, dataSize: 64, args: []decodeOp{xArgR64op, xArgRAX}},
+	{op: XEND, code: "\x0f\x01\xd5", modrm: encNoModRM},
+	{op: XGETBV, code: "\x0f\x01\xd0", modrm: encNoModRM},
+	{op: XLATB, code: "\xd7", modrm: encNoModRM},
+	{op: XLATB, code: "\xd7", modrm: encNoModRM, is64: 1, dataSize: 64},
+	{op: XOR, code: "\x34", modrm: encNoModRM, read: []decodeOp{xReadIb}, args: []decodeOp{xArgAL, xArgImm8u}},
+	{op: XOR, code: "\x35", modrm: encNoModRM, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgAX, xArgImm16}},
+	{op: XOR, code: "\x35", modrm: encNoModRM, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgEAX, xArgImm32}},
+	{op: XOR, code: "\x35", modrm: encNoModRM, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRAX, xArgImm32}},
+	{op: XOR, code: "\x81", modrm: 6, dataSize: 16, read: []decodeOp{xReadIw}, args: []decodeOp{xArgRM16, xArgImm16}},
+	{op: XOR, code: "\x83", modrm: 6, dataSize: 16, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM16, xArgImm8}},
+	{op: XOR, code: "\x31", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgRM16, xArgR16}},
+	{op: XOR, code: "\x81", modrm: 6, dataSize: 32, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM32, xArgImm32}},
+	{op: XOR, code: "\x83", modrm: 6, dataSize: 32, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM32, xArgImm8}},
+	{op: XOR, code: "\x31", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgRM32, xArgR32}},
+	{op: XOR, code: "\x81", modrm: 6, is64: 1, dataSize: 64, read: []decodeOp{xReadId}, args: []decodeOp{xArgRM64, xArgImm32}},
+	{op: XOR, code: "\x83", modrm: 6, is64: 1, dataSize: 64, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM64, xArgImm8}},
+	{op: XOR, code: "\x31", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgRM64, xArgR64}},
+	{op: XOR, code: "\x80", modrm: 6, read: []decodeOp{xReadIb}, args: []decodeOp{xArgRM8, xArgImm8u}},
+	{op: XOR, code: "\x30", modrm: encSlashR, args: []decodeOp{xArgRM8, xArgR8}},
+	{op: XOR, code: "\x33", modrm: encSlashR, dataSize: 16, args: []decodeOp{xArgR16, xArgRM16}},
+	{op: XOR, code: "\x33", modrm: encSlashR, dataSize: 32, args: []decodeOp{xArgR32, xArgRM32}},
+	{op: XOR, code: "\x33", modrm: encSlashR, is64: 1, dataSize: 64, args: []decodeOp{xArgR64, xArgRM64}},
+	{op: XOR, code: "\x32", modrm: encSlashR, args: []decodeOp{xArgR8, xArgRM8}},
+	{op: XORPD, prefix: 0x66, code: "\x0f\x57", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: XORPS, code: "\x0f\x57", modrm: encSlashR, args: []decodeOp{xArgXmm1, xArgXmm2M128}},
+	{op: XRSTOR, code: "\x0f\xae", modrm: 5, dataSize: 16, args: []decodeOp{xArgMem}},
+	{op: XRSTOR, code: "\x0f\xae", modrm: 5, dataSize: 32, args: []decodeOp{xArgMem}},
+	{op: XRSTOR64, code: "\x0f\xae", modrm: 5, is64: 1, dataSize: 64, args: []decodeOp{xArgMem}},
+	{op: XRSTORS, code: "\x0f\xc7", modrm: 3, dataSize: 16, args: []decodeOp{xArgMem}},
+	{op: XRSTORS, code: "\x0f\xc7", modrm: 3, dataSize: 32, args: []decodeOp{xArgMem}},
+	{op: XRSTORS64, code: "\x0f\xc7", modrm: 3, is64: 1, dataSize: 64, args: []decodeOp{xArgMem}},
+	{op: XSAVE, code: "\x0f\xae", modrm: 4, dataSize: 16, args: []decodeOp{xArgMem}},
+	{op: XSAVE, code: "\x0f\xae", modrm: 4, dataSize: 32, args: []decodeOp{xArgMem}},
+	{op: XSAVE64, code: "\x0f\xae", modrm: 4, is64: 1, dataSize: 64, args: []decodeOp{xArgMem}},
+	{op: XSAVEC, code: "\x0f\xc7", modrm: 4, dataSize: 16, args: []decodeOp{xArgMem}},
+	{op: XSAVEC, code: "\x0f\xc7", modrm: 4, dataSize: 32, args: []decodeOp{xArgMem}},
+	{op: XSAVEC64, code: "\x0f\xc7", modrm: 4, is64: 1, dataSize: 64, args: []decodeOp{xArgMem}},
+	{op: XSAVEOPT, code: "\x0f\xae", modrm: 6, dataSize: 16, args: []decodeOp{xArgMem}},
+	{op: XSAVEOPT, code: "\x0f\xae", modrm: 6, dataSize: 32, args: []decodeOp{xArgMem}},
+	{op: XSAVEOPT64, code: "\x0f\xae", modrm: 6, dataSize: 64, args: []decodeOp{xArgMem}},
+	{op: XSAVES, code: "\x0f\xc7", modrm: 5, dataSize: 16, args: []decodeOp{xArgMem}},
+	{op: XSAVES, code: "\x0f\xc7", modrm: 5, dataSize: 32, args: []decodeOp{xArgMem}},
+	{op: XSAVES64, code: "\x0f\xc7", modrm: 5, is64: 1, dataSize: 64, args: []decodeOp{xArgMem}},
+	{op: XSETBV, code: "\x0f\x01\xd1", modrm: encNoModRM},
+	{op: XTEST, code: "\x0f\x01\xd6", modrm: encNoModRM},
+}
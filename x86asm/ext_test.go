@@ -177,7 +177,7 @@ func testExtDis(
 	t.Logf("%d test cases, %d expected mismatches, %d failures; %.0f cases/second", totalTests, totalSkips, totalErrors, float64(totalTests)/time.Since(start).Seconds())
 
 	if err := <-errc; err != nil {
-		t.Fatal("external disassembler: %v", err)
+		t.Fatalf("external disassembler: %v", err)
 	}
 
 }
@@ -243,17 +243,18 @@ func disasm(syntax string, mode int, src []byte) (inst Inst, text string) {
 		cover -= coverage()
 	}
 
-	inst, err := decode1(src, mode, syntax == "gnu")
+	d := Decoder{Mode: mode, GNUCompat: syntax == "gnu"}
+	inst, err := d.Decode(src)
 	if err != nil {
 		text = "error: " + err.Error()
 	} else {
 		switch syntax {
 		case "gnu":
-			text = GNUSyntax(inst)
+			text = GNUSyntax(inst, 0, nil)
 		case "intel":
-			text = IntelSyntax(inst)
+			text = IntelSyntax(inst, 0, nil)
 		case "plan9":
-			text = plan9Syntax(inst, 0, nil)
+			text = GoSyntax(inst, 0, nil)
 		default:
 			text = "error: unknown syntax " + syntax
 		}
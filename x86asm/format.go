@@ -0,0 +1,446 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A Syntax identifies an assembler syntax.
+type Syntax uint8
+
+const (
+	SyntaxIntel Syntax = iota // IntelSyntax
+	SyntaxGNU                 // GNUSyntax
+	SyntaxGo                  // GoSyntax
+	SyntaxNASM                // NASMSyntax
+	SyntaxMASM                // MASMSyntax
+)
+
+func (s Syntax) String() string {
+	switch s {
+	case SyntaxIntel:
+		return "Intel"
+	case SyntaxGNU:
+		return "GNU"
+	case SyntaxGo:
+		return "Go"
+	case SyntaxNASM:
+		return "NASM"
+	case SyntaxMASM:
+		return "MASM"
+	}
+	return fmt.Sprintf("Syntax(%d)", int(s))
+}
+
+// A Case selects the letter case of mnemonics, prefixes,
+// registers, and other keywords.
+type Case uint8
+
+const (
+	CaseDefault Case = iota // the syntax's own case
+	CaseLower               // lower case, as in mov eax, dword ptr [ebx]
+	CaseUpper               // upper case, as in MOV EAX, DWORD PTR [EBX]
+)
+
+// A HexStyle selects how hexadecimal numbers are written.
+type HexStyle uint8
+
+const (
+	HexDefault     HexStyle = iota // the syntax's own style
+	HexPrefix                      // 0x1f
+	HexPrefixUpper                 // 0x1F
+	HexSuffix                      // 1fh, or 01fh if it would start with a letter
+	HexSuffixUpper                 // 1Fh, or 01Fh if it would start with a letter
+)
+
+// A Formatter formats instructions as assembly language text,
+// with options for the details that vary between listing styles.
+// The zero Formatter formats instructions as IntelSyntax does,
+// and a Formatter with only Syntax set formats them as the
+// corresponding function does.
+//
+// The options never change symbol names, and Case does not
+// change hexadecimal digits, which HexStyle controls.
+type Formatter struct {
+	// Syntax selects the assembler syntax.
+	Syntax Syntax
+
+	// Case selects the case of mnemonics, prefixes, registers,
+	// and keywords like dword ptr.
+	Case Case
+
+	// Hex selects how hexadecimal numbers are written.
+	Hex HexStyle
+
+	// Decimal selects signed decimal for immediate arguments,
+	// as in add esp, -16, instead of hexadecimal.
+	Decimal bool
+
+	// UnsignedDisp selects writing a negative displacement as the
+	// unsigned number of the instruction's address size, as in
+	// [ebp+0xfffffff0], instead of with a minus sign, as in [ebp-0x10].
+	UnsignedDisp bool
+
+	// OpWidth is the width of the mnemonic column. If OpWidth is positive,
+	// the prefixes and mnemonic of an instruction with arguments are
+	// padded with spaces to at least OpWidth columns.
+	OpWidth int
+
+	// Tab selects a tab instead of a space between the mnemonic
+	// and the arguments, after any padding for OpWidth.
+	Tab bool
+
+	// Sep is the separator between arguments.
+	// If Sep is empty, the syntax's own separator is used:
+	// "," for GNU syntax and ", " for the others.
+	Sep string
+}
+
+// Format returns the text for the instruction in the syntax and style
+// selected by f. The pc and symname arguments are as for the function
+// implementing the syntax, such as IntelSyntax.
+func (f *Formatter) Format(inst Inst, pc uint64, symname SymLookup) string {
+	var buf [64]byte
+	switch f.Syntax {
+	case SyntaxIntel:
+		return string(f.appendIntel(buf[:0], inst, pc, symname))
+	case SyntaxGNU:
+		return string(f.appendGNU(buf[:0], inst, pc, symname))
+	case SyntaxGo:
+		return f.goSyntax(inst, pc, symname)
+	}
+	return f.asmSyntax(inst, pc, symname)
+}
+
+// Append appends the text that Format returns for the instruction to dst
+// and returns the extended buffer. For Intel and GNU syntax, Append
+// does not allocate memory except to grow dst, so that reusing a large
+// enough buffer formats instructions without allocating.
+func (f *Formatter) Append(dst []byte, inst Inst, pc uint64, symname SymLookup) []byte {
+	switch f.Syntax {
+	case SyntaxIntel:
+		return f.appendIntel(dst, inst, pc, symname)
+	case SyntaxGNU:
+		return f.appendGNU(dst, inst, pc, symname)
+	}
+	return append(dst, f.Format(inst, pc, symname)...)
+}
+
+// join puts together the text for an instruction
+// from its prefixes, which end in a space, mnemonic, and arguments.
+func (f *Formatter) join(prefix, op string, args []string) string {
+	text := f.name(prefix + op)
+	if len(args) == 0 {
+		return text
+	}
+	if n := f.OpWidth - len(text); n > 0 {
+		text += strings.Repeat(" ", n)
+	}
+	if f.Tab {
+		text += "\t"
+	} else {
+		text += " "
+	}
+	return text + strings.Join(args, f.sep())
+}
+
+// appendArgs finishes the text for an instruction, which begins
+// with its prefixes and mnemonic at dst[start:], by appending the
+// arguments args in buf. It is the append form of join.
+func (f *Formatter) appendArgs(dst []byte, start int, buf []byte, args *argList) []byte {
+	f.setCase(dst[start:])
+	if args.n == 0 {
+		return dst
+	}
+	for n := f.OpWidth - (len(dst) - start); n > 0; n-- {
+		dst = append(dst, ' ')
+	}
+	if f.Tab {
+		dst = append(dst, '\t')
+	} else {
+		dst = append(dst, ' ')
+	}
+	sep := f.sep()
+	for i, s := range args.span[:args.n] {
+		if i > 0 {
+			dst = append(dst, sep...)
+		}
+		dst = append(dst, buf[s.start:s.end]...)
+	}
+	return dst
+}
+
+// sep returns the separator between arguments.
+func (f *Formatter) sep() string {
+	switch {
+	case f.Sep != "":
+		return f.Sep
+	case f.Syntax == SyntaxGNU:
+		return ","
+	}
+	return ", "
+}
+
+// An argList is a list of formatted arguments, kept as spans of
+// a separate buffer so that a formatter can edit and reorder the list
+// without allocating. The formatter appends the text of an argument
+// to the buffer and then calls add or insert with the span it wrote.
+type argList struct {
+	span [8]argSpan
+	n    int
+}
+
+type argSpan struct {
+	start, end int
+}
+
+// add adds buf[start:end] to the end of the list.
+func (l *argList) add(start, end int) {
+	l.span[l.n] = argSpan{start, end}
+	l.n++
+}
+
+// insert inserts buf[start:end] into the list as argument i.
+func (l *argList) insert(i, start, end int) {
+	copy(l.span[i+1:l.n+1], l.span[i:l.n])
+	l.span[i] = argSpan{start, end}
+	l.n++
+}
+
+// addName appends the name s, in the case selected by f, to buf
+// and adds it to the end of the list.
+func (l *argList) addName(f *Formatter, buf []byte, s string) []byte {
+	start := len(buf)
+	buf = f.appendName(buf, s)
+	l.add(start, len(buf))
+	return buf
+}
+
+// prepend adds s to the start of argument i in buf.
+func (l *argList) prepend(buf []byte, i int, s string) []byte {
+	start := len(buf)
+	buf = append(buf, s...)
+	buf = append(buf, buf[l.span[i].start:l.span[i].end]...)
+	l.span[i] = argSpan{start, len(buf)}
+	return buf
+}
+
+// name returns the keyword or register name s in the case selected by f.
+func (f *Formatter) name(s string) string {
+	switch f.Case {
+	case CaseLower:
+		return strings.ToLower(s)
+	case CaseUpper:
+		return strings.ToUpper(s)
+	}
+	return s
+}
+
+// appendName appends the keyword or register name s
+// in the case selected by f.
+func (f *Formatter) appendName(dst []byte, s string) []byte {
+	n := len(dst)
+	dst = append(dst, s...)
+	f.setCase(dst[n:])
+	return dst
+}
+
+// appendLowerName is like appendName but writes s
+// in lower case by default.
+func (f *Formatter) appendLowerName(dst []byte, s string) []byte {
+	if f.Case == CaseDefault {
+		return appendLower(dst, s)
+	}
+	return f.appendName(dst, s)
+}
+
+// setCase changes the letters in b to the case selected by f.
+func (f *Formatter) setCase(b []byte) {
+	switch f.Case {
+	case CaseLower:
+		toLower(b)
+	case CaseUpper:
+		toUpper(b)
+	}
+}
+
+// appendLower appends s in lower case.
+func appendLower(dst []byte, s string) []byte {
+	n := len(dst)
+	dst = append(dst, s...)
+	toLower(dst[n:])
+	return dst
+}
+
+func toLower(b []byte) {
+	for i, c := range b {
+		if 'A' <= c && c <= 'Z' {
+			b[i] = c + 'a' - 'A'
+		}
+	}
+}
+
+func toUpper(b []byte) {
+	for i, c := range b {
+		if 'a' <= c && c <= 'z' {
+			b[i] = c + 'A' - 'a'
+		}
+	}
+}
+
+// hex returns v as a hexadecimal number.
+func (f *Formatter) hex(v uint64) string {
+	return string(f.appendHex(nil, v))
+}
+
+// appendHex appends v as a hexadecimal number.
+func (f *Formatter) appendHex(dst []byte, v uint64) []byte {
+	style := f.Hex
+	if style == HexDefault {
+		style = HexPrefix
+		if f.Syntax == SyntaxMASM {
+			style = HexSuffixUpper
+		}
+	}
+	if style == HexSuffix || style == HexSuffixUpper {
+		n := len(dst)
+		dst = strconv.AppendUint(dst, v, 16)
+		if dst[n] > '9' {
+			// A number must start with a digit.
+			dst = append(dst, 0)
+			copy(dst[n+1:], dst[n:])
+			dst[n] = '0'
+		}
+		if style == HexSuffixUpper {
+			toUpper(dst[n:])
+		}
+		return append(dst, 'h')
+	}
+	dst = append(dst, "0x"...)
+	n := len(dst)
+	dst = strconv.AppendUint(dst, v, 16)
+	if style == HexPrefixUpper {
+		toUpper(dst[n:])
+	}
+	return dst
+}
+
+// appendSigned appends v as a hexadecimal number,
+// with a minus sign if it is negative.
+func (f *Formatter) appendSigned(dst []byte, v int64) []byte {
+	if v < 0 {
+		return f.appendHex(append(dst, '-'), uint64(-v))
+	}
+	return f.appendHex(dst, uint64(v))
+}
+
+// offset returns v as a hexadecimal number
+// with a plus or minus sign.
+func (f *Formatter) offset(v int64) string {
+	return string(f.appendOffset(nil, v))
+}
+
+// appendOffset is like appendSigned but adds a plus sign
+// if v is not negative.
+func (f *Formatter) appendOffset(dst []byte, v int64) []byte {
+	if v >= 0 {
+		dst = append(dst, '+')
+	}
+	return f.appendSigned(dst, v)
+}
+
+// imm returns the text for the immediate a.
+// See appendImm for the meaning of size.
+func (f *Formatter) imm(a Imm, size int) string {
+	return string(f.appendImm(nil, a, size))
+}
+
+// appendImm appends the text for the immediate a. If size is positive,
+// a is written as an unsigned number of size bytes; otherwise it is
+// written with a minus sign if it is negative. If f.Decimal is set,
+// a is instead written as a signed decimal number.
+func (f *Formatter) appendImm(dst []byte, a Imm, size int) []byte {
+	switch {
+	case f.Decimal:
+		return strconv.AppendInt(dst, int64(a), 10)
+	case size > 0 && size < 8:
+		return f.appendHex(dst, uint64(a)&(1<<uint(8*size)-1))
+	case size > 0:
+		return f.appendHex(dst, uint64(a))
+	}
+	return f.appendSigned(dst, int64(a))
+}
+
+// disp returns the text for the displacement d
+// of a memory argument of inst. See appendDisp.
+func (f *Formatter) disp(inst *Inst, d int64) string {
+	return string(f.appendDisp(nil, inst, d))
+}
+
+// appendDisp appends the text for the displacement d of a memory argument
+// of inst, for use where the syntax writes it with a minus sign
+// if it is negative, or, if f.UnsignedDisp is set, as an unsigned
+// number of the address size.
+func (f *Formatter) appendDisp(dst []byte, inst *Inst, d int64) []byte {
+	if f.UnsignedDisp && d < 0 {
+		return f.appendHex(dst, dispBits(inst, d))
+	}
+	return f.appendSigned(dst, d)
+}
+
+// dispOffset returns the text for the displacement d
+// of a memory argument of inst. See appendDispOffset.
+func (f *Formatter) dispOffset(inst *Inst, d int64) string {
+	return string(f.appendDispOffset(nil, inst, d))
+}
+
+// appendDispOffset is like appendDisp but adds a plus sign
+// if the displacement is written without a minus sign.
+func (f *Formatter) appendDispOffset(dst []byte, inst *Inst, d int64) []byte {
+	if d >= 0 || f.UnsignedDisp {
+		dst = append(dst, '+')
+	}
+	return f.appendDisp(dst, inst, d)
+}
+
+// dispBits returns the displacement d of a memory argument of inst
+// as an unsigned number of inst's address size.
+func dispBits(inst *Inst, d int64) uint64 {
+	switch inst.AddrSize {
+	case 16:
+		return uint64(uint16(d))
+	case 32:
+		return uint64(uint32(d))
+	}
+	return uint64(d)
+}
+
+// appendBcst appends the broadcast decoration {1toN}.
+func (f *Formatter) appendBcst(dst []byte, n int) []byte {
+	dst = f.appendName(dst, "{1to")
+	dst = strconv.AppendInt(dst, int64(n), 10)
+	return append(dst, '}')
+}
+
+// lookupSym returns the name and base address of the symbol
+// containing addr, or "", 0 if symname is nil or finds no symbol.
+func lookupSym(symname SymLookup, addr uint64) (name string, base uint64) {
+	if symname == nil {
+		return "", 0
+	}
+	return symname(addr)
+}
+
+// appendSym appends addr as the symbol name plus an offset from base.
+func (f *Formatter) appendSym(dst []byte, name string, base, addr uint64) []byte {
+	dst = append(dst, name...)
+	if addr != base {
+		dst = f.appendOffset(dst, int64(addr-base))
+	}
+	return dst
+}
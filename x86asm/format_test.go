@@ -0,0 +1,169 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var formatterTests = []struct {
+	f    Formatter
+	mode int
+	code string
+	pc   uint64
+	text string
+}{
+	{Formatter{}, 32, "83c4f0", 0, "add esp, 0xfffffff0"},
+	{Formatter{Decimal: true}, 32, "83c4f0", 0, "add esp, -16"},
+	{Formatter{Case: CaseUpper}, 32, "8b45f0", 0, "MOV EAX, DWORD PTR [EBP-0x10]"},
+	{Formatter{UnsignedDisp: true}, 32, "8b45f0", 0, "mov eax, dword ptr [ebp+0xfffffff0]"},
+	{Formatter{Hex: HexPrefixUpper}, 32, "b8ab000000", 0, "mov eax, 0xAB"},
+	{Formatter{Case: CaseUpper}, 64, "f3a4", 0, "REP MOVSB BYTE PTR [RDI], BYTE PTR [RSI]"},
+	{Formatter{Case: CaseUpper}, 64, "e8fb0f0000", 5, "CALL runtime.morestack+0x5"},
+	{Formatter{Syntax: SyntaxGNU}, 32, "8b45f0", 0, "mov -0x10(%ebp),%eax"},
+	{Formatter{Syntax: SyntaxGNU, Sep: ", ", OpWidth: 6}, 32, "8b45f0", 0, "mov    -0x10(%ebp), %eax"},
+	{Formatter{Syntax: SyntaxGNU, UnsignedDisp: true}, 32, "8b45f0", 0, "mov 0xfffffff0(%ebp),%eax"},
+	{Formatter{Syntax: SyntaxGNU, Hex: HexSuffix, Tab: true}, 64, "ff14c5f0ffffff", 0, "callq\t*0fffffff0h(,%rax,8)"},
+	{Formatter{Syntax: SyntaxGo}, 64, "488b45f0", 0, "MOVQ -0x10(BP), AX"},
+	{Formatter{Syntax: SyntaxGo, Case: CaseLower}, 64, "488b45f0", 0, "movq -0x10(bp), ax"},
+	{Formatter{Syntax: SyntaxGo, Tab: true}, 64, "488b45f0", 0, "MOVQ\t-0x10(BP), AX"},
+	{Formatter{Syntax: SyntaxGo, Decimal: true}, 64, "4883c4f0", 0, "ADDQ $-16, SP"},
+	{Formatter{Syntax: SyntaxNASM, Hex: HexSuffix}, 32, "b8ff000000", 0, "mov eax, 0ffh"},
+	{Formatter{Syntax: SyntaxNASM, Case: CaseUpper}, 64, "488b05f90f0000", 0, "MOV RAX, QWORD [REL $+0x1000]"},
+	{Formatter{Syntax: SyntaxNASM, Case: CaseUpper}, 64, "62f1fd4958c0", 0, "VADDPD ZMM0{K1}, ZMM0, ZMM0"},
+	{Formatter{Syntax: SyntaxMASM}, 32, "8b45f0", 0, "mov eax, dword ptr [ebp-10h]"},
+	{Formatter{Syntax: SyntaxMASM, Hex: HexPrefix}, 32, "8b45f0", 0, "mov eax, dword ptr [ebp-0x10]"},
+	{Formatter{Syntax: SyntaxMASM, Case: CaseUpper, OpWidth: 8}, 32, "8b45f0", 0, "MOV      EAX, DWORD PTR [EBP-10h]"},
+}
+
+func formatterTestSym(addr uint64) (string, uint64) {
+	if 0x1000 <= addr && addr < 0x2000 {
+		return "runtime.morestack", 0x1000
+	}
+	return "", 0
+}
+
+func TestFormatter(t *testing.T) {
+	for _, tt := range formatterTests {
+		inst, ok := mustDecodeHex(t, Decoder{Mode: tt.mode}, tt.code)
+		if !ok {
+			continue
+		}
+		if text := tt.f.Format(inst, tt.pc, formatterTestSym); text != tt.text {
+			t.Errorf("%+v.Format(%s) = %q, want %q", tt.f, tt.code, text, tt.text)
+		}
+	}
+}
+
+// benchInsts returns the valid instructions in testdata/decode.txt
+// for the given mode, to use as a benchmark's input.
+func benchInsts(tb testing.TB, mode int) [][]byte {
+	data, err := ioutil.ReadFile("testdata/decode.txt")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	seen := map[string]bool{}
+	var codes [][]byte
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.SplitN(line, "\t", 4)
+		if len(f) < 4 || f[1] != strconv.Itoa(mode) || strings.HasPrefix(f[3], "error: ") {
+			continue
+		}
+		code, err := hex.DecodeString(strings.Replace(f[0], "|", "", -1))
+		if err != nil || seen[string(code)] {
+			continue
+		}
+		if _, err := Decode(code, mode); err != nil {
+			continue
+		}
+		seen[string(code)] = true
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+func TestAppendAllocs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping allocation test in short mode")
+	}
+	syntaxes := []struct {
+		name   string
+		append func([]byte, Inst, uint64, SymLookup) []byte
+	}{
+		{"AppendIntelSyntax", AppendIntelSyntax},
+		{"AppendGNUSyntax", AppendGNUSyntax},
+	}
+	buf := make([]byte, 0, 1024)
+	for _, mode := range []int{32, 64} {
+		for _, code := range benchInsts(t, mode) {
+			inst, _ := Decode(code, mode)
+			for _, s := range syntaxes {
+				n := testing.AllocsPerRun(1, func() {
+					s.append(buf[:0], inst, 0x1000, formatterTestSym)
+				})
+				if n != 0 {
+					t.Errorf("%s(%x) allocates %v times", s.name, code, n)
+				}
+			}
+		}
+	}
+}
+
+// The benchmarks decode and format the instructions in testdata/decode.txt
+// in turn, so that ns/op is the time per instruction.
+
+func BenchmarkDecode(b *testing.B) {
+	codes := benchInsts(b, 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Decode(codes[i%len(codes)], 64)
+	}
+}
+
+func BenchmarkIntelSyntax(b *testing.B) {
+	benchSyntax(b, IntelSyntax)
+}
+
+func BenchmarkGNUSyntax(b *testing.B) {
+	benchSyntax(b, GNUSyntax)
+}
+
+func BenchmarkGoSyntax(b *testing.B) {
+	benchSyntax(b, GoSyntax)
+}
+
+func benchSyntax(b *testing.B, syntax func(Inst, uint64, SymLookup) string) {
+	codes := benchInsts(b, 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		inst, _ := Decode(codes[i%len(codes)], 64)
+		syntax(inst, 0, nil)
+	}
+}
+
+func BenchmarkAppendIntelSyntax(b *testing.B) {
+	benchAppend(b, AppendIntelSyntax)
+}
+
+func BenchmarkAppendGNUSyntax(b *testing.B) {
+	benchAppend(b, AppendGNUSyntax)
+}
+
+func benchAppend(b *testing.B, syntax func([]byte, Inst, uint64, SymLookup) []byte) {
+	codes := benchInsts(b, 64)
+	buf := make([]byte, 0, 256)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		inst, _ := Decode(codes[i%len(codes)], 64)
+		buf = syntax(buf[:0], inst, 0, nil)
+	}
+}
@@ -4,14 +4,27 @@
 
 package x86asm
 
-import (
-	"fmt"
-	"strings"
-)
+import "strconv"
 
 // GNUSyntax returns the GNU assembler syntax for the instruction, as defined by GNU binutils.
 // This general form is often called ``AT&T syntax'' as a reference to AT&T System V Unix.
-func GNUSyntax(inst Inst) string {
+// If pc is not zero, it is the program counter of the instruction,
+// and branch targets and RIP-relative addresses are printed as absolute
+// addresses, named using symname if that is not nil.
+func GNUSyntax(inst Inst, pc uint64, symname SymLookup) string {
+	f := Formatter{Syntax: SyntaxGNU}
+	return f.Format(inst, pc, symname)
+}
+
+// AppendGNUSyntax appends the text that GNUSyntax returns
+// for the instruction to dst and returns the extended buffer.
+// It does not allocate memory except to grow dst.
+func AppendGNUSyntax(dst []byte, inst Inst, pc uint64, symname SymLookup) []byte {
+	f := Formatter{Syntax: SyntaxGNU}
+	return f.appendGNU(dst, inst, pc, symname)
+}
+
+func (f *Formatter) appendGNU(dst []byte, inst Inst, pc uint64, symname SymLookup) []byte {
 	// Rewrite instruction to mimic GNU peculiarities.
 	// Note that inst has been passed by value and contains
 	// no pointers, so any changes we make here are local
@@ -214,9 +227,10 @@ func GNUSyntax(inst Inst) string {
 	}
 
 	// Determine opcode.
-	op := strings.ToLower(inst.Op.String())
+	var opBuf [32]byte
+	op := appendLower(opBuf[:0], inst.Op.String())
 	if alt := gnuOp[inst.Op]; alt != "" {
-		op = alt
+		op = append(op[:0], alt...)
 	}
 
 	// Determine opcode suffix.
@@ -257,14 +271,14 @@ SuffixLoop:
 					continue
 				}
 
-			case CVTSI2SD, CVTSI2SS:
+			case CVTSI2SD, CVTSI2SS, VCVTSI2SD, VCVTSI2SS, VCVTUSI2SD, VCVTUSI2SS:
 				// The integer register argument takes priority.
-				if X0 <= a && a <= X15 {
+				if X0 <= a && a <= X31 {
 					continue
 				}
 			}
 
-			if AL <= a && a <= R15 || ES <= a && a <= GS || X0 <= a && a <= X15 || M0 <= a && a <= M7 {
+			if AL <= a && a <= R15 || ES <= a && a <= GS || X0 <= a && a <= X31 || Y0 <= a && a <= Y31 || Z0 <= a && a <= Z31 || K0 <= a && a <= K7 || M0 <= a && a <= M7 {
 				needSuffix = false
 				break SuffixLoop
 			}
@@ -275,23 +289,31 @@ SuffixLoop:
 		switch inst.Op {
 		case CMPXCHG8B, FLDCW, FNSTCW, FNSTSW, LDMXCSR, LLDT, LMSW, LTR, PCLMULQDQ,
 			SETA, SETAE, SETB, SETBE, SETE, SETG, SETGE, SETL, SETLE, SETNE, SETNO, SETNP, SETNS, SETO, SETP, SETS,
-			SLDT, SMSW, STMXCSR, STR, VERR, VERW:
+			SLDT, SMSW, STMXCSR, STR, VERR, VERW, VLDMXCSR, VSTMXCSR:
 			// For various reasons, libopcodes emits no suffix for these instructions.
 
 		case CRC32:
-			op += byteSizeSuffix(argBytes(&inst, inst.Args[1]))
+			op = append(op, byteSizeSuffix(argBytes(&inst, inst.Args[1]))...)
+
+		case VCVTUSI2SD, VCVTUSI2SS:
+			// Outside 64-bit mode there is only the 32-bit source,
+			// and libopcodes leaves it unmarked.
+			if inst.Mode == 64 {
+				op = append(op, byteSizeSuffix(inst.MemBytes)...)
+			}
 
 		case LGDT, LIDT, SGDT, SIDT:
-			op += byteSizeSuffix(inst.DataSize / 8)
+			op = append(op, byteSizeSuffix(inst.DataSize/8)...)
 
 		case MOVZX, MOVSX:
 			// Integer size conversions get two suffixes.
-			op = op[:4] + byteSizeSuffix(argBytes(&inst, inst.Args[1])) + byteSizeSuffix(argBytes(&inst, inst.Args[0]))
+			op = append(op[:4], byteSizeSuffix(argBytes(&inst, inst.Args[1]))...)
+			op = append(op, byteSizeSuffix(argBytes(&inst, inst.Args[0]))...)
 
 		case LOOP, LOOPE, LOOPNE:
 			// Add w suffix to indicate use of CX register instead of ECX.
 			if inst.AddrSize == 16 {
-				op += "w"
+				op = append(op, 'w')
 			}
 
 		case CALL, ENTER, JMP, LCALL, LEAVE, LJMP, LRET, RET, SYSRET, XBEGIN:
@@ -302,24 +324,24 @@ SuffixLoop:
 			}
 			if inst.DataSize == 16 && inst.Mode != 16 {
 				markLastImplicit(&inst, PrefixDataSize)
-				op += "w"
+				op = append(op, 'w')
 			} else if inst.Mode == 64 {
-				op += "q"
+				op = append(op, 'q')
 			}
 
 		case FRSTOR, FNSAVE, FNSTENV, FLDENV:
 			// Add s suffix to indicate shortened FPU state (I guess).
 			if inst.DataSize == 16 {
-				op += "s"
+				op = append(op, 's')
 			}
 
 		case PUSH, POP:
 			if markLastImplicit(&inst, PrefixDataSize) {
-				op += byteSizeSuffix(inst.DataSize / 8)
+				op = append(op, byteSizeSuffix(inst.DataSize/8)...)
 			} else if inst.Mode == 64 {
-				op += "q"
+				op = append(op, 'q')
 			} else {
-				op += byteSizeSuffix(inst.MemBytes)
+				op = append(op, byteSizeSuffix(inst.MemBytes)...)
 			}
 
 		default:
@@ -328,25 +350,25 @@ SuffixLoop:
 				switch inst.MemBytes {
 				default:
 					if (inst.Op == FLD || inst.Op == FSTP) && isMem(inst.Args[0]) {
-						op += "t"
+						op = append(op, 't')
 					}
 				case 4:
 					if isFloatInt(inst.Op) {
-						op += "l"
+						op = append(op, 'l')
 					} else {
-						op += "s"
+						op = append(op, 's')
 					}
 				case 8:
 					if isFloatInt(inst.Op) {
-						op += "ll"
+						op = append(op, "ll"...)
 					} else {
-						op += "l"
+						op = append(op, 'l')
 					}
 				}
 				break
 			}
 
-			op += byteSizeSuffix(inst.MemBytes)
+			op = append(op, byteSizeSuffix(inst.MemBytes)...)
 		}
 	}
 
@@ -354,39 +376,73 @@ SuffixLoop:
 	switch inst.Op {
 	case 0:
 		if inst.Prefix[0] != 0 {
-			return strings.ToLower(inst.Prefix[0].String())
+			n := len(dst)
+			dst = appendLower(dst, inst.Prefix[0].String())
+			f.setCase(dst[n:])
+			return dst
 		}
 
 	case INT:
 		if inst.Opcode>>24 == 0xCC {
 			inst.Args[0] = nil
-			op = "int3"
+			op = append(op[:0], "int3"...)
 		}
 
 	case CMPPS, CMPPD, CMPSD_XMM, CMPSS:
 		imm, ok := inst.Args[2].(Imm)
 		if ok && 0 <= imm && imm < 8 {
 			inst.Args[2] = nil
-			op = cmppsOps[imm] + op[3:]
+			var cmpBuf [32]byte
+			op = append(append(cmpBuf[:0], cmppsOps[imm]...), op[3:]...)
 		}
 
 	case PCLMULQDQ:
 		imm, ok := inst.Args[2].(Imm)
 		if ok && imm&^0x11 == 0 {
 			inst.Args[2] = nil
-			op = pclmulqOps[(imm&0x10)>>3|(imm&1)]
+			op = append(op[:0], pclmulqOps[(imm&0x10)>>3|(imm&1)]...)
 		}
 
 	case XLATB:
 		if markLastImplicit(&inst, PrefixAddrSize) {
-			op = "xlat" // not xlatb
+			op = append(op[:0], "xlat"...) // not xlatb
+		}
+
+	case VCVTPD2DQ, VCVTPD2PS, VCVTTPD2DQ, VCVTPD2UDQ, VCVTTPD2UDQ, VCVTQQ2PS, VCVTUQQ2PS:
+		// The xmm destination does not say whether a memory source
+		// is 128 or 256 bits, so libopcodes adds an x or y suffix.
+		// A 512-bit source has a ymm destination and a broadcast
+		// says its size with {1toN}, so neither needs the suffix.
+		if isMem(inst.Args[1]) && inst.Bcst == 0 {
+			switch inst.MemBytes {
+			case 16:
+				op = append(op, 'x')
+			case 32:
+				op = append(op, 'y')
+			}
+		}
+
+	case VFPCLASSPD, VFPCLASSPS:
+		// Likewise the opmask destination does not say
+		// how large a non-broadcast memory source is.
+		if isMem(inst.Args[1]) && inst.Bcst == 0 {
+			switch inst.MemBytes {
+			case 16:
+				op = append(op, 'x')
+			case 32:
+				op = append(op, 'y')
+			case 64:
+				op = append(op, 'z')
+			}
 		}
 	}
 
 	// Build list of argument strings.
 	var (
-		usedPrefixes bool     // segment prefixes consumed by Mem formatting
-		args         []string // formatted arguments
+		usedPrefixes bool      // segment prefixes consumed by Mem formatting
+		args         argList   // formatted arguments, in buf
+		argBuf       [256]byte // initial storage for buf
+		buf          = argBuf[:0]
 	)
 	for i, a := range inst.Args {
 		if a == nil {
@@ -403,7 +459,37 @@ SuffixLoop:
 		if a == Imm(1) && (inst.Opcode>>24)&^1 == 0xD0 {
 			continue
 		}
-		args = append(args, gnuArg(&inst, a, &usedPrefixes))
+		n := len(buf)
+		buf = f.appendGNUArg(buf, &inst, pc, symname, a, &usedPrefixes)
+		if i == 0 && inst.Mask != 0 {
+			buf = append(buf, '{')
+			buf = append(f.appendName(buf, gccRegName[inst.Mask]), '}')
+			if inst.Zero {
+				buf = f.appendName(buf, "{z}")
+			}
+		}
+		if inst.Bcst != 0 && isMem(a) {
+			buf = f.appendBcst(buf, inst.Bcst)
+		}
+		args.add(n, len(buf))
+	}
+
+	// EVEX rounding appears as an extra argument, in Intel order
+	// after the last register or memory argument. The integer
+	// conversions put it before their integer source instead.
+	if inst.Round != 0 {
+		i := args.n
+		for i > 0 && isImm(inst.Args[i-1]) {
+			i--
+		}
+		switch inst.Op {
+		case VCVTSI2SD, VCVTSI2SS, VCVTUSI2SD, VCVTUSI2SS:
+			i--
+		}
+		n := len(buf)
+		buf = append(buf, '{')
+		buf = append(f.appendName(buf, inst.Round.String()), '}')
+		args.insert(i, n, len(buf))
 	}
 
 	// The default is to print the arguments in reverse Intel order.
@@ -413,15 +499,15 @@ SuffixLoop:
 		// no reverse
 	default:
 		// reverse args
-		for i, j := 0, len(args)-1; i < j; i, j = i+1, j-1 {
-			args[i], args[j] = args[j], args[i]
+		for i, j := 0, args.n-1; i < j; i, j = i+1, j-1 {
+			args.span[i], args.span[j] = args.span[j], args.span[i]
 		}
 	}
 
 	// Build prefix string.
 	// Must be after argument formatting, which can turn off segment prefixes.
 	var (
-		prefix       = "" // output string
+		start        = len(dst) // start of output
 		numAddr      = 0
 		numData      = 0
 		implicitData = false
@@ -442,20 +528,26 @@ SuffixLoop:
 		default:
 			if p.IsREX() {
 				if p&0xFF == PrefixREX {
-					prefix += "rex "
+					dst = append(dst, "rex "...)
 				} else {
-					prefix += "rex." + p.String()[4:] + " "
+					dst = append(dst, "rex."...)
+					for i, c := range "WRXB" {
+						if p&(PrefixREXW>>uint(i)) != 0 {
+							dst = append(dst, byte(c))
+						}
+					}
+					dst = append(dst, ' ')
 				}
 				break
 			}
-			prefix += strings.ToLower(p.String()) + " "
+			dst = append(appendLower(dst, p.String()), ' ')
 
 		case PrefixPN:
-			op += ",pn"
+			op = append(op, ",pn"...)
 			continue
 
 		case PrefixPT:
-			op += ",pt"
+			op = append(op, ",pt"...)
 			continue
 
 		case PrefixAddrSize, PrefixAddr16, PrefixAddr32:
@@ -471,7 +563,7 @@ SuffixLoop:
 			if countPrefix(&inst, PrefixAddrSize) > numAddr {
 				n = inst.Mode
 			}
-			prefix += fmt.Sprintf("addr%d ", n)
+			dst = append(strconv.AppendInt(append(dst, "addr"...), int64(n), 10), ' ')
 			continue
 
 		case PrefixData16, PrefixData32:
@@ -490,32 +582,65 @@ SuffixLoop:
 						n = 32
 					}
 				}
-				prefix += fmt.Sprintf("data%d ", n)
+				dst = append(strconv.AppendInt(append(dst, "data"...), int64(n), 10), ' ')
 				continue
 			}
-			prefix += strings.ToLower(p.String()) + " "
+			dst = append(appendLower(dst, p.String()), ' ')
 		}
 	}
 
+	// An EVEX encoding of an instruction that could have
+	// used the shorter VEX encoding gets an {evex} pseudo-prefix.
+	if isPlainEVEX(&inst) {
+		dst = append(dst, "{evex} "...)
+	}
+
 	// Finally! Put it all together.
-	text := prefix + op
-	if args != nil {
-		text += " "
+	dst = append(dst, op...)
+	if args.n > 0 {
 		// Indirect call/jmp gets a star to distinguish from direct jump address.
 		if (inst.Op == CALL || inst.Op == JMP || inst.Op == LJMP || inst.Op == LCALL) && (isMem(inst.Args[0]) || isReg(inst.Args[0])) {
-			text += "*"
+			buf = args.prepend(buf, 0, "*")
+		}
+	}
+	return f.appendArgs(dst, start, buf, &args)
+}
+
+// isPlainEVEX reports whether inst is EVEX-encoded but
+// uses none of the EVEX additions to its VEX encoding:
+// masking, broadcast, rounding, 512-bit vectors, registers
+// 16 through 31, or opmask registers.
+func isPlainEVEX(inst *Inst) bool {
+	if !vexOps[inst.Op] || inst.Mask != 0 || inst.Bcst != 0 || inst.Round != 0 {
+		return false
+	}
+	if countPrefix(inst, PrefixEVEX) == 0 {
+		return false
+	}
+	isEVEXReg := func(r Reg) bool {
+		return X16 <= r && r <= X31 || Y16 <= r && r <= Y31 || Z0 <= r && r <= K7
+	}
+	for _, a := range inst.Args {
+		switch a := a.(type) {
+		case Reg:
+			if isEVEXReg(a) {
+				return false
+			}
+		case Mem:
+			if isEVEXReg(a.Index) {
+				return false
+			}
 		}
-		text += strings.Join(args, ",")
 	}
-	return text
+	return true
 }
 
-// gnuArg returns the GNU syntax for the argument x from the instruction inst.
+// appendGNUArg appends the GNU syntax for the argument x from the instruction inst.
 // If *usedPrefixes is false and x is a Mem, then the formatting
 // includes any segment prefixes and sets *usedPrefixes to true.
-func gnuArg(inst *Inst, x Arg, usedPrefixes *bool) string {
+func (f *Formatter) appendGNUArg(dst []byte, inst *Inst, pc uint64, symname SymLookup, x Arg, usedPrefixes *bool) []byte {
 	if x == nil {
-		return "<nil>"
+		return append(dst, "<nil>"...)
 	}
 	switch x := x.(type) {
 	case Reg:
@@ -528,12 +653,11 @@ func gnuArg(inst *Inst, x Arg, usedPrefixes *bool) string {
 		case IN, INSB, INSW, INSD, OUT, OUTSB, OUTSW, OUTSD:
 			// DX is the port, but libopcodes prints it as if it were a memory reference.
 			if x == DX {
-				return "(%dx)"
+				return append(f.appendName(append(dst, '('), "%dx"), ')')
 			}
 		}
-		return gccRegName[x]
+		return f.appendName(dst, gccRegName[x])
 	case Mem:
-		seg := ""
 		var haveCS, haveDS, haveES, haveFS, haveGS, haveSS bool
 		switch x.Segment {
 		case CS:
@@ -596,60 +720,80 @@ func gnuArg(inst *Inst, x Arg, usedPrefixes *bool) string {
 			}
 			*usedPrefixes = true
 		}
+		n := len(dst)
 		if haveCS {
-			seg += "%cs:"
+			dst = append(dst, "%cs:"...)
 		}
 		if haveDS {
-			seg += "%ds:"
+			dst = append(dst, "%ds:"...)
 		}
 		if haveSS {
-			seg += "%ss:"
+			dst = append(dst, "%ss:"...)
 		}
 		if haveES {
-			seg += "%es:"
+			dst = append(dst, "%es:"...)
 		}
 		if haveFS {
-			seg += "%fs:"
+			dst = append(dst, "%fs:"...)
 		}
 		if haveGS {
-			seg += "%gs:"
+			dst = append(dst, "%gs:"...)
+		}
+		f.setCase(dst[n:])
+		var sym string
+		var symBase, symAddr uint64
+		if x.Base == RIP || x.Base == EIP {
+			if addr, ok := memTarget(inst, pc, x); ok {
+				sym, symBase = lookupSym(symname, addr)
+				symAddr = addr
+			}
 		}
-		disp := ""
-		if x.Disp != 0 {
-			disp = fmt.Sprintf("%#x", x.Disp)
+		if sym != "" {
+			dst = f.appendSym(dst, sym, symBase, symAddr)
+		} else if x.Disp != 0 {
+			dst = f.appendDisp(dst, inst, x.Disp)
 		}
 		if x.Scale == 0 || x.Index == 0 && x.Scale == 1 && (x.Base == ESP || x.Base == RSP || x.Base == 0 && inst.Mode == 64) {
 			if x.Base == 0 {
-				return seg + disp
+				return dst
 			}
-			return fmt.Sprintf("%s%s(%s)", seg, disp, gccRegName[x.Base])
+			return append(f.appendName(append(dst, '('), gccRegName[x.Base]), ')')
 		}
-		base := gccRegName[x.Base]
-		if x.Base == 0 {
-			base = ""
+		dst = append(dst, '(')
+		if x.Base != 0 {
+			dst = f.appendName(dst, gccRegName[x.Base])
 		}
-		index := gccRegName[x.Index]
-		if x.Index == 0 {
-			if inst.AddrSize == 64 {
-				index = "%riz"
-			} else {
-				index = "%eiz"
-			}
+		dst = append(dst, ',')
+		switch {
+		case x.Index != 0:
+			dst = f.appendName(dst, gccRegName[x.Index])
+		case inst.AddrSize == 64:
+			dst = f.appendName(dst, "%riz")
+		default:
+			dst = f.appendName(dst, "%eiz")
 		}
 		if AX <= x.Base && x.Base <= DI {
 			// 16-bit addressing - no scale
-			return fmt.Sprintf("%s%s(%s,%s)", seg, disp, base, index)
+			return append(dst, ')')
 		}
-		return fmt.Sprintf("%s%s(%s,%s,%d)", seg, disp, base, index, x.Scale)
+		dst = strconv.AppendInt(append(dst, ','), int64(x.Scale), 10)
+		return append(dst, ')')
 	case Rel:
-		return fmt.Sprintf(".%+#x", int32(x))
+		if pc == 0 {
+			return f.appendOffset(append(dst, '.'), int64(int32(x)))
+		}
+		addr := pc + uint64(inst.Len) + uint64(x)
+		if s, base := lookupSym(symname, addr); s != "" {
+			return f.appendSym(dst, s, base, addr)
+		}
+		return f.appendHex(dst, addr)
 	case Imm:
 		if inst.Mode == 32 {
-			return fmt.Sprintf("$%#x", uint32(x))
+			return f.appendImm(append(dst, '$'), x, 4)
 		}
-		return fmt.Sprintf("$%#x", int64(x))
+		return f.appendImm(append(dst, '$'), x, 0)
 	}
-	return x.String()
+	return f.appendName(dst, x.String())
 }
 
 var gccRegName = [...]string{
@@ -757,6 +901,94 @@ var gccRegName = [...]string{
 	X13:  "%xmm13",
 	X14:  "%xmm14",
 	X15:  "%xmm15",
+	X16:  "%xmm16",
+	X17:  "%xmm17",
+	X18:  "%xmm18",
+	X19:  "%xmm19",
+	X20:  "%xmm20",
+	X21:  "%xmm21",
+	X22:  "%xmm22",
+	X23:  "%xmm23",
+	X24:  "%xmm24",
+	X25:  "%xmm25",
+	X26:  "%xmm26",
+	X27:  "%xmm27",
+	X28:  "%xmm28",
+	X29:  "%xmm29",
+	X30:  "%xmm30",
+	X31:  "%xmm31",
+	Y0:   "%ymm0",
+	Y1:   "%ymm1",
+	Y2:   "%ymm2",
+	Y3:   "%ymm3",
+	Y4:   "%ymm4",
+	Y5:   "%ymm5",
+	Y6:   "%ymm6",
+	Y7:   "%ymm7",
+	Y8:   "%ymm8",
+	Y9:   "%ymm9",
+	Y10:  "%ymm10",
+	Y11:  "%ymm11",
+	Y12:  "%ymm12",
+	Y13:  "%ymm13",
+	Y14:  "%ymm14",
+	Y15:  "%ymm15",
+	Y16:  "%ymm16",
+	Y17:  "%ymm17",
+	Y18:  "%ymm18",
+	Y19:  "%ymm19",
+	Y20:  "%ymm20",
+	Y21:  "%ymm21",
+	Y22:  "%ymm22",
+	Y23:  "%ymm23",
+	Y24:  "%ymm24",
+	Y25:  "%ymm25",
+	Y26:  "%ymm26",
+	Y27:  "%ymm27",
+	Y28:  "%ymm28",
+	Y29:  "%ymm29",
+	Y30:  "%ymm30",
+	Y31:  "%ymm31",
+	Z0:   "%zmm0",
+	Z1:   "%zmm1",
+	Z2:   "%zmm2",
+	Z3:   "%zmm3",
+	Z4:   "%zmm4",
+	Z5:   "%zmm5",
+	Z6:   "%zmm6",
+	Z7:   "%zmm7",
+	Z8:   "%zmm8",
+	Z9:   "%zmm9",
+	Z10:  "%zmm10",
+	Z11:  "%zmm11",
+	Z12:  "%zmm12",
+	Z13:  "%zmm13",
+	Z14:  "%zmm14",
+	Z15:  "%zmm15",
+	Z16:  "%zmm16",
+	Z17:  "%zmm17",
+	Z18:  "%zmm18",
+	Z19:  "%zmm19",
+	Z20:  "%zmm20",
+	Z21:  "%zmm21",
+	Z22:  "%zmm22",
+	Z23:  "%zmm23",
+	Z24:  "%zmm24",
+	Z25:  "%zmm25",
+	Z26:  "%zmm26",
+	Z27:  "%zmm27",
+	Z28:  "%zmm28",
+	Z29:  "%zmm29",
+	Z30:  "%zmm30",
+	Z31:  "%zmm31",
+	K0:   "%k0",
+	K1:   "%k1",
+	K2:   "%k2",
+	K3:   "%k3",
+	K4:   "%k4",
+	K5:   "%k5",
+	K6:   "%k6",
+	K7:   "%k7",
 	CS:   "%cs",
 	SS:   "%ss",
 	DS:   "%ds",
@@ -0,0 +1,1024 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseGNU parses a single instruction written in the AT&T syntax
+// printed by GNUSyntax, such as "lock addl $0x5,0x10(%rax,%rcx,4)",
+// for the given processor mode (16, 32, or 64).
+//
+// As with ParseIntel, the result is the instruction that Decode
+// returns for the shortest encoding of the text, and GNUSyntax
+// of the result reproduces the text up to case, spacing, and the
+// spelling of numbers. ParseGNU also accepts the spellings used
+// in objdump -d listings, such as repz for rep, %st(0) for %st,
+// jmpq for jmp, movabs for mov, notrack for ds, rex.W and other
+// REX prefixes, a segment prefix like cs before the instruction
+// in place of one on its memory argument, and a trailing # comment.
+// Branch targets must be written relative to the end of the
+// instruction, as in "jmp .+0x10".
+func ParseGNU(text string, mode int) (Inst, error) {
+	switch mode {
+	case 16, 32, 64:
+		// ok
+	default:
+		return Inst{}, ErrInvalidMode
+	}
+	t, err := parseGNUText(text, mode)
+	if err != nil {
+		return Inst{}, fmt.Errorf("parsing %q: %v", text, err)
+	}
+	cands := t.candidates(mode)
+	search := func(exact bool) (Inst, bool) {
+		vary := func(enc []byte) [][]byte { return t.variants(enc, mode) }
+		return parseSearchVariants(cands, vary, func(inst Inst) bool {
+			t2, err := parseGNUText(GNUSyntax(inst, 0, nil), mode)
+			return err == nil && t.matches(t2) && (!exact || t.spelledLike(t2))
+		})
+	}
+	// Prefer an instruction that GNUSyntax prints as written.
+	// Failing that, accept the spellings objdump uses, like
+	// jmpq for jmp or rex.W push %rax, where GNUSyntax, like Decode,
+	// treats the REX.W prefix as used and does not print it.
+	inst, ok := search(true)
+	if !ok {
+		inst, ok = search(false)
+	}
+	if !ok {
+		return Inst{}, fmt.Errorf("parsing %q: %v", text, ErrUnrecognized)
+	}
+	return inst, nil
+}
+
+// A gnuText is a parsed line of AT&T syntax.
+type gnuText struct {
+	prefix []string
+	rex    string // REX prefix, like "rex.w"
+	rexPos int    // number of prefixes before rex
+	op     string
+	hint   string // branch hint: ",pn" or ",pt"
+	star   bool   // indirect branch
+	args   []gnuArgText
+}
+
+// A gnuArgText is a parsed argument in AT&T syntax.
+type gnuArgText struct {
+	arg   Arg      // Reg, Imm, Rel, or Mem; nil for a rounding argument
+	seg   string   // segment overrides before a memory argument, like "%es:"
+	mask  Reg      // opmask decoration
+	zero  bool     // {z} decoration
+	bcst  int      // {1toN} decoration
+	round Rounding // rounding argument
+}
+
+// matches reports whether t and u say the same thing.
+// The REX prefix in t need only match one printed in u.
+func (t *gnuText) matches(u *gnuText) bool {
+	if t.op != u.op && gnuOpAliases[t.op] != u.op && gnuOpAliases[u.op] != t.op ||
+		u.rex != "" && t.rex != u.rex || t.hint != u.hint || t.star != u.star ||
+		len(t.prefix) != len(u.prefix) || len(t.args) != len(u.args) {
+		return false
+	}
+	for i, p := range t.prefix {
+		if gnuSizePrefixes[u.prefix[i]] != gnuSizePrefixes[p] || u.prefix[i] != p && gnuSizePrefixes[p] == "" {
+			return false
+		}
+	}
+	for i, a := range t.args {
+		if u.args[i] != a {
+			return false
+		}
+	}
+	return true
+}
+
+// spelledLike reports whether u spells the mnemonic
+// and prefixes the same way as t.
+func (t *gnuText) spelledLike(u *gnuText) bool {
+	if t.op != u.op || t.rex != u.rex || len(t.prefix) != len(u.prefix) {
+		return false
+	}
+	for i, p := range t.prefix {
+		if u.prefix[i] != p {
+			return false
+		}
+	}
+	return true
+}
+
+// gnuPrefixes maps the prefix keywords accepted in AT&T syntax
+// to the ones printed by GNUSyntax.
+var gnuPrefixes = map[string]string{
+	"lock":     "lock",
+	"rep":      "rep",
+	"repe":     "rep",
+	"repz":     "rep",
+	"repn":     "repn",
+	"repne":    "repn",
+	"repnz":    "repn",
+	"xacquire": "xacquire",
+	"xrelease": "xrelease",
+	"bnd":      "bnd",
+	"addr16":   "addr16",
+	"addr32":   "addr32",
+	"addr64":   "addr64",
+	"data16":   "data16",
+	"data32":   "data32",
+	"cs":       "cs",
+	"ds":       "ds",
+	"es":       "es",
+	"fs":       "fs",
+	"gs":       "gs",
+	"ss":       "ss",
+	"{evex}":   "{evex}",
+	"notrack":  "ds",
+}
+
+// gnuPrefixBytes maps the prefixes printed by GNUSyntax
+// to the bytes that encode them.
+var gnuPrefixBytes = map[string]byte{
+	"lock":     0xF0,
+	"rep":      0xF3,
+	"repn":     0xF2,
+	"xacquire": 0xF2,
+	"xrelease": 0xF3,
+	"bnd":      0xF2,
+	"addr16":   0x67,
+	"addr32":   0x67,
+	"addr64":   0x67,
+	"data16":   0x66,
+	"data32":   0x66,
+	"cs":       0x2E,
+	"ds":       0x3E,
+	"es":       0x26,
+	"fs":       0x64,
+	"gs":       0x65,
+	"ss":       0x36,
+}
+
+// gnuSizePrefixes maps the operand and address size prefixes to
+// the prefix bytes they write, as each may be printed in either
+// spelling: libopcodes prints some repeated data16 prefixes as data32.
+var gnuSizePrefixes = map[string]string{
+	"addr16": "addr",
+	"addr32": "addr",
+	"addr64": "addr",
+	"data16": "data",
+	"data32": "data",
+}
+
+// gnuOpAliases maps the mnemonics that objdump prints for some
+// instructions to the ones GNUSyntax prints, or the reverse.
+var gnuOpAliases = map[string]string{
+	"callq":  "call",
+	"jmpq":   "jmp",
+	"movabs": "mov",
+	"retq":   "ret",
+}
+
+// gnuSuffixes lists the operand size suffixes in AT&T syntax,
+// longest first, with the memory sizes each can denote.
+var gnuSuffixes = []struct {
+	suffix string
+	bytes  []int
+}{
+	{"ll", []int{8}},
+	{"b", []int{1}},
+	{"w", []int{2}},
+	{"l", []int{4, 8}},
+	{"q", []int{8}},
+	{"s", []int{4, 2}},
+	{"t", []int{10}},
+	{"x", []int{16}},
+	{"y", []int{32}},
+	{"z", []int{64}},
+}
+
+var (
+	gnuOpsByName  = map[string][]Op{}
+	gnuRegsByName = map[string]Reg{
+		"%st(0)": F0,
+	}
+)
+
+func init() {
+	for op := Op(1); op <= maxOp; op++ {
+		name := gnuOp[op]
+		if name == "" {
+			name = strings.ToLower(op.String())
+		}
+		gnuOpsByName[name] = append(gnuOpsByName[name], op)
+	}
+	for r, name := range gccRegName {
+		if r != 0 && name != "" {
+			gnuRegsByName[name] = Reg(r)
+		}
+	}
+	for r := DR0; r <= DR15; r++ {
+		gnuRegsByName["%dr"+strconv.Itoa(int(r-DR0))] = r
+	}
+}
+
+// parseGNUText parses a line of AT&T syntax.
+func parseGNUText(text string, mode int) (*gnuText, error) {
+	t := new(gnuText)
+	s := strings.ToLower(text)
+	if i := strings.Index(s, "#"); i >= 0 {
+		s = s[:i]
+	}
+	s = strings.TrimSpace(s)
+	for {
+		word, rest := s, ""
+		if i := strings.IndexAny(s, " \t"); i >= 0 {
+			word, rest = s[:i], strings.TrimSpace(s[i:])
+		}
+		if p := gnuPrefixes[word]; p != "" {
+			t.prefix = append(t.prefix, p)
+			s = rest
+			continue
+		}
+		if isGNURex(word) {
+			t.rex, t.rexPos = word, len(t.prefix)
+			s = rest
+			continue
+		}
+		if word == "" {
+			return nil, fmt.Errorf("missing instruction")
+		}
+		if i := strings.Index(word, ","); i >= 0 {
+			word, t.hint = word[:i], word[i:]
+			if t.hint != ",pn" && t.hint != ",pt" {
+				return nil, fmt.Errorf("invalid branch hint %q", t.hint)
+			}
+		}
+		if gnuOpForms(word) == nil {
+			return nil, fmt.Errorf("unknown instruction %q", word)
+		}
+		t.op, s = word, rest
+		break
+	}
+	if strings.HasPrefix(s, "*") {
+		t.star = true
+		s = strings.TrimSpace(s[1:])
+	}
+	if s == "" {
+		return t, nil
+	}
+	for _, text := range splitArgs(s) {
+		a, err := parseGNUArg(text, mode)
+		if err != nil {
+			return nil, err
+		}
+		t.args = append(t.args, a)
+	}
+
+	// Newer versions of objdump print a segment override as a prefix,
+	// as in "cs nopw 0x0(%rax,%rax,1)", where GNUSyntax prints it on
+	// the memory argument. The port (%dx) is not a memory argument.
+	for i := len(t.prefix) - 1; i >= 0; i-- {
+		if r, ok := gnuRegsByName["%"+t.prefix[i]]; !ok || r < ES || r > GS {
+			continue
+		}
+		for j, a := range t.args {
+			if m, ok := a.arg.(Mem); ok && a.seg == "" && m != (Mem{Base: DX}) {
+				t.args[j].seg = "%" + t.prefix[i] + ":"
+				t.prefix = append(t.prefix[:i:i], t.prefix[i+1:]...)
+				if i < t.rexPos {
+					t.rexPos--
+				}
+				break
+			}
+		}
+		break
+	}
+	return t, nil
+}
+
+// isGNURex reports whether word is a REX prefix,
+// rex or rex. followed by some of w, r, x, and b.
+func isGNURex(word string) bool {
+	if word == "rex" {
+		return true
+	}
+	if !strings.HasPrefix(word, "rex.") || len(word) == len("rex.") {
+		return false
+	}
+	for _, c := range word[len("rex."):] {
+		if !strings.ContainsRune("wrxb", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseGNUArg parses a single argument, including its decorations.
+func parseGNUArg(s string, mode int) (gnuArgText, error) {
+	var a gnuArgText
+
+	// A rounding argument is only a decoration.
+	if strings.HasPrefix(s, "{") {
+		for r := RoundNearest; r <= RoundSAE; r++ {
+			if s == "{"+r.String()+"}" {
+				a.round = r
+				return a, nil
+			}
+		}
+		return a, fmt.Errorf("invalid rounding %q", s)
+	}
+
+	for strings.HasSuffix(s, "}") {
+		i := strings.LastIndex(s, "{")
+		if i < 0 {
+			return a, fmt.Errorf("invalid argument %q", s)
+		}
+		dec := s[i+1 : len(s)-1]
+		s = strings.TrimSpace(s[:i])
+		switch {
+		case dec == "z":
+			a.zero = true
+		case strings.HasPrefix(dec, "1to"):
+			n, err := strconv.Atoi(dec[len("1to"):])
+			if err != nil || n <= 0 {
+				return a, fmt.Errorf("invalid broadcast {%s}", dec)
+			}
+			a.bcst = n
+		default:
+			r, ok := gnuRegsByName[dec]
+			if !ok || r < K0 || r > K7 {
+				return a, fmt.Errorf("invalid decoration {%s}", dec)
+			}
+			a.mask = r
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(s, "$"):
+		v, err := parseInt(s[1:])
+		if err != nil {
+			return a, fmt.Errorf("invalid immediate %q", s)
+		}
+		if mode == 32 {
+			// GNUSyntax prints 32-bit mode immediates as uint32.
+			v = int64(uint32(v))
+		}
+		a.arg = Imm(v)
+
+	case strings.HasPrefix(s, "."):
+		v, err := parseInt(s[1:])
+		if err != nil {
+			return a, fmt.Errorf("invalid branch target %q", s)
+		}
+		a.arg = Rel(v)
+
+	default:
+		if r, ok := gnuRegsByName[s]; ok {
+			a.arg = r
+			break
+		}
+		seg, mem, err := parseGNUMem(s)
+		if err != nil {
+			return a, err
+		}
+		a.seg, a.arg = seg, mem
+	}
+	return a, nil
+}
+
+// parseGNUMem parses a memory reference seg:disp(base,index,scale),
+// returning the segment overrides and the rest of the reference.
+func parseGNUMem(s string) (string, Mem, error) {
+	var mem Mem
+	bad := func() (string, Mem, error) {
+		return "", mem, fmt.Errorf("invalid argument %q", s)
+	}
+	seg := ""
+	rest := s
+	for strings.HasPrefix(rest, "%") {
+		i := strings.Index(rest, ":")
+		if i < 0 {
+			return bad()
+		}
+		r, ok := gnuRegsByName[rest[:i]]
+		if !ok || r < ES || r > GS {
+			return bad()
+		}
+		seg += rest[:i+1]
+		rest = rest[i+1:]
+	}
+
+	disp := rest
+	regs := ""
+	if i := strings.Index(rest, "("); i >= 0 {
+		if !strings.HasSuffix(rest, ")") {
+			return bad()
+		}
+		disp, regs = rest[:i], rest[i+1:len(rest)-1]
+	}
+	if disp = strings.TrimSpace(disp); disp != "" {
+		v, err := parseInt(disp)
+		if err != nil {
+			return bad()
+		}
+		mem.Disp = v
+	} else if regs == "" {
+		return bad()
+	}
+	if regs == "" {
+		return seg, mem, nil
+	}
+
+	f := strings.Split(regs, ",")
+	for i := range f {
+		f[i] = strings.TrimSpace(f[i])
+	}
+	if len(f) > 3 {
+		return bad()
+	}
+	if f[0] != "" {
+		r, ok := gnuRegsByName[f[0]]
+		if !ok {
+			return bad()
+		}
+		mem.Base = r
+	}
+	if len(f) >= 2 {
+		mem.Scale = 1
+		switch f[1] {
+		case "%eiz", "%riz":
+			// no index
+		default:
+			r, ok := gnuRegsByName[f[1]]
+			if !ok {
+				return bad()
+			}
+			mem.Index = r
+		}
+	}
+	if len(f) == 3 {
+		n, err := strconv.Atoi(f[2])
+		if err != nil || n != 1 && n != 2 && n != 4 && n != 8 {
+			return bad()
+		}
+		mem.Scale = uint8(n)
+	}
+	return seg, mem, nil
+}
+
+// A gnuOpForm is one reading of an AT&T mnemonic.
+type gnuOpForm struct {
+	op     Op
+	suffix string // operand size suffix
+	imm    Arg    // immediate folded into the mnemonic
+}
+
+// gnuFloatSwap maps each floating-point subtraction or division
+// to the reversed form whose name GNUSyntax may print for it.
+var gnuFloatSwap = map[Op]Op{
+	FDIV: FDIVR, FDIVR: FDIV, FSUB: FSUBR, FSUBR: FSUB,
+	FDIVP: FDIVRP, FDIVRP: FDIVP, FSUBP: FSUBRP, FSUBRP: FSUBP,
+}
+
+// gnuOpForms returns the readings of the AT&T mnemonic name.
+func gnuOpForms(name string) []gnuOpForm {
+	var forms []gnuOpForm
+	for _, op := range gnuOpsByName[name] {
+		forms = append(forms, gnuOpForm{op: op})
+	}
+	for _, s := range gnuSuffixes {
+		if strings.HasSuffix(name, s.suffix) {
+			for _, op := range gnuOpsByName[name[:len(name)-len(s.suffix)]] {
+				forms = append(forms, gnuOpForm{op: op, suffix: s.suffix})
+			}
+		}
+	}
+	if len(name) == 6 && (strings.HasPrefix(name, "movz") || strings.HasPrefix(name, "movs")) &&
+		strings.Contains("bwlq", name[4:5]) && strings.Contains("bwlq", name[5:6]) {
+		// Integer size conversions get two suffixes.
+		op := MOVZX
+		if name[3] == 's' {
+			op = MOVSX
+		}
+		forms = append(forms, gnuOpForm{op: op, suffix: name[4:]})
+	}
+	if alias, ok := gnuOpAliases[name]; ok {
+		forms = append(forms, gnuOpForms(alias)...)
+	}
+	switch name {
+	case "int3":
+		forms = append(forms, gnuOpForm{op: INT, imm: Imm(3)})
+	}
+	for i, pred := range cmppsOps {
+		if strings.HasPrefix(name, pred) {
+			for _, op := range gnuOpsByName["cmp"+name[len(pred):]] {
+				switch op {
+				case CMPPS, CMPPD, CMPSD_XMM, CMPSS:
+					forms = append(forms, gnuOpForm{op: op, imm: Imm(i)})
+				}
+			}
+		}
+	}
+	for i, pclmul := range pclmulqOps {
+		if name == pclmul {
+			forms = append(forms, gnuOpForm{op: PCLMULQDQ, imm: Imm(i&1 | i&2<<3)})
+		}
+	}
+
+	// GNUSyntax swaps the register forms of these, after libopcodes.
+	for _, f := range forms {
+		if op, ok := gnuFloatSwap[f.op]; ok {
+			f.op = op
+			forms = append(forms, f)
+		}
+	}
+	return forms
+}
+
+// candidates returns the instructions that t might describe,
+// undoing the simplifications made by GNUSyntax.
+func (t *gnuText) candidates(mode int) []Inst {
+	var base Inst
+	base.Mode = mode
+	var sizePrefix []Prefix
+	n := 0
+	for _, p := range t.prefix {
+		switch p {
+		case "lock":
+			base.Prefix[n] = PrefixLOCK
+		case "rep":
+			base.Prefix[n] = PrefixREP
+		case "repn":
+			base.Prefix[n] = PrefixREPN
+		case "xacquire":
+			base.Prefix[n] = PrefixXACQUIRE
+		case "xrelease":
+			base.Prefix[n] = PrefixXRELEASE
+		case "bnd":
+			base.Prefix[n] = PrefixBND
+		case "cs", "ds", "es", "fs", "gs", "ss":
+			base.Prefix[n] = segmentToPrefix(gnuRegsByName["%"+p])
+		case "{evex}":
+			base.Prefix[n] = PrefixEVEX
+		case "addr16", "addr32", "addr64":
+			base.AddrSize, _ = strconv.Atoi(p[len("addr"):])
+			sizePrefix = append(sizePrefix, PrefixAddrSize)
+			continue
+		case "data16", "data32":
+			base.DataSize = 16
+			if p == "data32" {
+				base.DataSize = 32
+			}
+			sizePrefix = append(sizePrefix, PrefixDataSize)
+			continue
+		}
+		n++
+	}
+	switch t.hint {
+	case ",pn":
+		base.Prefix[n] = PrefixPN
+	case ",pt":
+		base.Prefix[n] = PrefixPT
+	}
+
+	var printed []Arg
+	haveMem := false
+	var memSegs []Prefix
+	for _, a := range t.args {
+		if a.round != 0 {
+			base.Round = a.round
+			continue
+		}
+		if a.mask != 0 {
+			base.Mask = a.mask
+		}
+		if a.zero {
+			base.Zero = true
+		}
+		if a.bcst != 0 {
+			base.Bcst = a.bcst
+		}
+		if mem, ok := a.arg.(Mem); ok {
+			if a.seg != "" {
+				mem.Segment = gnuRegsByName[a.seg[len(a.seg)-4:len(a.seg)-1]]
+			}
+			for seg := a.seg; seg != ""; seg = seg[4:] {
+				memSegs = append(memSegs, segmentToPrefix(gnuRegsByName[seg[:3]]))
+			}
+			a.arg = mem
+			haveMem = true
+		}
+		printed = append(printed, a.arg)
+	}
+
+	// In 64-bit mode, the CS, DS, ES, and SS segment overrides
+	// are ignored, and GNUSyntax prints them (after the default
+	// segment, for string instructions) as overrides on the memory
+	// argument. They may have come before or after the other prefixes.
+	type segVariant struct {
+		args   []Arg
+		before bool
+		prefix []Prefix
+	}
+	segVariants := []segVariant{{args: printed}}
+	if mode == 64 && len(memSegs) > 0 {
+		for _, p := range memSegs {
+			if p == PrefixFS || p == PrefixGS {
+				memSegs = nil
+				break
+			}
+		}
+		if memSegs != nil {
+			noSeg := append([]Arg(nil), printed...)
+			for i, a := range noSeg {
+				if mem, ok := a.(Mem); ok {
+					mem.Segment = 0
+					noSeg[i] = mem
+				}
+			}
+			for _, before := range []bool{false, true} {
+				segVariants = append(segVariants, segVariant{noSeg, before, memSegs})
+				if len(memSegs) > 1 {
+					segVariants = append(segVariants, segVariant{noSeg, before, memSegs[1:]})
+				}
+			}
+		}
+	}
+
+	var list []Inst
+	for _, sv := range segVariants {
+		base := base
+		if sv.prefix != nil {
+			var p Prefixes
+			n := copy(p[:], sv.prefix)
+			if sv.before {
+				copy(p[n:], base.Prefix[:])
+			} else {
+				n = 0
+				for base.Prefix[n] != 0 {
+					n++
+				}
+				p = base.Prefix
+				copy(p[n:], sv.prefix)
+			}
+			base.Prefix = p
+		}
+		list = append(list, t.formCandidates(mode, base, sizePrefix, sv.args, haveMem)...)
+	}
+
+	// A REX.W prefix calls for a 64-bit operand size,
+	// which the text may not otherwise say, as in rex.W push %rax.
+	if mode == 64 && strings.Contains(t.rex, "w") {
+		var wide []Inst
+		for _, c := range list {
+			if c.DataSize == 0 {
+				c.DataSize = 64
+				wide = append(wide, c)
+			}
+		}
+		list = append(wide, list...)
+	}
+	return list
+}
+
+// variants returns encodings that differ from enc, an encoding
+// of one of t's candidates, in the prefixes that t prints.
+// GNUSyntax prints the prefixes that the instruction does not use,
+// like a second segment override or an ignored REX prefix, in order,
+// and the segment overrides of a memory argument in a fixed order.
+// The variants keep the printed prefixes in order, put the ones the
+// instruction uses before, between, or after them, add the segment
+// overrides printed on memory arguments that enc lacks, and put
+// the printed REX prefix, if any, in its place.
+func (t *gnuText) variants(enc []byte, mode int) [][]byte {
+	n := legacyPrefixLen(enc)
+	legacy, rest := enc[:n], enc[n:]
+	var printed []byte
+	rexAt := -1
+	for i, p := range t.prefix {
+		if i == t.rexPos {
+			rexAt = len(printed)
+		}
+		if b, ok := gnuPrefixBytes[p]; ok {
+			printed = append(printed, b)
+		}
+	}
+	rexes := [][]byte{nil}
+	if mode == 64 && len(rest) > 0 && rest[0]&0xF0 == 0x40 {
+		rexes[0], rest = rest[:1], rest[1:]
+	}
+	if t.rex != "" {
+		rex := byte(PrefixREX)
+		for i, c := range "wrxb" {
+			if strings.ContainsRune(t.rex[len("rex"):], c) {
+				rex |= byte(PrefixREXW) >> uint(i)
+			}
+		}
+		rexes = append(rexes, []byte{rex})
+	}
+	used := append([]byte(nil), legacy...)
+	for _, b := range printed {
+		if i := bytes.IndexByte(used, b); i >= 0 {
+			used = append(used[:i], used[i+1:]...)
+		}
+	}
+	last := map[string]string{}
+	for _, p := range t.prefix {
+		if kind := gnuSizePrefixes[p]; kind != "" {
+			last[kind] = p
+		}
+	}
+	for _, p := range last {
+		// GNUSyntax spells all but the last of several size prefixes
+		// like addr64 in 64-bit mode. If the last one is spelled that
+		// way too, another one follows, which the instruction uses.
+		if b := gnuPrefixBytes[p]; gnuRepeatedSize(p, mode) && bytes.IndexByte(used, b) < 0 {
+			used = append(used, b)
+		}
+	}
+	var segs []byte
+	for _, a := range t.args {
+		for seg := a.seg; seg != ""; seg = seg[4:] {
+			b := gnuPrefixBytes[seg[1:3]]
+			if bytes.IndexByte(used, b) < 0 && bytes.IndexByte(segs, b) < 0 {
+				segs = append(segs, b)
+			}
+		}
+	}
+	if len(printed) == 0 && len(segs) == 0 && len(rexes) == 1 {
+		return nil
+	}
+
+	var out [][]byte
+	seen := map[string]bool{string(enc): true}
+	add := func(parts ...[]byte) {
+		v := bytes.Join(parts, nil)
+		if !seen[string(v)] {
+			seen[string(v)] = true
+			out = append(out, v)
+		}
+	}
+	for m := 0; m < 1<<uint(len(segs)); m++ {
+		var extra []byte
+		for i, b := range segs {
+			if m>>uint(i)&1 != 0 {
+				extra = append(extra, b)
+			}
+		}
+		for i := 0; i <= len(printed); i++ {
+			for _, mid := range [][]byte{
+				bytes.Join([][]byte{extra, used}, nil),
+				bytes.Join([][]byte{used, extra}, nil),
+			} {
+				seq := bytes.Join([][]byte{printed[:i], mid, printed[i:]}, nil)
+				for k, rex := range rexes {
+					if k > 0 && rexAt >= 0 {
+						// The printed REX prefix came before
+						// other printed prefixes.
+						j := len(seq) - (len(printed) - rexAt)
+						add(seq[:j], rex, seq[j:], rest)
+						continue
+					}
+					add(seq, rex, rest)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// gnuRepeatedSize reports whether GNUSyntax prints the size
+// prefix p in the given mode only when it is repeated.
+func gnuRepeatedSize(p string, mode int) bool {
+	switch p {
+	case "addr16", "addr32", "addr64":
+		return p == "addr"+strconv.Itoa(mode)
+	case "data16":
+		return mode == 16
+	case "data32":
+		return mode != 16
+	}
+	return false
+}
+
+// formCandidates returns the candidate instructions for each possible
+// reading of t.op, with the given prefixes and printed arguments.
+func (t *gnuText) formCandidates(mode int, base Inst, sizePrefix []Prefix, printed []Arg, haveMem bool) []Inst {
+	// Convert the arguments to Intel order.
+	var args []Arg
+	for _, a := range printed {
+		args = append([]Arg{a}, args...)
+	}
+
+	var list []Inst
+	for _, form := range gnuOpForms(t.op) {
+		inst := base
+		inst.Op = form.op
+		args := args
+		switch inst.Op {
+		case BOUND, LCALL, ENTER, LJMP:
+			// GNUSyntax does not reverse these.
+			args = printed
+		}
+		alts := gnuArgAlternatives(&inst, form.imm, args)
+		if mode == 32 {
+			// GNUSyntax prints a 16-bit immediate sign-extended
+			// to 32 bits, as in pushw $0xffffff80. Decode
+			// returns the sign-extended value, so try it first.
+			var all [][]Arg
+			for _, alt := range alts {
+				list := immAlternatives(alt)
+				for i := len(list) - 1; i >= 0; i-- {
+					all = append(all, list[i])
+				}
+			}
+			alts = all
+		}
+
+		// The suffix gives the memory size or the operand size.
+		suffix := form.suffix
+		dataSize := 0
+		if form.op == MOVZX || form.op == MOVSX {
+			suffix, dataSize = form.suffix[:1], 8*gnuSuffixBytes(form.suffix[1:])
+		}
+		memSizes := []int{0}
+		if haveMem {
+			memSizes = nil
+			for _, s := range gnuSuffixes {
+				if s.suffix == suffix {
+					memSizes = append(memSizes, s.bytes...)
+				}
+			}
+			memSizes = append(memSizes, 0)
+			if suffix == "" {
+				memSizes = append(memSizes, 10)
+			}
+		}
+		dataSizes := []int{base.DataSize}
+		addrSize := base.AddrSize
+		extra := sizePrefix // the prefixes written before the mnemonic
+		sizePrefix := sizePrefix
+		switch {
+		case dataSize != 0:
+			dataSizes = []int{dataSize}
+		case suffix == "w" || suffix == "l" || suffix == "q":
+			dataSizes = []int{8 * gnuSuffixBytes(suffix), base.DataSize}
+			if suffix == "w" && mode != 16 || suffix == "l" && mode == 16 {
+				// The suffix may stand for an operand size prefix
+				// that does not change the size of any argument.
+				sizePrefix = append(sizePrefix[:len(sizePrefix):len(sizePrefix)], PrefixDataSize)
+			}
+		case suffix == "s" && (inst.Op == FRSTOR || inst.Op == FNSAVE || inst.Op == FNSTENV || inst.Op == FLDENV):
+			// The s suffix means the 16-bit FPU state.
+			dataSizes = []int{16}
+			if mode != 16 {
+				sizePrefix = append(sizePrefix[:len(sizePrefix):len(sizePrefix)], PrefixDataSize)
+			}
+		case suffix == "" && mode == 16 && (inst.Op == FRSTOR || inst.Op == FNSAVE || inst.Op == FNSTENV || inst.Op == FLDENV):
+			// Without the s suffix, 16-bit mode needs the 32-bit FPU state.
+			dataSizes = []int{32}
+			sizePrefix = append(sizePrefix[:len(sizePrefix):len(sizePrefix)], PrefixDataSize)
+		case gnuCvtOpsize(inst.Op, args):
+			dataSizes = []int{16}
+		}
+		if (inst.Op == LOOP || inst.Op == LOOPE || inst.Op == LOOPNE) && (suffix == "w") != (mode == 16) && mode != 64 {
+			// loopw uses CX, even in 16-bit mode, where loop uses ECX.
+			// Decode leaves the address size prefix explicit.
+			addrSize = 32
+			if suffix == "w" {
+				addrSize = 16
+			}
+			i := 0
+			for inst.Prefix[i] != 0 {
+				i++
+			}
+			inst.Prefix[i] = PrefixAddrSize
+		}
+
+		for _, alt := range alts {
+			if len(alt) > len(inst.Args) {
+				continue
+			}
+			inst.Args = Args{}
+			copy(inst.Args[:], alt)
+			for _, mb := range memSizes {
+				for _, ds := range dataSizes {
+					c := inst
+					c.MemBytes, c.DataSize, c.AddrSize = mb, ds, addrSize
+					list = append(list, c)
+					if sizePrefix != nil {
+						// The sizes may instead come from
+						// meaningless prefixes.
+						i := 0
+						for c.Prefix[i] != 0 {
+							i++
+						}
+						for _, p := range sizePrefix {
+							if p == PrefixDataSize {
+								c.DataSize = 0
+							} else {
+								c.AddrSize = 0
+							}
+						}
+						copy(c.Prefix[i:], sizePrefix)
+						list = append(list, c)
+					}
+					if extra != nil {
+						// Or the suffix may give the operand size,
+						// and the prefixes may be extra ones,
+						// as in data16 cs nopw 0x0(%rax,%rax,1).
+						c := inst
+						c.MemBytes, c.DataSize, c.AddrSize = mb, ds, addrSize
+						i := 0
+						for c.Prefix[i] != 0 {
+							i++
+						}
+						copy(c.Prefix[i:], extra)
+						list = append(list, c)
+					}
+				}
+			}
+		}
+	}
+	return list
+}
+
+// gnuSuffixBytes returns the operand size in bytes
+// denoted by an integer suffix b, w, l, or q.
+func gnuSuffixBytes(suffix string) int {
+	switch suffix {
+	case "b":
+		return 1
+	case "w":
+		return 2
+	case "l":
+		return 4
+	case "q":
+		return 8
+	}
+	return 0
+}
+
+// gnuCvtOpsize reports whether args, the arguments to the
+// conversion op, include a 16-bit register, which GNUSyntax
+// prints in place of a 32-bit one after an operand size prefix.
+func gnuCvtOpsize(op Op, args []Arg) bool {
+	switch op {
+	case CVTSI2SS, CVTSI2SD, CVTSS2SI, CVTSD2SI, CVTTSD2SI, CVTTSS2SI:
+		for _, a := range args {
+			if r, ok := a.(Reg); ok && AX <= r && r <= R15W {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// gnuArgAlternatives returns the argument lists, in Intel order,
+// that might have been printed as args, most likely first.
+// The immediate imm was folded into the mnemonic, if not nil.
+func gnuArgAlternatives(inst *Inst, imm Arg, args []Arg) [][]Arg {
+	if imm != nil {
+		args = append(append([]Arg(nil), args...), imm)
+	}
+	alts := [][]Arg{args}
+	switch inst.Op {
+	case MONITOR, MWAIT:
+		// GNUSyntax prints the implicit arguments.
+		alts = append(alts, nil)
+
+	case IN, INSB, INSW, INSD, OUT, OUTSB, OUTSW, OUTSD:
+		// GNUSyntax prints the port DX as (%dx).
+		list := append([]Arg(nil), args...)
+		for i, a := range list {
+			if a == (Mem{Base: DX}) {
+				list[i] = DX
+			}
+		}
+		alts = [][]Arg{list}
+
+	case RCL, RCR, ROL, ROR, SAR, SHL, SHR:
+		// GNUSyntax omits the implicit count of 1.
+		if len(args) == 1 {
+			alts = append(alts, []Arg{args[0], Imm(1)})
+		}
+
+	case CVTSI2SS, CVTSI2SD, CVTSS2SI, CVTSD2SI, CVTTSD2SI, CVTTSS2SI:
+		// GNUSyntax prints 32-bit registers as 16-bit ones
+		// when there is an operand size prefix.
+		list := append([]Arg(nil), args...)
+		for i, a := range list {
+			if r, ok := a.(Reg); ok && AX <= r && r <= R15W {
+				list[i] = r - AX + EAX
+			}
+		}
+		alts = append(alts, list)
+	}
+	return alts
+}
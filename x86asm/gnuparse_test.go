@@ -0,0 +1,176 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestParseGNU checks that ParseGNU inverts GNUSyntax
+// for the AT&T syntax in testdata/decode.txt.
+func TestParseGNU(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/decode.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.SplitN(strings.TrimSpace(line), "\t", 4)
+		if len(f) < 4 || f[2] != "gnu" || strings.HasPrefix(f[3], "error: ") {
+			continue
+		}
+		mode, err := strconv.Atoi(f[1])
+		if err != nil {
+			t.Errorf("invalid mode %q in: %s", f[1], line)
+			continue
+		}
+		inst, err := ParseGNU(f[3], mode)
+		if err != nil {
+			t.Errorf("ParseGNU(%q, %d): %v", f[3], mode, err)
+			continue
+		}
+		if out := GNUSyntax(inst, 0, nil); out != f[3] {
+			t.Errorf("ParseGNU(%q, %d) = %s", f[3], mode, out)
+		}
+	}
+}
+
+// TestParseGNUDecode checks that ParseGNU inverts GNUSyntax
+// for every instruction in testdata/decode.txt, including those
+// listed there only in other syntaxes.
+func TestParseGNUDecode(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/decode.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := map[string]bool{}
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.Fields(line)
+		if len(f) < 2 || strings.HasPrefix(line, "#") || seen[f[0]+" "+f[1]] {
+			continue
+		}
+		seen[f[0]+" "+f[1]] = true
+		code, err := hex.DecodeString(strings.Replace(f[0], "|", "", 1))
+		if err != nil {
+			t.Errorf("parsing %q: %v", f[0], err)
+			continue
+		}
+		mode, err := strconv.Atoi(f[1])
+		if err != nil {
+			t.Errorf("invalid mode %q in: %s", f[1], line)
+			continue
+		}
+		inst, err := Decode(code, mode)
+		if err != nil {
+			continue
+		}
+		text := GNUSyntax(inst, 0, nil)
+		inst2, err := ParseGNU(text, mode)
+		if err != nil {
+			t.Errorf("ParseGNU(%q, %d) for %x: %v", text, mode, code[:inst.Len], err)
+			continue
+		}
+		if out := GNUSyntax(inst2, 0, nil); out != text {
+			t.Errorf("ParseGNU(%q, %d) for %x = %s", text, mode, code[:inst.Len], out)
+		}
+	}
+}
+
+var parseGNUTests = []struct {
+	text string
+	mode int
+	code string
+	out  string
+}{
+	{"ADD $5,%EAX", 32, "83c005", "add $0x5,%eax"},
+	{"addl $0x5,0x10(%rax,%rcx,4)", 64, "8344881005", "addl $0x5,0x10(%rax,%rcx,4)"},
+	{"mov (%rbx),%eax", 64, "8b03", "mov (%rbx),%eax"},
+	{"mov %fs:0x28,%rax", 64, "64488b042528000000", "mov %fs:0x28,%rax"},
+	{"mov %es:-0x8(%ebx),%al", 32, "268a43f8", "mov %es:-0x8(%ebx),%al"},
+	{"lock xadd %eax,(%rdi)", 64, "f00fc107", "lock xadd %eax,(%rdi)"},
+	{"repz cmpsb %es:(%rdi),%ds:(%rsi)", 64, "f3a6", "rep cmpsb %es:(%rdi),%ds:(%rsi)"},
+	{"je .+0x10", 64, "7410", "je .+0x10"},
+	{"jmp *%rax", 64, "ffe0", "jmp *%rax"},
+	{"callq *0x8(%rax)", 64, "ff5008", "callq *0x8(%rax)"},
+	{"fadd %st(0),%st(1)", 32, "dcc1", "fadd %st,%st(1)"},
+	{"fldt (%eax)", 32, "db28", "fldt (%eax)"},
+	{"movzbl %al,%ecx", 32, "0fb6c8", "movzbl %al,%ecx"},
+	{"int3", 64, "cc", "int3"},
+	{"nop # padding", 64, "90", "nop"},
+	{"vaddps %zmm3,%zmm2,%zmm1{%k1}{z}", 64, "62f16cc958cb", "vaddps %zmm3,%zmm2,%zmm1{%k1}{z}"},
+	{"vaddps (%rax){1to16},%zmm2,%zmm1", 64, "62f16c585808", "vaddps (%rax){1to16},%zmm2,%zmm1"},
+	{"{evex} vaddps %xmm3,%xmm2,%xmm1", 64, "62f16c0858cb", "{evex} vaddps %xmm3,%xmm2,%xmm1"},
+	{"rex.W push %rax", 64, "4850", "push %rax"},
+	{"lea 0x0(%esi,%eiz,1),%esi", 32, "8d3426", "lea (%esi,%eiz,1),%esi"},
+	{"lea 0x0(%rsi,%riz,1),%rsi", 64, "488d3426", "lea (%rsi,%riz,1),%rsi"},
+	{"cs nopw 0x0(%rax,%rax,1)", 64, "2e660f1f0400", "nopw %cs:(%rax,%rax,1)"},
+	{"data16 cs nopw 0x0(%rax,%rax,1)", 64, "2e66660f1f0400", "data32 nopw %cs:(%rax,%rax,1)"},
+	{"data16 data16 cs nopw 0x0(%rax,%rax,1)", 64, "2e6666660f1f0400", "data32 data32 nopw %cs:(%rax,%rax,1)"},
+	{"jmpq *%rax", 64, "ffe0", "jmp *%rax"},
+	{"callq *%rax", 64, "ffd0", "call *%rax"},
+	{"movabs $0x123456789,%rax", 64, "48b88967452301000000", "mov $0x123456789,%rax"},
+	{"endbr64", 64, "f30f1efa", "endbr64"},
+	{"notrack jmpq *%rax", 64, "3effe0", "ds jmp *%rax"},
+	{"bnd jmp *%rax", 64, "f2ffe0", "bnd jmp *%rax"},
+	{"bnd ret", 64, "f2c3", "bnd retq"},
+	{"les 0xff8c(%bx,%si),%di", 16, "c4b88cff", "les 0xff8c(%bx,%si),%di"},
+	{"idivl 0xffda(%bx)", 16, "66f7bfdaff", "idivl 0xffda(%bx)"},
+	{"shl $0x1,%eax", 32, "c1e001", "shl $0x1,%eax"},
+	{"shrw $0x1,0x4(%rbp)", 64, "66c16d0401", "shrw $0x1,0x4(%rbp)"},
+	{"rolb $0x1,(%rax)", 64, "c00001", "rolb $0x1,(%rax)"},
+	{"movsb %ds:%es:(%rsi),%es:(%rdi)", 64, "26a4", "movsb %ds:%es:(%rsi),%es:(%rdi)"},
+	{"sbb %eax,%es:%gs:(%rax)", 64, "26651900", "sbb %eax,%es:%gs:(%rax)"},
+	{"es out %ax,(%dx)", 32, "2666ef", "es out %ax,(%dx)"},
+	{"add $0xffff9420,%ax", 32, "66052094", "add $0xffff9420,%ax"},
+	{"loopnew,pt .+0x11", 32, "3e67e011", "loopnew,pt .+0x11"},
+	{"pushw $0xffffff80", 32, "666880ff", "pushw $0xffffff80"},
+	{"mov 0xdeadbeef(,%eiz,1),%eax", 32, "8b0425efbeadde", "mov 0xdeadbeef(,%eiz,1),%eax"},
+	{"rex.W sysretq", 64, "480f07", "rex.W sysretq"},
+	{"rex.W lretq", 64, "48cb", "rex.W lretq"},
+	{"rex.B hlt", 64, "41f4", "rex.B hlt"},
+	{"lock rex.W fsubrl (%rdi)", 64, "f048dc2f", "lock rex.W fsubrl (%rdi)"},
+}
+
+func TestParseGNUInput(t *testing.T) {
+	for _, tt := range parseGNUTests {
+		inst, err := ParseGNU(tt.text, tt.mode)
+		if err != nil {
+			t.Errorf("ParseGNU(%q, %d): %v", tt.text, tt.mode, err)
+			continue
+		}
+		e := Encoder{Exact: true}
+		code, err := e.Encode(inst)
+		if err != nil {
+			t.Errorf("ParseGNU(%q, %d) = %v: %v", tt.text, tt.mode, inst, err)
+			continue
+		}
+		if out := GNUSyntax(inst, 0, nil); hex.EncodeToString(code) != tt.code || out != tt.out {
+			t.Errorf("ParseGNU(%q, %d) = %x %s, want %s %s", tt.text, tt.mode, code, out, tt.code, tt.out)
+		}
+	}
+}
+
+func TestParseGNUError(t *testing.T) {
+	for _, text := range []string{
+		"",
+		"lock",
+		"frob %eax",
+		"mov (%rbx,%eax",
+		"mov %eax,%rax",
+		"mov $0x123456789,%eax",
+		"mov 0x10(%rax,%rcx,3),%eax",
+		"vaddps %zmm3,%zmm2,%zmm1{%k8}",
+	} {
+		if inst, err := ParseGNU(text, 64); err == nil {
+			t.Errorf("ParseGNU(%q, 64) = %s, want error", text, GNUSyntax(inst, 0, nil))
+		}
+	}
+	if _, err := ParseGNU("nop", 8); err != ErrInvalidMode {
+		t.Errorf("ParseGNU with mode 8: %v, want ErrInvalidMode", err)
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Support for testing against the Go assembler.
+
+package x86asm
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var goAsmUpdate = flag.Bool("goasmupdate", false, "rewrite the lists of lines go tool asm rejects")
+
+func TestGoAsm32(t *testing.T) { testGoAsm(t, 32) }
+func TestGoAsm64(t *testing.T) { testGoAsm(t, 64) }
+
+// testGoAsm checks that the Go assembler accepts the Go syntax
+// in testdata/decode.txt for the given mode and that the code
+// it assembles decodes back to the same text.
+// Since GoSyntax does not yet use all of the assembler's names,
+// the assembler may reject the lines listed in testdata/goasm32.txt
+// or testdata/goasm64.txt, which -goasmupdate rewrites.
+func testGoAsm(t *testing.T, mode int) {
+	if testing.Short() {
+		t.Skip("skipping go tool asm test in short mode")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("skipping go tool asm test: go command not found")
+	}
+
+	lines, err := goAsmLines(mode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	known, err := goAsmRejected(mode)
+	if err != nil && !*goAsmUpdate {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "x86asm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// The assembler stops after a few errors,
+	// so drop the rejected lines until it succeeds.
+	var rejected []string
+	var code map[int][]byte
+	for {
+		var errs map[int]string
+		code, errs, err = goAsm(dir, mode, lines)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if errs == nil {
+			break
+		}
+		var keep []string
+		for i, line := range lines {
+			if msg, ok := errs[i]; ok {
+				rejected = append(rejected, line)
+				if !known[line] && !*goAsmUpdate {
+					t.Errorf("%s: rejected by go tool asm: %s", line, msg)
+				} else if *mismatch {
+					t.Logf("rejected: %s: %s", line, msg)
+				}
+				continue
+			}
+			keep = append(keep, line)
+		}
+		if len(keep) == len(lines) {
+			t.Fatalf("go tool asm failed without reporting a line:\n%s", errs[-1])
+		}
+		lines = keep
+	}
+	if *goAsmUpdate {
+		if err := writeGoAsmRejected(mode, rejected); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ok := 0
+	for i, line := range lines {
+		enc := code[i]
+		inst, err := Decode(enc, mode)
+		switch {
+		case err != nil:
+			t.Errorf("%s: assembled to % x: %v", line, enc, err)
+			continue
+		case inst.Len != len(enc):
+			t.Errorf("%s: assembled to % x, which decodes as %d bytes", line, enc, inst.Len)
+			continue
+		}
+		if text := GoSyntax(inst, 0, nil); text != line {
+			want, err := ParseGo(line, mode)
+			if err != nil {
+				t.Errorf("ParseGo(%q, %d): %v", line, mode, err)
+				continue
+			}
+			if !allowedMismatchGoAsm(&want, &inst) {
+				t.Errorf("%s: assembled to % x, which decodes as %s", line, enc, text)
+			} else if *mismatch {
+				t.Logf("allowed mismatch: %s: assembled to % x, which decodes as %s", line, enc, text)
+			}
+			continue
+		}
+		ok++
+	}
+	t.Logf("%d round trips, %d lines rejected by the assembler", ok, len(rejected))
+}
+
+// allowedMismatchGoAsm reports whether the Go assembler may assemble
+// GoSyntax(*want) as got even though GoSyntax(*got) is different.
+func allowedMismatchGoAsm(want, got *Inst) bool {
+	switch {
+	case want.Op == CMP && got.Op == CMP:
+		// GoSyntax reverses the operands of CMP, as it does
+		// for every other instruction, but the assembler does not.
+		return true
+	case want.Op == MOVD && got.Op == MOVQ:
+		// The assembler uses MOVD as another name for MOVQ.
+		return true
+	case want.Op == MOVQ && got.Op == MOVQ:
+		// GoSyntax prints the mandatory F3 prefix of some
+		// encodings of MOVQ as REP.
+		return true
+	case want.Op == got.Op && (want.Op == PUSH || want.Op == POP):
+		// GoSyntax prints the decoded operand size, which is 32 bits
+		// for the shortest encodings in 64-bit mode.
+		return true
+	case want.Op == MOV && got.Op == MOV:
+		// The operand size does not matter for segment registers.
+		for _, a := range got.Args {
+			if r, ok := a.(Reg); ok && ES <= r && r <= GS {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// goAsmLines returns the distinct Go syntax in testdata/decode.txt
+// for the given mode, leaving out branches, since the assembler
+// writes branch targets differently.
+func goAsmLines(mode int) ([]string, error) {
+	data, err := ioutil.ReadFile("testdata/decode.txt")
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	var lines []string
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.SplitN(strings.TrimSpace(line), "\t", 4)
+		if len(f) < 4 || f[1] != strconv.Itoa(mode) || f[2] != "plan9" || strings.HasPrefix(f[3], "error: ") {
+			continue
+		}
+		text := f[3]
+		if strings.Contains(text, " .+") || strings.Contains(text, " .-") || seen[text] {
+			continue
+		}
+		seen[text] = true
+		lines = append(lines, text)
+	}
+	return lines, nil
+}
+
+// goAsmRejectedFile returns the name of the file listing
+// the lines that go tool asm rejects in the given mode.
+func goAsmRejectedFile(mode int) string {
+	return fmt.Sprintf("testdata/goasm%d.txt", mode)
+}
+
+// goAsmRejected returns the lines listed in goAsmRejectedFile(mode).
+func goAsmRejected(mode int) (map[string]bool, error) {
+	data, err := ioutil.ReadFile(goAsmRejectedFile(mode))
+	if err != nil {
+		return nil, err
+	}
+	known := map[string]bool{}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line != "" && !strings.HasPrefix(line, "#") {
+			known[line] = true
+		}
+	}
+	return known, nil
+}
+
+// writeGoAsmRejected writes the rejected lines to goAsmRejectedFile(mode).
+func writeGoAsmRejected(mode int, rejected []string) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# Go syntax from decode.txt that go tool asm rejects in %d-bit mode.\n", mode)
+	fmt.Fprintf(&buf, "# Generated by go test -run GoAsm%d -goasmupdate.\n", mode)
+	for _, line := range rejected {
+		fmt.Fprintf(&buf, "%s\n", line)
+	}
+	return ioutil.WriteFile(goAsmRejectedFile(mode), buf.Bytes(), 0666)
+}
+
+var (
+	goAsmPrefix = regexp.MustCompile(`^(REP|REPNE|LOCK|CS|DS|ES|FS|GS|SS|PN|PT|XACQUIRE|XRELEASE|BND) `)
+	goAsmError  = regexp.MustCompile(`(?:x\.s:(\d+)|main\.f(\d+):)\)?:? *(.*)`)
+	goAsmFunc   = regexp.MustCompile(`^main\.f(\d+) STEXT`)
+	goAsmHex    = regexp.MustCompile(`^\t0x[0-9a-f]+((?: [0-9a-f]{2}){1,16})(?:  |$)`)
+)
+
+// goAsm assembles lines with go tool asm in dir,
+// each as its own function, and returns the code for each line.
+// If the assembler rejects some lines, goAsm instead returns
+// their error messages, indexed by line, or under -1
+// if the messages do not name a line.
+func goAsm(dir string, mode int, lines []string) (code map[int][]byte, errs map[int]string, err error) {
+	// Line i is x.s:2*i+2, in function fi.
+	var buf bytes.Buffer
+	for i, line := range lines {
+		// The assembler wants a prefix as a separate instruction.
+		line = goAsmPrefix.ReplaceAllString(line, "$1; ")
+		// The flags are NOSPLIT|NOFRAME, to keep the assembler
+		// from adding a frame around a CALL.
+		fmt.Fprintf(&buf, "TEXT ·f%d(SB), 516, $0\n\t%s\n", i, line)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "x.s"), buf.Bytes(), 0666); err != nil {
+		return nil, nil, err
+	}
+
+	goarch := "amd64"
+	if mode == 32 {
+		goarch = "386"
+	}
+	cmd := exec.Command("go", "tool", "asm", "-p", "main", "-S", "-o", "x.o", "x.s")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOOS=linux", "GOARCH="+goarch)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		errs = map[int]string{}
+		for _, msg := range strings.Split(stderr.String(), "\n") {
+			if m := goAsmError.FindStringSubmatch(msg); m != nil {
+				i := -1
+				if m[1] != "" {
+					n, _ := strconv.Atoi(m[1])
+					i = (n - 2) / 2
+				} else {
+					i, _ = strconv.Atoi(m[2])
+				}
+				if 0 <= i && i < len(lines) {
+					errs[i] = m[3]
+					continue
+				}
+			}
+			if msg != "" {
+				errs[-1] += msg + "\n"
+			}
+		}
+		if errs[-1] == "" {
+			errs[-1] = err.Error()
+		}
+		return nil, errs, nil
+	}
+
+	code = map[int][]byte{}
+	fn := -1
+	b := bufio.NewScanner(&stdout)
+	for b.Scan() {
+		line := b.Text()
+		if m := goAsmFunc.FindStringSubmatch(line); m != nil {
+			fn, _ = strconv.Atoi(m[1])
+			continue
+		}
+		if m := goAsmHex.FindStringSubmatch(line); m != nil && fn >= 0 {
+			for _, h := range strings.Fields(m[1]) {
+				x, _ := strconv.ParseUint(h, 16, 8)
+				code[fn] = append(code[fn], byte(x))
+			}
+		}
+	}
+	return code, nil, nil
+}
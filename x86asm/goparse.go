@@ -0,0 +1,576 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseGo parses a single instruction written in the Go assembler
+// syntax printed by GoSyntax, such as "ADDL $0x5, 0x10(AX)(CX*4)",
+// for the given processor mode (16, 32, or 64).
+//
+// GoSyntax does not print the size of a register or memory argument,
+// and it prints at most one prefix, so many instructions print the same
+// way. The result is the instruction that Decode returns for the first
+// encoding found that prints as the text, preferring the register sizes
+// implied by the operand size suffix and the mode, so its Opcode, Len,
+// DataSize, and other decoded fields are filled in, and GoSyntax
+// of the result reproduces the text up to spacing and the spelling
+// of numbers. Branch targets must be written relative to the end of
+// the instruction, as in "JMP .+16"; symbolic references like
+// "runtime·morestack(SB)" are not accepted.
+func ParseGo(text string, mode int) (Inst, error) {
+	switch mode {
+	case 16, 32, 64:
+		// ok
+	default:
+		return Inst{}, ErrInvalidMode
+	}
+	t, err := parseGoText(text)
+	if err != nil {
+		return Inst{}, fmt.Errorf("parsing %q: %v", text, err)
+	}
+	// Prefer an instruction that GoSyntax prints as written,
+	// so that $-0x36 picks the sign-extended 8-bit immediate
+	// over the byte 0xca, which GoSyntax prints as $0xca.
+	// Try the likely register sizes before all the others.
+	for _, exact := range []bool{true, false} {
+		match := func(inst Inst) bool {
+			t2, err := parseGoText(GoSyntax(inst, 0, nil))
+			return err == nil && t.matches(t2, immBits(&inst)) && (!exact || t.spelledLike(t2))
+		}
+		if inst, ok := parseSearchVariants(t.candidates(mode, false), t.variants, match); ok {
+			return inst, nil
+		}
+		if inst, ok := parseSearchVariants(t.candidates(mode, true), t.variants, match); ok {
+			return inst, nil
+		}
+	}
+	return Inst{}, fmt.Errorf("parsing %q: %v", text, ErrUnrecognized)
+}
+
+// A goText is a parsed line of Go assembler syntax.
+type goText struct {
+	prefix string
+	op     string // mnemonic, including any operand size suffix
+	round  Rounding
+	bcst   bool
+	zero   bool
+	args   []goArgText
+}
+
+// A goArgText is a parsed argument in Go assembler syntax.
+// Registers are kept by name, since a name like AX
+// stands for AX, EAX, or RAX.
+type goArgText struct {
+	reg   string // register name
+	imm   bool   // arg is the immediate val
+	rel   bool   // arg is the branch target .+val
+	mem   bool   // arg is the memory reference seg:val(base)(index*scale)
+	val   int64
+	seg   string
+	base  string
+	index string
+	scale int
+}
+
+// matches reports whether t and u say the same thing about an
+// instruction whose immediates have the given number of bits.
+// Immediates need only agree in those bits, and since GoSyntax
+// prints the operand size suffix of a byte operation as L,
+// a B suffix in t matches any suffix in u for an 8-bit operation.
+func (t *goText) matches(u *goText, immBits int) bool {
+	if t.prefix != u.prefix || t.round != u.round ||
+		t.bcst != u.bcst || t.zero != u.zero || len(t.args) != len(u.args) {
+		return false
+	}
+	if t.op != u.op {
+		n := len(t.op) - 1
+		if immBits != 8 || !strings.HasSuffix(t.op, "B") || len(u.op) != len(t.op) || u.op[:n] != t.op[:n] {
+			return false
+		}
+	}
+	for i, a := range t.args {
+		b := u.args[i]
+		if a.imm && b.imm && sameImm(Imm(a.val), Imm(b.val), immBits) {
+			b.val = a.val
+		}
+		if b != a {
+			return false
+		}
+	}
+	return true
+}
+
+// spelledLike reports whether u spells the mnemonic
+// and arguments the same way as t.
+func (t *goText) spelledLike(u *goText) bool {
+	if t.op != u.op {
+		return false
+	}
+	for i, a := range t.args {
+		if u.args[i] != a {
+			return false
+		}
+	}
+	return true
+}
+
+// variants returns enc with the prefix that t prints moved after
+// the other legacy prefixes. GoSyntax prints only the last prefix,
+// but Encode puts a segment override before an operand size prefix.
+func (t *goText) variants(enc []byte) [][]byte {
+	if t.prefix == "" {
+		return nil
+	}
+	b := byte(goPrefixByName[t.prefix][0])
+	n := legacyPrefixLen(enc)
+	i := bytes.LastIndexByte(enc[:n], b)
+	if i < 0 || i == n-1 {
+		return nil
+	}
+	v := append(append([]byte(nil), enc[:i]...), enc[i+1:n]...)
+	v = append(v, b)
+	return [][]byte{append(v, enc[n:]...)}
+}
+
+var (
+	goOpsByName    = map[string]Op{}
+	goRegsByName   = map[string][]Reg{}
+	goPrefixByName = map[string][]Prefix{
+		// GoSyntax prints any F2 prefix as REPNE.
+		"REPNE": {PrefixREPN, PrefixXACQUIRE, PrefixBND},
+	}
+	goRounds = map[string]Rounding{
+		"RN_SAE": RoundNearest,
+		"RD_SAE": RoundDown,
+		"RU_SAE": RoundUp,
+		"RZ_SAE": RoundZero,
+		"SAE":    RoundSAE,
+	}
+)
+
+func init() {
+	for op := Op(1); op <= maxOp; op++ {
+		goOpsByName[op.String()] = op
+	}
+	for r, name := range plan9Reg {
+		if r != 0 && name != "" {
+			goRegsByName[name] = append(goRegsByName[name], Reg(r))
+		}
+	}
+	for p, name := range prefixNames {
+		switch p & 0xFF {
+		case PrefixREPN, PrefixDataSize, PrefixAddrSize:
+			continue
+		}
+		if !p.IsREX() && !p.IsVEX() {
+			goPrefixByName[name] = append(goPrefixByName[name], p)
+		}
+	}
+}
+
+// parseGoText parses a line of Go assembler syntax.
+func parseGoText(text string) (*goText, error) {
+	t := new(goText)
+	s := text
+	if i := strings.Index(s, "//"); i >= 0 {
+		s = s[:i]
+	}
+	s = strings.TrimSpace(s)
+	word, rest := s, ""
+	if i := strings.IndexAny(s, " \t"); i >= 0 {
+		word, rest = s[:i], strings.TrimSpace(s[i:])
+	}
+	if goPrefixByName[word] != nil {
+		t.prefix = word
+		word, rest = rest, ""
+		if i := strings.IndexAny(word, " \t"); i >= 0 {
+			word, rest = word[:i], strings.TrimSpace(word[i:])
+		}
+	}
+	if word == "" {
+		return nil, fmt.Errorf("missing instruction")
+	}
+
+	// The mnemonic may carry EVEX decorations, like VADDPS.RN_SAE.Z.
+	f := strings.Split(word, ".")
+	t.op = f[0]
+	for _, dec := range f[1:] {
+		switch {
+		case goRounds[dec] != 0 && t.round == 0 && !t.bcst && !t.zero:
+			t.round = goRounds[dec]
+		case dec == "BCST" && !t.bcst && !t.zero:
+			t.bcst = true
+		case dec == "Z" && !t.zero:
+			t.zero = true
+		default:
+			return nil, fmt.Errorf("invalid decoration .%s", dec)
+		}
+	}
+	if goOpForms(t.op) == nil {
+		return nil, fmt.Errorf("unknown instruction %q", t.op)
+	}
+
+	if rest == "" {
+		return t, nil
+	}
+	for _, text := range splitArgs(rest) {
+		a, err := parseGoArg(text)
+		if err != nil {
+			return nil, err
+		}
+		t.args = append(t.args, a)
+	}
+	return t, nil
+}
+
+// parseGoArg parses a single argument.
+func parseGoArg(s string) (goArgText, error) {
+	var a goArgText
+	switch {
+	case strings.HasPrefix(s, "$"):
+		v, err := parseInt(s[1:])
+		if err != nil {
+			return a, fmt.Errorf("invalid immediate %q", s)
+		}
+		a.imm, a.val = true, v
+
+	case strings.HasPrefix(s, "."):
+		v, err := parseInt(s[1:])
+		if err != nil {
+			return a, fmt.Errorf("invalid branch target %q", s)
+		}
+		a.rel, a.val = true, v
+
+	case goRegsByName[s] != nil:
+		a.reg = s
+
+	default:
+		return parseGoMem(s)
+	}
+	return a, nil
+}
+
+// parseGoMem parses a memory reference seg:disp(base)(index*scale).
+func parseGoMem(s string) (goArgText, error) {
+	a := goArgText{mem: true}
+	bad := func() (goArgText, error) {
+		return goArgText{}, fmt.Errorf("invalid argument %q", s)
+	}
+	rest := s
+	if i := strings.Index(rest, ":"); i >= 0 {
+		a.seg, rest = rest[:i], rest[i+1:]
+		if r := goRegsByName[a.seg]; r == nil || r[0] < ES || r[0] > GS {
+			return bad()
+		}
+	}
+	disp := rest
+	var regs []string
+	if i := strings.Index(rest, "("); i >= 0 {
+		disp, rest = rest[:i], rest[i:]
+		for rest != "" {
+			j := strings.Index(rest, ")")
+			if rest[0] != '(' || j < 0 {
+				return bad()
+			}
+			regs = append(regs, rest[1:j])
+			rest = rest[j+1:]
+		}
+	}
+	if disp = strings.TrimSpace(disp); disp != "" {
+		v, err := parseInt(disp)
+		if err != nil {
+			return bad()
+		}
+		a.val = v
+	} else if regs == nil {
+		return bad()
+	}
+	if len(regs) > 2 {
+		return bad()
+	}
+	if len(regs) > 0 {
+		if strings.Contains(regs[0], "*") {
+			// Index but no base.
+			regs = append([]string{""}, regs...)
+		} else {
+			a.base = regs[0]
+			if goRegsByName[a.base] == nil {
+				return bad()
+			}
+		}
+	}
+	if len(regs) > 1 {
+		f := strings.Split(regs[1], "*")
+		if len(f) != 2 || goRegsByName[f[0]] == nil {
+			return bad()
+		}
+		n, err := strconv.Atoi(f[1])
+		if err != nil || n != 1 && n != 2 && n != 4 && n != 8 {
+			return bad()
+		}
+		a.index, a.scale = f[0], n
+	}
+	return a, nil
+}
+
+// A goOpForm is one reading of a Go assembler mnemonic.
+type goOpForm struct {
+	op       Op
+	dataSize int // from the operand size suffix, or 0
+}
+
+// goOpForms returns the readings of the Go assembler mnemonic name.
+func goOpForms(name string) []goOpForm {
+	var forms []goOpForm
+	if op, ok := goOpsByName[name]; ok {
+		forms = append(forms, goOpForm{op: op})
+	}
+	if name == "" {
+		return forms
+	}
+	size := 0
+	switch name[len(name)-1] {
+	case 'B':
+		size = 8
+	case 'W':
+		size = 16
+	case 'L':
+		size = 32
+	case 'Q':
+		size = 64
+	}
+	if op, ok := goOpsByName[name[:len(name)-1]]; ok && size != 0 && plan9Suffix[op] {
+		forms = append(forms, goOpForm{op: op, dataSize: size})
+	}
+	return forms
+}
+
+// candidates returns the instructions that t might describe,
+// undoing the simplifications made by GoSyntax.
+// Unless all is set, it only considers the register sizes
+// implied by the operand size suffix and the mode.
+func (t *goText) candidates(mode int, all bool) []Inst {
+	var base Inst
+	base.Mode = mode
+	base.Round = t.round
+	base.Zero = t.zero
+
+	// The printed prefix may be part of the instruction
+	// or implied by it or by a memory argument.
+	prefixes := []Prefix{0}
+	if t.prefix != "" {
+		prefixes = append(goPrefixByName[t.prefix], 0)
+		for _, a := range t.args {
+			if a.seg == t.prefix {
+				// Try the segment on the memory argument alone first,
+				// to avoid encoding the prefix twice.
+				prefixes = append([]Prefix{0}, goPrefixByName[t.prefix]...)
+				break
+			}
+		}
+	}
+	var lists [][]Prefix
+	for _, p := range prefixes {
+		lists = append(lists, []Prefix{p})
+	}
+	bcsts := []int{0}
+	if t.bcst {
+		bcsts = []int{16, 8, 4, 2}
+	}
+
+	// GoSyntax prints the opmask just before the destination.
+	printed := [][]goArgText{t.args}
+	masks := []Reg{0}
+	if n := len(t.args); n >= 2 && t.args[n-2].reg != "" {
+		if r := goRegsByName[t.args[n-2].reg][0]; K1 <= r && r <= K7 {
+			args := append(append([]goArgText(nil), t.args[:n-2]...), t.args[n-1])
+			printed = append([][]goArgText{args}, printed...)
+			masks = append([]Reg{r}, masks...)
+		}
+	}
+
+	var list []Inst
+	for _, form := range goOpForms(t.op) {
+		inst := base
+		inst.Op = form.op
+		dataSizes := []int{form.dataSize}
+		if all && form.dataSize == 0 {
+			dataSizes = append(dataSizes, 16, 32, 64)
+		}
+		prefixLists := lists
+		if form.dataSize == 16 && mode != 16 || form.dataSize == 32 && mode == 16 {
+			// The suffix may stand for an operand size prefix
+			// that does not change the size of any argument.
+			for _, list := range lists {
+				prefixLists = append(prefixLists, append(list[:len(list):len(list)], PrefixDataSize))
+			}
+		}
+		// The suffix also gives the size of a memory argument,
+		// although GoSyntax prints L for a byte operation.
+		memBytes := []int{0}
+		for _, a := range t.args {
+			if a.mem && form.dataSize != 0 {
+				memBytes = []int{form.dataSize / 8, 0}
+			}
+		}
+		regSizes := goRegSizes(mode, form.dataSize, all)
+		addrSizes := []int{defaultAddrSize(mode)}
+		if all {
+			addrSizes = []int{16, 32, 64}
+		}
+		for i, args := range printed {
+			inst.Mask = masks[i]
+			for _, as := range addrSizes {
+				for _, iargs := range goArgAlternatives(mode, args, regSizes, as, all) {
+					for _, iargs := range immAlternatives(iargs) {
+						inst.Args = Args{}
+						copy(inst.Args[:], iargs)
+						for _, prefixes := range prefixLists {
+							for _, b := range bcsts {
+								c := inst
+								n := 0
+								for _, p := range prefixes {
+									if p != 0 {
+										c.Prefix[n] = p
+										n++
+									}
+								}
+								c.Bcst = b
+								for _, mb := range memBytes {
+									c.MemBytes = mb
+									for _, ds := range dataSizes {
+										c.DataSize = ds
+										list = append(list, c)
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return list
+}
+
+// goRegSizes returns the sizes, in bits, to consider for general
+// registers in an instruction with the given operand size suffix.
+func goRegSizes(mode, dataSize int, all bool) []int {
+	size := dataSize
+	if size == 0 {
+		size = 32
+		if mode == 16 {
+			size = 16
+		}
+	}
+	if !all {
+		return []int{size}
+	}
+	sizes := []int{size}
+	for _, s := range []int{32, 64, 16, 8} {
+		if s != size {
+			sizes = append(sizes, s)
+		}
+	}
+	return sizes
+}
+
+// goArgAlternatives returns the argument lists, in Intel order,
+// that might have been printed as args. Unless all is set,
+// the general registers are all assumed to have the first
+// of the sizes regSizes.
+func goArgAlternatives(mode int, args []goArgText, regSizes []int, addrSize int, all bool) [][]Arg {
+	alts := [][]Arg{nil}
+	for i := len(args) - 1; i >= 0; i-- {
+		choices := goArgChoices(mode, args[i], regSizes, addrSize)
+		if len(choices) == 0 {
+			return nil
+		}
+		if !all {
+			choices = choices[:1]
+		}
+		var next [][]Arg
+		for _, alt := range alts {
+			for _, c := range choices {
+				next = append(next, append(alt[:len(alt):len(alt)], c))
+			}
+		}
+		alts = next
+	}
+	return alts
+}
+
+// goArgChoices returns the arguments that a might stand for,
+// most likely first.
+func goArgChoices(mode int, a goArgText, regSizes []int, addrSize int) []Arg {
+	switch {
+	case a.imm:
+		v := a.val
+		if mode == 32 {
+			// GoSyntax prints 32-bit mode immediates as uint32.
+			v = int64(uint32(v))
+		}
+		return []Arg{Imm(v)}
+	case a.rel:
+		return []Arg{Rel(a.val)}
+	case a.mem:
+		mem := Mem{Disp: a.val}
+		if a.seg != "" {
+			mem.Segment = goRegsByName[a.seg][0]
+		}
+		var ok bool
+		if a.base != "" {
+			if mem.Base, ok = goSizedReg(a.base, addrSize); !ok {
+				return nil
+			}
+		}
+		if a.index != "" {
+			if mem.Index, ok = goSizedReg(a.index, addrSize); !ok {
+				// A vector index for a gather or scatter.
+				r := goRegsByName[a.index][0]
+				if r < X0 || r > Z31 {
+					return nil
+				}
+				mem.Index = r
+			}
+			mem.Scale = uint8(a.scale)
+		}
+		return []Arg{mem}
+	}
+	var choices []Arg
+	for _, size := range regSizes {
+		if r, ok := goSizedReg(a.reg, size); ok {
+			choices = append(choices, r)
+		}
+	}
+	if choices == nil {
+		// Not a general register.
+		for _, r := range goRegsByName[a.reg] {
+			choices = append(choices, r)
+		}
+	}
+	return choices
+}
+
+// goSizedReg returns the register of the given size in bits
+// printed as name, if any.
+func goSizedReg(name string, size int) (Reg, bool) {
+	for _, r := range goRegsByName[name] {
+		switch {
+		case size == 8 && (AL <= r && r <= R15B),
+			size == 16 && (AX <= r && r <= R15W || r == IP),
+			size == 32 && (EAX <= r && r <= R15L || r == EIP),
+			size == 64 && (RAX <= r && r <= R15 || r == RIP):
+			return r, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,157 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestParseGo checks that ParseGo inverts GoSyntax
+// for the Go syntax in testdata/decode.txt.
+func TestParseGo(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/decode.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.SplitN(strings.TrimSpace(line), "\t", 4)
+		if len(f) < 4 || f[2] != "plan9" || strings.HasPrefix(f[3], "error: ") {
+			continue
+		}
+		mode, err := strconv.Atoi(f[1])
+		if err != nil {
+			t.Errorf("invalid mode %q in: %s", f[1], line)
+			continue
+		}
+		inst, err := ParseGo(f[3], mode)
+		if err != nil {
+			t.Errorf("ParseGo(%q, %d): %v", f[3], mode, err)
+			continue
+		}
+		if out := GoSyntax(inst, 0, nil); out != f[3] {
+			t.Errorf("ParseGo(%q, %d) = %s", f[3], mode, out)
+		}
+	}
+}
+
+// TestParseGoDecode checks that ParseGo inverts GoSyntax
+// for every instruction in testdata/decode.txt, including those
+// listed there only in other syntaxes.
+func TestParseGoDecode(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/decode.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := map[string]bool{}
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.Fields(line)
+		if len(f) < 2 || strings.HasPrefix(line, "#") || seen[f[0]+" "+f[1]] {
+			continue
+		}
+		seen[f[0]+" "+f[1]] = true
+		code, err := hex.DecodeString(strings.Replace(f[0], "|", "", 1))
+		if err != nil {
+			t.Errorf("parsing %q: %v", f[0], err)
+			continue
+		}
+		mode, err := strconv.Atoi(f[1])
+		if err != nil {
+			t.Errorf("invalid mode %q in: %s", f[1], line)
+			continue
+		}
+		inst, err := Decode(code, mode)
+		if err != nil {
+			continue
+		}
+		text := GoSyntax(inst, 0, nil)
+		inst2, err := ParseGo(text, mode)
+		if err != nil {
+			t.Errorf("ParseGo(%q, %d) for %x: %v", text, mode, code[:inst.Len], err)
+			continue
+		}
+		if out := GoSyntax(inst2, 0, nil); out != text {
+			t.Errorf("ParseGo(%q, %d) for %x = %s", text, mode, code[:inst.Len], out)
+		}
+	}
+}
+
+var parseGoTests = []struct {
+	text string
+	mode int
+	code string
+	out  string
+}{
+	{"ADDL $0x5, AX", 32, "83c005", "ADDL $0x5, AX"},
+	{"ADDQ $0x5, AX", 64, "4883c005", "ADDQ $0x5, AX"},
+	{"ADDW $0x5, AX", 64, "66050500", "ADDW $0x5, AX"},
+	{"ADDL $0x5, 0x10(AX)(CX*4)", 64, "8344881005", "ADDL $0x5, 0x10(AX)(CX*4)"},
+	{"MOVL (BX), AX", 64, "8b03", "MOVL 0(BX), AX"},
+	{"FS MOVQ FS:0x28, AX", 64, "64488b042528000000", "FS MOVQ FS:0x28, AX"},
+	{"MOVL 0(BX)(SI*1), AX", 16, "668b00", "MOVL 0(BX)(SI*1), AX"},
+	{"ADDL 0(CX), DL", 64, "0211", "ADDL 0(CX), DL"},
+	{"ADDL 0(CX), R8", 64, "440301", "ADDL 0(CX), R8"},
+	{"MOVZX SP, AX", 64, "0fb7c4", "MOVZX SP, AX"},
+	{"LOCK XADDL AX, 0(DI)", 64, "f00fc107", "LOCK XADDL AX, 0(DI)"},
+	{"REP MOVSB DS:0(SI), ES:0(DI)", 64, "f3a4", "REP MOVSB DS:0(SI), ES:0(DI)"},
+	{"JE .+16", 64, "7410", "JE .+16"},
+	{"JMP .-2", 64, "ebfe", "JMP .-2"},
+	{"VADDPS.Z Z3, Z2, K1, Z1", 64, "62f16cc958cb", "VADDPS.Z Z3, Z2, K1, Z1"},
+	{"VADDPS.RZ_SAE Z3, Z2, Z1", 64, "62f16c7858cb", "VADDPS.RZ_SAE Z3, Z2, Z1"},
+	{"VADDPS.BCST 0(AX), Z2, Z1", 64, "62f16c585808", "VADDPS.BCST 0(AX), Z2, Z1"},
+	{"KMOVW K2, K1", 64, "c5f890ca", "KMOVW K2, K1"},
+	{"NOPL // padding", 64, "90", "NOPL"},
+	{"MOVL $-0x1, 0(AX)", 64, "c700ffffffff", "MOVL $-0x1, 0(AX)"},
+	{"MOVL $0xffffffff, 0(AX)", 64, "c700ffffffff", "MOVL $-0x1, 0(AX)"},
+	{"MOVW $0xffffaccc, BX", 32, "66bbccac", "MOVW $0xffffaccc, BX"},
+	{"MOVW $0xaccc, BX", 64, "66bbccac", "MOVW $-0x5334, BX"},
+	{"MOVW $-1, 0(AX)", 64, "66c700ffff", "MOVW $-0x1, 0(AX)"},
+	{"MOVB $-1, 0(AX)", 64, "c600ff", "MOVL $0xff, 0(AX)"},
+	{"FS ANDL $-0x36, FS:0x50(SI)", 16, "6664836450ca", "FS ANDL $-0x36, FS:0x50(SI)"},
+	{"ANDL $-0x36, 0x50(SI)", 64, "836650ca", "ANDL $-0x36, 0x50(SI)"},
+}
+
+func TestParseGoInput(t *testing.T) {
+	for _, tt := range parseGoTests {
+		inst, err := ParseGo(tt.text, tt.mode)
+		if err != nil {
+			t.Errorf("ParseGo(%q, %d): %v", tt.text, tt.mode, err)
+			continue
+		}
+		e := Encoder{Exact: true}
+		code, err := e.Encode(inst)
+		if err != nil {
+			t.Errorf("ParseGo(%q, %d) = %v: %v", tt.text, tt.mode, inst, err)
+			continue
+		}
+		if out := GoSyntax(inst, 0, nil); hex.EncodeToString(code) != tt.code || out != tt.out {
+			t.Errorf("ParseGo(%q, %d) = %x %s, want %s %s", tt.text, tt.mode, code, out, tt.code, tt.out)
+		}
+	}
+}
+
+func TestParseGoError(t *testing.T) {
+	for _, text := range []string{
+		"",
+		"LOCK",
+		"FROB AX",
+		"movl AX, BX",
+		"MOVL 0(BX, AX",
+		"MOVL 0(BX)(AX*3), AX",
+		"MOVL $0x123456789, AX",
+		"MOVL runtime·morestack(SB), AX",
+		"VADDPS.FOO Z3, Z2, Z1",
+	} {
+		if inst, err := ParseGo(text, 64); err == nil {
+			t.Errorf("ParseGo(%q, 64) = %s, want error", text, GoSyntax(inst, 0, nil))
+		}
+	}
+	if _, err := ParseGo("NOP", 8); err != ErrInvalidMode {
+		t.Errorf("ParseGo with mode 8: %v, want ErrInvalidMode", err)
+	}
+}
@@ -2,7 +2,7 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Package x86asm implements decoding of x86 machine code.
+// Package x86asm implements decoding and encoding of x86 machine code.
 package x86asm
 
 import (
@@ -19,10 +19,29 @@ type Inst struct {
 	Mode     int      // processor mode in bits: 16, 32, or 64
 	AddrSize int      // address size in bits: 16, 32, or 64
 	DataSize int      // operand size in bits: 16, 32, or 64
-	MemBytes int      // size of memory argument in bytes: 1, 2, 4, 8, 16, and so on.
+	MemBytes int      // size of memory argument in bytes: 1, 2, 4, 8, 16, 32, 64, and so on.
 	Len      int      // length of encoded instruction in bytes
 	PCRel int // length of PC-relative address in instruction encoding
 	PCRelOff int // index of start of PC-relative address in instruction encoding
+
+	// EVEX operand decorations. The opmask applies to Args[0], and the
+	// broadcast applies to the memory argument, in which case MemBytes
+	// is the size of the single element being broadcast.
+	Mask  Reg      // opmask register K1-K7 ({k1}), or 0 for no masking
+	Zero  bool     // zeroing-masking ({z}) instead of merging-masking
+	Bcst  int      // number of elements the memory argument is broadcast to ({1toN}), or 0
+	Round Rounding // embedded rounding control or exception suppression ({rn-sae}, {sae})
+
+	Features FeatureSet // CPUID features the instruction requires
+
+	Access     [4]Access // how the instruction accesses each of Args
+	accessForm uint16    // index of implicit register accesses in accessForms
+	flagForm   uint16    // index of EFLAGS effects in flagForms
+
+	// Encoding bits that Decode reads but that do not otherwise
+	// show in the instruction, for Encoder.Exact to reproduce.
+	vex [3]byte // VEX or EVEX payload following the prefix byte
+	is4 uint8   // imm8 holding a register number in bits 7:4
 }
 
 // Prefixes is an array of prefixes associated with a single instruction.
@@ -77,6 +96,14 @@ const (
 	PrefixREXR Prefix = 0x04 // extension bit R (r field in modrm)
 	PrefixREXX Prefix = 0x02 // extension bit X (index field in sib)
 	PrefixREXB Prefix = 0x01 // extension bit B (r/m field in modrm or base field in sib)
+
+	// The VEX prefixes replace REX and the mandatory prefixes
+	// for AVX instructions, and the EVEX prefix does the same
+	// for AVX-512 instructions. The payload bytes that follow the
+	// prefix byte have no corresponding entries in Prefixes.
+	PrefixVEX2 Prefix = 0xC5 // two-byte VEX prefix
+	PrefixVEX3 Prefix = 0xC4 // three-byte VEX prefix
+	PrefixEVEX Prefix = 0x62 // four-byte EVEX prefix
 )
 
 // IsREX reports whether p is a REX prefix byte.
@@ -84,6 +111,11 @@ func (p Prefix) IsREX() bool {
 	return p&0xF0 == PrefixREX
 }
 
+// IsVEX reports whether p is a VEX or EVEX prefix byte.
+func (p Prefix) IsVEX() bool {
+	return p&0xFF == PrefixVEX2 || p&0xFF == PrefixVEX3 || p&0xFF == PrefixEVEX
+}
+
 func (p Prefix) String() string {
 	p &^= PrefixImplicit | PrefixIgnored | PrefixInvalid
 	if s := prefixNames[p]; s != "" {
@@ -121,6 +153,54 @@ func (op Op) String() string {
 	return opNames[i]
 }
 
+// A Feature is a CPUID feature flag, such as SSE2 or AVX2,
+// that the processor must report for an instruction to be available.
+type Feature uint8
+
+func (f Feature) String() string {
+	i := int(f)
+	if i < 0 || i >= len(featureNames) || featureNames[i] == "" {
+		return fmt.Sprintf("Feature(%d)", i)
+	}
+	return featureNames[i]
+}
+
+// A FeatureSet is a set of CPUID features.
+//
+// An instruction's FeatureSet lists features that are all required;
+// it cannot say that any one of several features will do.
+// XTEST, which runs on processors with either HLE or RTM,
+// is recorded as requiring RTM, and there is no Feature for HLE.
+type FeatureSet uint64
+
+// NewFeatureSet returns the set containing the given features.
+func NewFeatureSet(features ...Feature) FeatureSet {
+	var s FeatureSet
+	for _, f := range features {
+		s |= 1 << f
+	}
+	return s
+}
+
+// Has reports whether s contains the feature f.
+func (s FeatureSet) Has(f Feature) bool {
+	return s&(1<<f) != 0
+}
+
+// String returns the names of the features in s, separated by commas.
+func (s FeatureSet) String() string {
+	var buf bytes.Buffer
+	for f := Feature(1); f <= maxFeature; f++ {
+		if s.Has(f) {
+			if buf.Len() > 0 {
+				buf.WriteString(",")
+			}
+			buf.WriteString(f.String())
+		}
+	}
+	return buf.String()
+}
+
 // An Args holds the instruction arguments.
 // If an instruction has fewer than 4 arguments,
 // the final elements in the array are nil.
@@ -139,7 +219,7 @@ type Arg interface {
 
 // A Reg is a single register.
 // The zero Reg value has no name but indicates ``no register.''
-type Reg uint8
+type Reg uint16
 
 const (
 	_ Reg = iota
@@ -262,6 +342,100 @@ const (
 	X13
 	X14
 	X15
+	X16
+	X17
+	X18
+	X19
+	X20
+	X21
+	X22
+	X23
+	X24
+	X25
+	X26
+	X27
+	X28
+	X29
+	X30
+	X31
+
+	// YMM registers.
+	Y0
+	Y1
+	Y2
+	Y3
+	Y4
+	Y5
+	Y6
+	Y7
+	Y8
+	Y9
+	Y10
+	Y11
+	Y12
+	Y13
+	Y14
+	Y15
+	Y16
+	Y17
+	Y18
+	Y19
+	Y20
+	Y21
+	Y22
+	Y23
+	Y24
+	Y25
+	Y26
+	Y27
+	Y28
+	Y29
+	Y30
+	Y31
+
+	// ZMM registers.
+	Z0
+	Z1
+	Z2
+	Z3
+	Z4
+	Z5
+	Z6
+	Z7
+	Z8
+	Z9
+	Z10
+	Z11
+	Z12
+	Z13
+	Z14
+	Z15
+	Z16
+	Z17
+	Z18
+	Z19
+	Z20
+	Z21
+	Z22
+	Z23
+	Z24
+	Z25
+	Z26
+	Z27
+	Z28
+	Z29
+	Z30
+	Z31
+
+	// Opmask registers.
+	K0
+	K1
+	K2
+	K3
+	K4
+	K5
+	K6
+	K7
 
 	// Segment registers.
 	ES
@@ -370,6 +544,347 @@ func (m Mem) String() string {
 	return "[" + base + plus + scale + index + disp + "]"
 }
 
+// A Rounding is an EVEX embedded rounding mode.
+// Each rounding mode also suppresses floating-point exceptions,
+// and RoundSAE suppresses exceptions without overriding the rounding
+// mode in MXCSR.
+type Rounding uint8
+
+const (
+	_            Rounding = iota
+	RoundNearest          // round to nearest even ({rn-sae})
+	RoundDown             // round toward negative infinity ({rd-sae})
+	RoundUp               // round toward positive infinity ({ru-sae})
+	RoundZero             // round toward zero ({rz-sae})
+	RoundSAE              // suppress all exceptions ({sae})
+)
+
+var roundingNames = [...]string{
+	RoundNearest: "rn-sae",
+	RoundDown:    "rd-sae",
+	RoundUp:      "ru-sae",
+	RoundZero:    "rz-sae",
+	RoundSAE:     "sae",
+}
+
+func (r Rounding) String() string {
+	i := int(r)
+	if i < 0 || i >= len(roundingNames) || roundingNames[i] == "" {
+		return fmt.Sprintf("Rounding(%d)", i)
+	}
+	return roundingNames[i]
+}
+
+// An Access describes how an instruction uses an operand.
+type Access uint8
+
+const (
+	AccessRead      Access = 1 << iota // operand is read
+	AccessWrite                        // operand is written
+	AccessReadWrite = AccessRead | AccessWrite
+)
+
+func (a Access) String() string {
+	switch a {
+	case 0:
+		return "-"
+	case AccessRead:
+		return "r"
+	case AccessWrite:
+		return "w"
+	case AccessReadWrite:
+		return "rw"
+	}
+	return fmt.Sprintf("Access(%d)", int(a))
+}
+
+// A RegAccess is a register accessed by an instruction.
+type RegAccess struct {
+	Reg    Reg
+	Access Access
+}
+
+// An accessForm records the operand accesses for an instruction form.
+// The decoding tables index a list of them.
+type accessForm struct {
+	args     [4]Access
+	implicit []implicitReg
+}
+
+// An implicitReg is a register an instruction accesses
+// without listing it in Args.
+type implicitReg struct {
+	reg    Reg
+	access Access
+	size   regSize
+}
+
+// A regSize says how to size an implicit register.
+type regSize uint8
+
+const (
+	sizeFixed regSize = iota // reg as given
+	sizeMode                 // 64-bit reg resized to the processor mode (stack pointer)
+	sizeAddr                 // 64-bit reg resized to the address size
+)
+
+// Implicit returns the registers the instruction reads or writes
+// without listing them in Args, such as RDX for MUL,
+// the stack pointer for PUSH, or RSI, RDI, and RCX for REP MOVSB.
+// Registers used by a memory argument are not included.
+func (inst *Inst) Implicit() []RegAccess {
+	var regs []RegAccess
+	for _, r := range accessForms[inst.accessForm].implicit {
+		reg := r.reg
+		switch r.size {
+		case sizeMode:
+			reg = baseRegForBits(inst.Mode) + reg - RAX
+		case sizeAddr:
+			reg = baseRegForBits(inst.AddrSize) + reg - RAX
+		}
+		regs = append(regs, RegAccess{reg, r.access})
+	}
+	if inst.isRepString() {
+		regs = append(regs, RegAccess{baseRegForBits(inst.AddrSize) + CX - AX, AccessReadWrite})
+	}
+	return regs
+}
+
+// isRepString reports whether inst is a string instruction
+// repeated by a REP or REPN prefix.
+func (inst *Inst) isRepString() bool {
+	switch inst.Op {
+	case INSB, INSW, INSD,
+		MOVSB, MOVSW, MOVSD, MOVSQ,
+		OUTSB, OUTSW, OUTSD,
+		LODSB, LODSW, LODSD, LODSQ,
+		CMPSB, CMPSW, CMPSD, CMPSQ,
+		SCASB, SCASW, SCASD, SCASQ,
+		STOSB, STOSW, STOSD, STOSQ:
+	default:
+		return false
+	}
+	for _, p := range inst.Prefix {
+		if p == 0 {
+			break
+		}
+		if p&PrefixIgnored == 0 && (p&0xFF == PrefixREP || p&0xFF == PrefixREPN) {
+			return true
+		}
+	}
+	return false
+}
+
+// Regs returns the registers the instruction reads and writes,
+// whether as explicit arguments, in memory address calculations,
+// or implicitly. A register that is both read and written
+// appears in both lists.
+func (inst *Inst) Regs() (read, write []Reg) {
+	add := func(list []Reg, r Reg) []Reg {
+		for _, x := range list {
+			if x == r {
+				return list
+			}
+		}
+		return append(list, r)
+	}
+	use := func(r Reg, a Access) {
+		if r == 0 {
+			return
+		}
+		if a&AccessRead != 0 {
+			read = add(read, r)
+		}
+		if a&AccessWrite != 0 {
+			write = add(write, r)
+		}
+	}
+	for i, arg := range inst.Args {
+		switch arg := arg.(type) {
+		case Reg:
+			use(arg, inst.Access[i])
+		case Mem:
+			use(arg.Segment, AccessRead)
+			use(arg.Base, AccessRead)
+			use(arg.Index, AccessRead)
+		}
+	}
+	if inst.Mask != 0 {
+		// Gathers and scatters clear each mask bit
+		// as they complete the corresponding element.
+		switch inst.Op {
+		case VGATHERDPD, VGATHERDPS, VGATHERQPD, VGATHERQPS,
+			VPGATHERDD, VPGATHERDQ, VPGATHERQD, VPGATHERQQ,
+			VPSCATTERDD, VPSCATTERDQ, VPSCATTERQD, VPSCATTERQQ,
+			VSCATTERDPD, VSCATTERDPS, VSCATTERQPD, VSCATTERQPS:
+			use(inst.Mask, AccessReadWrite)
+		default:
+			use(inst.Mask, AccessRead)
+		}
+	}
+	for _, r := range inst.Implicit() {
+		use(r.Reg, r.Access)
+	}
+	return read, write
+}
+
+// A Flags is a set of arithmetic flags in the EFLAGS register.
+type Flags uint8
+
+const (
+	FlagCF Flags = 1 << iota // carry
+	FlagPF                   // parity
+	FlagAF                   // auxiliary carry
+	FlagZF                   // zero
+	FlagSF                   // sign
+	FlagOF                   // overflow
+	FlagDF                   // direction
+
+	// FlagStatus is the set of status flags written by arithmetic instructions.
+	FlagStatus = FlagCF | FlagPF | FlagAF | FlagZF | FlagSF | FlagOF
+)
+
+var flagNames = [...]string{"CF", "PF", "AF", "ZF", "SF", "OF", "DF"}
+
+func (f Flags) String() string {
+	var buf bytes.Buffer
+	for i, name := range flagNames {
+		if f&(1<<uint(i)) != 0 {
+			if buf.Len() > 0 {
+				buf.WriteString("|")
+			}
+			buf.WriteString(name)
+		}
+	}
+	return buf.String()
+}
+
+// FlagEffects describes how an instruction uses the arithmetic flags.
+// The written flags are those in Set, Cleared, or Undefined.
+// Like the manual, FlagEffects describes the general case: a shift or
+// rotate by a count of zero leaves all flags unchanged, and a string
+// instruction with a REP prefix and a zero count does nothing.
+type FlagEffects struct {
+	Tested    Flags // flags read by the instruction
+	Set       Flags // flags set according to the result, or to 1
+	Cleared   Flags // flags set to 0
+	Undefined Flags // flags left with an undefined value
+}
+
+// Written returns the flags the instruction writes.
+func (e FlagEffects) Written() Flags {
+	return e.Set | e.Cleared | e.Undefined
+}
+
+// Flags returns the effects of the instruction on the arithmetic flags.
+func (inst *Inst) Flags() FlagEffects {
+	return flagForms[inst.flagForm]
+}
+
+// A Control describes how an instruction transfers control.
+// An instruction not listed in the comments below has Control 0
+// and always continues with the next instruction.
+type Control uint8
+
+const (
+	ControlJump       Control = 1 << iota // unconditional jump: JMP, LJMP
+	ControlCond                           // conditional jump: Jcc, LOOP, JCXZ, XBEGIN
+	ControlCall                           // call: CALL, LCALL
+	ControlReturn                         // return: RET, LRET, IRET, SYSRET, SYSEXIT, RSM
+	ControlIndirect                       // jump or call to a target computed at run time
+	ControlTrap                           // transfer to the operating system: INT, SYSCALL, UD2
+	ControlTerminator                     // never continues with the next instruction
+)
+
+var controlNames = [...]string{"jump", "cond", "call", "return", "indirect", "trap", "terminator"}
+
+func (c Control) String() string {
+	var buf bytes.Buffer
+	for i, name := range controlNames {
+		if c&(1<<uint(i)) != 0 {
+			if buf.Len() > 0 {
+				buf.WriteString("|")
+			}
+			buf.WriteString(name)
+		}
+	}
+	return buf.String()
+}
+
+// Control returns the way the instruction transfers control.
+func (inst *Inst) Control() Control {
+	switch inst.Op {
+	case JMP, LJMP:
+		return ControlJump | ControlTerminator | inst.indirect()
+	case CALL, LCALL:
+		return ControlCall | inst.indirect()
+	case JCXZ, JECXZ, JRCXZ, LOOP, LOOPE, LOOPNE, XBEGIN:
+		return ControlCond
+	case RET, LRET, IRET, IRETD, IRETQ, SYSRET, SYSEXIT, RSM:
+		return ControlReturn | ControlTerminator
+	case INT, INTO, ICEBP, SYSCALL, SYSENTER:
+		return ControlTrap
+	case UD1, UD2:
+		return ControlTrap | ControlTerminator
+	case HLT:
+		return ControlTerminator
+	}
+	if isCondJmp[inst.Op] {
+		return ControlCond
+	}
+	return 0
+}
+
+// indirect returns ControlIndirect if the jump or call
+// inst takes its target from a register or memory.
+func (inst *Inst) indirect() Control {
+	switch inst.Args[0].(type) {
+	case Reg, Mem:
+		return ControlIndirect
+	}
+	return 0
+}
+
+// Target returns the absolute address denoted by the instruction's
+// PC-relative argument, given the address pc of the instruction:
+// the branch target for a Rel argument, or the memory address for
+// a RIP-relative Mem argument. The second result is false if the
+// instruction has no such argument.
+//
+// The branch target wraps around at the operand size, as the
+// instruction pointer does: in 16- and 32-bit mode, a branch with
+// a 16-bit operand size truncates the target to 16 bits. In 64-bit
+// mode, Intel processors ignore the operand size, while AMD
+// processors truncate the target when the operand-size prefix
+// gives the branch a 16-bit displacement (see Decoder.Vendor).
+func (inst *Inst) Target(pc uint64) (uint64, bool) {
+	next := pc + uint64(inst.Len)
+	for _, arg := range inst.Args {
+		switch a := arg.(type) {
+		case Rel:
+			addr := next + uint64(int64(a))
+			switch {
+			case inst.Mode != 64 && inst.DataSize == 32:
+				addr = uint64(uint32(addr))
+			case inst.Mode != 64 && inst.DataSize == 16,
+				inst.Mode == 64 && inst.PCRel == 2:
+				addr = uint64(uint16(addr))
+			}
+			return addr, true
+		case Mem:
+			// The decoder records the 32-bit displacement unextended.
+			disp := uint64(int64(int32(a.Disp)))
+			switch a.Base {
+			case RIP:
+				return next + disp, true
+			case EIP:
+				return uint64(uint32(next + disp)), true
+			}
+		}
+	}
+	return 0, false
+}
+
 // A Rel is an offset relative to the current instruction pointer.
 type Rel int32
 
@@ -401,13 +916,25 @@ func (i Inst) String() string {
 	}
 	fmt.Fprintf(&buf, "%v", i.Op)
 	sep := " "
-	for _, v := range i.Args {
+	for j, v := range i.Args {
 		if v == nil {
 			break
 		}
 		fmt.Fprintf(&buf, "%s%v", sep, v)
+		if j == 0 && i.Mask != 0 {
+			fmt.Fprintf(&buf, "{%v}", i.Mask)
+			if i.Zero {
+				buf.WriteString("{z}")
+			}
+		}
+		if i.Bcst != 0 && isMem(v) {
+			fmt.Fprintf(&buf, "{1to%d}", i.Bcst)
+		}
 		sep = ", "
 	}
+	if i.Round != 0 {
+		fmt.Fprintf(&buf, "%s{%v}", sep, i.Round)
+	}
 	return buf.String()
 }
 
@@ -448,6 +975,18 @@ func regBytes(a Arg) int {
 	if RAX <= r && r <= R15 {
 		return 8
 	}
+	if M0 <= r && r <= M7 || K0 <= r && r <= K7 {
+		return 8
+	}
+	if X0 <= r && r <= X31 {
+		return 16
+	}
+	if Y0 <= r && r <= Y31 {
+		return 32
+	}
+	if Z0 <= r && r <= Z31 {
+		return 64
+	}
 	return 0
 }
 
@@ -483,6 +1022,9 @@ var prefixNames = map[Prefix]string{
 	PrefixREX:      "REX",
 	PrefixPT:       "PT",
 	PrefixPN:       "PN",
+	PrefixVEX2:     "VEX2",
+	PrefixVEX3:     "VEX3",
+	PrefixEVEX:     "EVEX",
 }
 
 var regNames = [...]string{
@@ -589,6 +1131,94 @@ var regNames = [...]string{
 	X13:  "X13",
 	X14:  "X14",
 	X15:  "X15",
+	X16:  "X16",
+	X17:  "X17",
+	X18:  "X18",
+	X19:  "X19",
+	X20:  "X20",
+	X21:  "X21",
+	X22:  "X22",
+	X23:  "X23",
+	X24:  "X24",
+	X25:  "X25",
+	X26:  "X26",
+	X27:  "X27",
+	X28:  "X28",
+	X29:  "X29",
+	X30:  "X30",
+	X31:  "X31",
+	Y0:   "Y0",
+	Y1:   "Y1",
+	Y2:   "Y2",
+	Y3:   "Y3",
+	Y4:   "Y4",
+	Y5:   "Y5",
+	Y6:   "Y6",
+	Y7:   "Y7",
+	Y8:   "Y8",
+	Y9:   "Y9",
+	Y10:  "Y10",
+	Y11:  "Y11",
+	Y12:  "Y12",
+	Y13:  "Y13",
+	Y14:  "Y14",
+	Y15:  "Y15",
+	Y16:  "Y16",
+	Y17:  "Y17",
+	Y18:  "Y18",
+	Y19:  "Y19",
+	Y20:  "Y20",
+	Y21:  "Y21",
+	Y22:  "Y22",
+	Y23:  "Y23",
+	Y24:  "Y24",
+	Y25:  "Y25",
+	Y26:  "Y26",
+	Y27:  "Y27",
+	Y28:  "Y28",
+	Y29:  "Y29",
+	Y30:  "Y30",
+	Y31:  "Y31",
+	Z0:   "Z0",
+	Z1:   "Z1",
+	Z2:   "Z2",
+	Z3:   "Z3",
+	Z4:   "Z4",
+	Z5:   "Z5",
+	Z6:   "Z6",
+	Z7:   "Z7",
+	Z8:   "Z8",
+	Z9:   "Z9",
+	Z10:  "Z10",
+	Z11:  "Z11",
+	Z12:  "Z12",
+	Z13:  "Z13",
+	Z14:  "Z14",
+	Z15:  "Z15",
+	Z16:  "Z16",
+	Z17:  "Z17",
+	Z18:  "Z18",
+	Z19:  "Z19",
+	Z20:  "Z20",
+	Z21:  "Z21",
+	Z22:  "Z22",
+	Z23:  "Z23",
+	Z24:  "Z24",
+	Z25:  "Z25",
+	Z26:  "Z26",
+	Z27:  "Z27",
+	Z28:  "Z28",
+	Z29:  "Z29",
+	Z30:  "Z30",
+	Z31:  "Z31",
+	K0:   "K0",
+	K1:   "K1",
+	K2:   "K2",
+	K3:   "K3",
+	K4:   "K4",
+	K5:   "K5",
+	K6:   "K6",
+	K7:   "K7",
 	CS:   "CS",
 	SS:   "SS",
 	DS:   "DS",
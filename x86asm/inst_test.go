@@ -5,6 +5,7 @@
 package x86asm
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 )
@@ -18,3 +19,166 @@ func TestRegString(t *testing.T) {
 		}
 	}
 }
+
+var accessTests = []struct {
+	mode     int
+	code     string
+	access   string // access of each argument
+	implicit string // implicit registers
+	read     string
+	write    string
+}{
+	{64, "4801d8", "rw r", "", "RAX RBX", "RAX"},
+	{64, "f7e1", "r", "EAX:rw EDX:w", "ECX EAX", "EAX EDX"},
+	{64, "f6e9", "r", "AL:r AX:w", "CL AL", "AX"},
+	{64, "99", "", "EAX:r EDX:w", "EAX", "EDX"},
+	{64, "0fa2", "", "EAX:rw ECX:rw EBX:w EDX:w", "EAX ECX", "EAX ECX EBX EDX"},
+	{64, "50", "r", "RSP:rw", "RAX RSP", "RSP"},
+	{32, "50", "r", "ESP:rw", "EAX ESP", "ESP"},
+	{64, "c9", "", "RSP:rw RBP:rw", "RSP RBP", "RSP RBP"},
+	{64, "a4", "w r", "RSI:rw RDI:rw", "ES RDI DS RSI", "RSI RDI"},
+	{64, "f3a4", "w r", "RSI:rw RDI:rw RCX:rw", "ES RDI DS RSI RCX", "RSI RDI RCX"},
+	{64, "67f3a4", "w r", "ESI:rw EDI:rw ECX:rw", "ES EDI DS ESI ECX", "ESI EDI ECX"},
+	{64, "488d0400", "w -", "", "RAX", "RAX"},
+	{64, "f00fb10b", "rw r", "EAX:rw", "RBX ECX EAX", "EAX"},
+	{64, "90", "", "", "", ""},
+	{64, "f390", "", "", "", ""},
+	{64, "d8c1", "rw r", "", "F0 F1", "F0"},
+	{64, "62f17c4910c1", "rw r", "", "Z0 Z1 K1", "Z0"},
+	{64, "62f17cc910c1", "w r", "", "Z1 K1", "Z0"},
+	{64, "62f27d4990040d00000000", "rw r", "", "Z0 Z1 K1", "Z0 K1"},
+}
+
+func TestAccess(t *testing.T) {
+	for _, tt := range accessTests {
+		inst, ok := mustDecodeHex(t, Decoder{Mode: tt.mode}, tt.code)
+		if !ok {
+			continue
+		}
+		var access, implicit []string
+		for i, arg := range inst.Args {
+			if arg != nil {
+				access = append(access, inst.Access[i].String())
+			}
+		}
+		for _, r := range inst.Implicit() {
+			implicit = append(implicit, fmt.Sprintf("%v:%v", r.Reg, r.Access))
+		}
+		read, write := inst.Regs()
+		if a, i, r, w := strings.Join(access, " "), strings.Join(implicit, " "), regList(read), regList(write); a != tt.access || i != tt.implicit || r != tt.read || w != tt.write {
+			t.Errorf("%d %s %v:\nhave access %q implicit %q read %q write %q\nwant access %q implicit %q read %q write %q",
+				tt.mode, tt.code, inst, a, i, r, w, tt.access, tt.implicit, tt.read, tt.write)
+		}
+	}
+}
+
+func regList(regs []Reg) string {
+	var s []string
+	for _, r := range regs {
+		s = append(s, r.String())
+	}
+	return strings.Join(s, " ")
+}
+
+var flagTests = []struct {
+	code      string
+	tested    Flags
+	set       Flags
+	cleared   Flags
+	undefined Flags
+}{
+	{"4801d8", 0, FlagStatus, 0, 0},
+	{"4811d8", FlagCF, FlagStatus, 0, 0},
+	{"ffc0", 0, FlagStatus &^ FlagCF, 0, 0},
+	{"31c0", 0, FlagPF | FlagZF | FlagSF, FlagCF | FlagOF, FlagAF},
+	{"f7e1", 0, FlagCF | FlagOF, 0, FlagPF | FlagAF | FlagZF | FlagSF},
+	{"d1e0", 0, FlagStatus &^ FlagAF, 0, FlagAF},
+	{"d3e0", 0, FlagCF | FlagPF | FlagZF | FlagSF, 0, FlagAF | FlagOF},
+	{"7500", FlagZF, 0, 0, 0},
+	{"0f46c1", FlagCF | FlagZF, 0, 0, 0},
+	{"0f9fc0", FlagZF | FlagSF | FlagOF, 0, 0, 0},
+	{"f9", 0, FlagCF, 0, 0},
+	{"fc", 0, 0, FlagDF, 0},
+	{"f3a4", FlagDF, 0, 0, 0},
+	{"9c", FlagStatus | FlagDF, 0, 0, 0},
+	{"9d", 0, FlagStatus | FlagDF, 0, 0},
+	{"0f2fc1", 0, FlagCF | FlagPF | FlagZF, FlagAF | FlagSF | FlagOF, 0},
+	{"89c0", 0, 0, 0, 0},
+	{"90", 0, 0, 0, 0},
+}
+
+func TestFlags(t *testing.T) {
+	for _, tt := range flagTests {
+		inst, ok := mustDecodeHex(t, Decoder{Mode: 64}, tt.code)
+		if !ok {
+			continue
+		}
+		want := FlagEffects{tt.tested, tt.set, tt.cleared, tt.undefined}
+		if have := inst.Flags(); have != want {
+			t.Errorf("%s %v: Flags() = %+v, want %+v", tt.code, inst, have, want)
+		}
+	}
+}
+
+func TestFlagsString(t *testing.T) {
+	if s := (FlagCF | FlagZF | FlagDF).String(); s != "CF|ZF|DF" {
+		t.Errorf("Flags.String() = %q, want %q", s, "CF|ZF|DF")
+	}
+}
+
+var controlTests = []struct {
+	d       Decoder
+	code    string
+	control Control
+	target  uint64
+	ok      bool
+}{
+	{Decoder{Mode: 64}, "e910000000", ControlJump | ControlTerminator, 0x1015, true},
+	{Decoder{Mode: 64}, "ebfe", ControlJump | ControlTerminator, 0x1000, true},
+	{Decoder{Mode: 64}, "ffe0", ControlJump | ControlTerminator | ControlIndirect, 0, false},
+	{Decoder{Mode: 64}, "ff2510000000", ControlJump | ControlTerminator | ControlIndirect, 0x1016, true},
+	{Decoder{Mode: 64}, "67ff2510000000", ControlJump | ControlTerminator | ControlIndirect, 0x1017, true},
+	{Decoder{Mode: 64}, "7410", ControlCond, 0x1012, true},
+	{Decoder{Mode: 64}, "0f8400f0ffff", ControlCond, 0x6, true},
+	{Decoder{Mode: 64}, "e2fe", ControlCond, 0x1000, true},
+	{Decoder{Mode: 64}, "e310", ControlCond, 0x1012, true},
+	{Decoder{Mode: 64}, "e8f0efffff", ControlCall, 0xfffffffffffffff5, true},
+	{Decoder{Mode: 64}, "ff15f0ffffff", ControlCall | ControlIndirect, 0xff6, true},
+	{Decoder{Mode: 64}, "c3", ControlReturn | ControlTerminator, 0, false},
+	{Decoder{Mode: 64}, "48cf", ControlReturn | ControlTerminator, 0, false},
+	{Decoder{Mode: 64}, "0f05", ControlTrap, 0, false},
+	{Decoder{Mode: 64}, "cd80", ControlTrap, 0, false},
+	{Decoder{Mode: 64}, "0f0b", ControlTrap | ControlTerminator, 0, false},
+	{Decoder{Mode: 64}, "f4", ControlTerminator, 0, false},
+	{Decoder{Mode: 64}, "488d0510000000", 0, 0x1017, true},
+	{Decoder{Mode: 64}, "01c0", 0, 0, false},
+	{Decoder{Mode: 32}, "e8f0efffff", ControlCall, 0xfffffff5, true},
+	{Decoder{Mode: 32}, "66e9f0ef", ControlJump | ControlTerminator, 0xfff4, true},
+	{Decoder{Mode: 32}, "ea112233445566", ControlJump | ControlTerminator, 0, false},
+	{Decoder{Mode: 16}, "e9f0ef", ControlJump | ControlTerminator, 0xfff3, true},
+	{Decoder{Mode: 16}, "66e9f0efffff", ControlJump | ControlTerminator, 0xfffffff6, true},
+	{Decoder{Mode: 64}, "66e9f0ef", 0, 0, false}, // truncated on Intel
+	{Decoder{Mode: 64, Vendor: VendorAMD}, "66e9f0ef", ControlJump | ControlTerminator, 0xfff4, true},
+	{Decoder{Mode: 64}, "66e811223344", ControlCall, 0x44333217, true},
+	{Decoder{Mode: 64, Vendor: VendorAMD}, "66e811223344", ControlCall, 0x3215, true},
+	{Decoder{Mode: 64, Vendor: VendorAMD}, "66ffe0", ControlJump | ControlTerminator | ControlIndirect, 0, false},
+}
+
+func TestControl(t *testing.T) {
+	const pc = 0x1000
+	for _, tt := range controlTests {
+		inst, err := decodeHex(t, tt.d, tt.code)
+		if err != nil {
+			if tt.control != 0 || tt.ok {
+				t.Errorf("decode %s: %v", tt.code, err)
+			}
+			continue
+		}
+		if c := inst.Control(); c != tt.control {
+			t.Errorf("%d %s %v: Control() = %v, want %v", tt.d.Mode, tt.code, inst, c, tt.control)
+		}
+		if target, ok := inst.Target(pc); target != tt.target || ok != tt.ok {
+			t.Errorf("%d %s %v: Target(%#x) = %#x, %v, want %#x, %v", tt.d.Mode, tt.code, inst, pc, target, ok, tt.target, tt.ok)
+		}
+	}
+}
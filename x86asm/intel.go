@@ -4,15 +4,29 @@
 
 package x86asm
 
-import (
-	"fmt"
-	"strings"
-)
+import "strconv"
 
 // IntelSyntax returns the Intel assembler syntax for the instruction, as defined by Intel's XED tool.
-func IntelSyntax(inst Inst) string {
-	var iargs []Arg
-	for _, a := range inst.Args {
+// If pc is not zero, it is the program counter of the instruction,
+// and branch targets and RIP-relative addresses are printed as absolute
+// addresses, named using symname if that is not nil.
+func IntelSyntax(inst Inst, pc uint64, symname SymLookup) string {
+	f := Formatter{Syntax: SyntaxIntel}
+	return f.Format(inst, pc, symname)
+}
+
+// AppendIntelSyntax appends the text that IntelSyntax returns
+// for the instruction to dst and returns the extended buffer.
+// It does not allocate memory except to grow dst.
+func AppendIntelSyntax(dst []byte, inst Inst, pc uint64, symname SymLookup) []byte {
+	f := Formatter{Syntax: SyntaxIntel}
+	return f.appendIntel(dst, inst, pc, symname)
+}
+
+func (f *Formatter) appendIntel(dst []byte, inst Inst, pc uint64, symname SymLookup) []byte {
+	argv := inst.Args
+	iargs := argv[:0]
+	for _, a := range argv {
 		if a == nil {
 			break
 		}
@@ -53,22 +67,6 @@ func IntelSyntax(inst Inst) string {
 		}
 	}
 
-	switch inst.Op {
-	case AAM, AAD:
-		if imm, ok := iargs[0].(Imm); ok {
-			if inst.DataSize == 32 {
-				iargs[0] = Imm(uint32(int8(imm)))
-			} else if inst.DataSize == 16 {
-				iargs[0] = Imm(uint16(int8(imm)))
-			}
-		}
-
-	case PUSH:
-		if imm, ok := iargs[0].(Imm); ok {
-			iargs[0] = Imm(uint32(imm))
-		}
-	}
-
 	for _, p := range inst.Prefix {
 		if p&PrefixImplicit != 0 {
 			for j, pj := range inst.Prefix {
@@ -155,7 +153,7 @@ func IntelSyntax(inst Inst) string {
 		haveBnd
 	)
 	var prefixBits uint32
-	prefix := ""
+	start := len(dst)
 	for _, p := range inst.Prefix {
 		if p == 0 {
 			break
@@ -168,13 +166,13 @@ func IntelSyntax(inst Inst) string {
 		}
 		switch p {
 		default:
-			prefix += strings.ToLower(p.String()) + " "
+			dst = append(appendLower(dst, p.String()), ' ')
 		case PrefixCS, PrefixDS, PrefixES, PrefixFS, PrefixGS, PrefixSS:
 			if inst.Op == 0 {
-				prefix += strings.ToLower(p.String()) + " "
+				dst = append(appendLower(dst, p.String()), ' ')
 			}
 		case PrefixREPN:
-			prefix += "repne "
+			dst = append(dst, "repne "...)
 		case PrefixLOCK:
 			prefixBits |= haveLock
 		case PrefixData16, PrefixDataSize:
@@ -207,49 +205,85 @@ func IntelSyntax(inst Inst) string {
 	}
 
 	if prefixBits&haveXacquire != 0 {
-		prefix += "xacquire "
+		dst = append(dst, "xacquire "...)
 	}
 	if prefixBits&haveXrelease != 0 {
-		prefix += "xrelease "
+		dst = append(dst, "xrelease "...)
 	}
 	if prefixBits&haveLock != 0 {
-		prefix += "lock "
+		dst = append(dst, "lock "...)
 	}
 	if prefixBits&haveBnd != 0 {
-		prefix += "bnd "
+		dst = append(dst, "bnd "...)
 	}
 	if prefixBits&haveHintTaken != 0 {
-		prefix += "hint-taken "
+		dst = append(dst, "hint-taken "...)
 	}
 	if prefixBits&haveHintNotTaken != 0 {
-		prefix += "hint-not-taken "
+		dst = append(dst, "hint-not-taken "...)
 	}
 	if prefixBits&haveAddr16 != 0 {
-		prefix += "addr16 "
+		dst = append(dst, "addr16 "...)
 	}
 	if prefixBits&haveAddr32 != 0 {
-		prefix += "addr32 "
+		dst = append(dst, "addr32 "...)
 	}
 	if prefixBits&haveData16 != 0 {
-		prefix += "data16 "
+		dst = append(dst, "data16 "...)
 	}
 	if prefixBits&haveData32 != 0 {
-		prefix += "data32 "
+		dst = append(dst, "data32 "...)
 	}
 
 	if inst.Op == 0 {
-		if prefix == "" {
-			return "<no instruction>"
+		if len(dst) == start {
+			return append(dst, "<no instruction>"...)
 		}
-		return prefix[:len(prefix)-1]
+		dst = dst[:len(dst)-1]
+		f.setCase(dst[start:])
+		return dst
 	}
 
-	var args []string
-	for _, a := range iargs {
+	var argBuf [256]byte
+	var args argList
+	buf := argBuf[:0]
+	for i, a := range iargs {
 		if a == nil {
 			break
 		}
-		args = append(args, intelArg(&inst, a))
+		n := len(buf)
+		buf = f.appendIntelArg(buf, &inst, pc, symname, a)
+		if i == 0 && inst.Mask != 0 {
+			buf = append(buf, '{')
+			buf = f.appendIntelArg(buf, &inst, pc, symname, inst.Mask)
+			buf = append(buf, '}')
+			if inst.Zero {
+				buf = f.appendName(buf, "{z}")
+			}
+		}
+		if inst.Bcst != 0 && isMem(a) {
+			buf = f.appendBcst(buf, inst.Bcst)
+		}
+		args.add(n, len(buf))
+	}
+
+	// EVEX rounding is written as an extra argument
+	// after the last register or memory argument.
+	if inst.Round != 0 {
+		i := args.n
+		for i > 0 && isImm(iargs[i-1]) {
+			i--
+		}
+		round := inst.Round.String()
+		if inst.Round == RoundNearest {
+			// XED, whose output IntelSyntax is tested against,
+			// spells round-to-nearest {rne-sae}, not {rn-sae}.
+			round = "rne-sae"
+		}
+		n := len(buf)
+		buf = append(buf, '{')
+		buf = append(f.appendName(buf, round), '}')
+		args.insert(i, n, len(buf))
 	}
 
 	var op string
@@ -257,44 +291,47 @@ func IntelSyntax(inst Inst) string {
 	case NOP:
 		if inst.Opcode>>24 == 0x0F {
 			if inst.DataSize == 16 {
-				args = append(args, "ax")
+				buf = args.addName(f, buf, "ax")
 			} else {
-				args = append(args, "eax")
+				buf = args.addName(f, buf, "eax")
 			}
 		}
 
 	case BLENDVPD, BLENDVPS, PBLENDVB:
-		args = args[:2]
+		args.n = 2
 
 	case INT:
 		if inst.Opcode>>24 == 0xCC {
-			args = nil
+			args.n = 0
 			op = "int3"
 		}
 
 	case LCALL, LJMP:
-		if len(args) == 2 {
-			args[0], args[1] = args[1], args[0]
+		if args.n == 2 {
+			args.span[0], args.span[1] = args.span[1], args.span[0]
 		}
 
 	case FCHS, FABS, FTST, FLDPI, FLDL2E, FLDLG2, F2XM1, FXAM, FLD1, FLDL2T, FSQRT, FRNDINT, FCOS, FSIN:
-		if len(args) == 0 {
-			args = append(args, "st0")
+		if args.n == 0 {
+			buf = args.addName(f, buf, "st0")
 		}
 
 	case FPTAN, FSINCOS, FUCOMPP, FCOMPP, FYL2X, FPATAN, FXTRACT, FPREM1, FPREM, FYL2XP1, FSCALE:
-		if len(args) == 0 {
-			args = []string{"st0", "st1"}
+		if args.n == 0 {
+			buf = args.addName(f, buf, "st0")
+			buf = args.addName(f, buf, "st1")
 		}
 
 	case FST, FSTP, FISTTP, FIST, FISTP, FBSTP:
-		if len(args) == 1 {
-			args = append(args, "st0")
+		if args.n == 1 {
+			buf = args.addName(f, buf, "st0")
 		}
 
 	case FLD, FXCH, FCOM, FCOMP, FIADD, FIMUL, FICOM, FICOMP, FISUBR, FIDIV, FUCOM, FUCOMP, FILD, FBLD, FADD, FMUL, FSUB, FSUBR, FISUB, FDIV, FDIVR, FIDIVR:
-		if len(args) == 1 {
-			args = []string{"st0", args[0]}
+		if args.n == 1 {
+			n := len(buf)
+			buf = f.appendName(buf, "st0")
+			args.insert(0, n, len(buf))
 		}
 
 	case MASKMOVDQU, MASKMOVQ, XLATB, OUTSB, OUTSW, OUTSD:
@@ -304,7 +341,9 @@ func IntelSyntax(inst Inst) string {
 			switch p {
 			case PrefixCS, PrefixES, PrefixFS, PrefixGS, PrefixSS:
 				if inst.Mode != 64 || p == PrefixFS || p == PrefixGS {
-					args = append(args, strings.ToLower((inst.Prefix[i] & 0xFF).String()))
+					n := len(buf)
+					buf = f.appendLowerName(buf, (inst.Prefix[i] & 0xFF).String())
+					args.add(n, len(buf))
 					break FixSegment
 				}
 			case PrefixDS:
@@ -319,25 +358,42 @@ func IntelSyntax(inst Inst) string {
 		op = intelOp[inst.Op]
 	}
 	if op == "" {
-		op = strings.ToLower(inst.Op.String())
+		dst = appendLower(dst, inst.Op.String())
+	} else {
+		dst = append(dst, op...)
 	}
-	if args != nil {
-		op += " " + strings.Join(args, ", ")
-	}
-	return prefix + op
+	return f.appendArgs(dst, start, buf, &args)
 }
 
-func intelArg(inst *Inst, arg Arg) string {
+func (f *Formatter) appendIntelArg(dst []byte, inst *Inst, pc uint64, symname SymLookup, arg Arg) []byte {
 	switch a := arg.(type) {
 	case Imm:
+		switch inst.Op {
+		case AAM, AAD:
+			if inst.DataSize == 32 {
+				a = Imm(uint32(int8(a)))
+			} else if inst.DataSize == 16 {
+				a = Imm(uint16(int8(a)))
+			}
+		case PUSH:
+			a = Imm(uint32(a))
+		}
 		if inst.Mode == 32 {
-			return fmt.Sprintf("%#x", uint32(a))
+			return f.appendImm(dst, a, 4)
 		}
 		if Imm(int32(a)) == a {
-			return fmt.Sprintf("%#x", int64(a))
+			return f.appendImm(dst, a, 0)
 		}
-		return fmt.Sprintf("%#x", uint64(a))
+		return f.appendImm(dst, a, 8)
 	case Mem:
+		var sym string
+		var symBase, symAddr uint64
+		if a.Base == RIP || a.Base == EIP {
+			if addr, ok := memTarget(inst, pc, a); ok {
+				sym, symBase = lookupSym(symname, addr)
+				symAddr = addr
+			}
+		}
 		if a.Base == EIP {
 			a.Base = RIP
 		}
@@ -353,6 +409,10 @@ func intelArg(inst *Inst, arg Arg) string {
 			prefix = "qword "
 		case 16:
 			prefix = "xmmword "
+		case 32:
+			prefix = "ymmword "
+		case 64:
+			prefix = "zmmword "
 		}
 		switch inst.Op {
 		case INVLPG:
@@ -407,37 +467,53 @@ func intelArg(inst *Inst, arg Arg) string {
 			a.Segment = 0
 		}
 
-		prefix += "ptr "
+		n := len(dst)
+		dst = append(dst, prefix...)
+		dst = append(dst, "ptr "...)
 		if a.Segment != 0 {
-			prefix += strings.ToLower(a.Segment.String()) + ":"
+			dst = append(appendLower(dst, a.Segment.String()), ':')
 		}
-		prefix += "["
+		f.setCase(dst[n:])
+		dst = append(dst, '[')
+		n = len(dst)
 		if a.Base != 0 {
-			prefix += intelArg(inst, a.Base)
+			dst = f.appendIntelArg(dst, inst, pc, symname, a.Base)
 		}
 		if a.Scale != 0 && a.Index != 0 {
 			if a.Base != 0 {
-				prefix += "+"
+				dst = append(dst, '+')
 			}
-			prefix += fmt.Sprintf("%s*%d", intelArg(inst, a.Index), a.Scale)
-		}
-		if a.Disp != 0 {
-			if prefix[len(prefix)-1] == '[' && (a.Disp >= 0 || int64(int32(a.Disp)) != a.Disp) {
-				prefix += fmt.Sprintf("%#x", uint64(a.Disp))
-			} else {
-				prefix += fmt.Sprintf("%+#x", a.Disp)
+			dst = append(f.appendIntelArg(dst, inst, pc, symname, a.Index), '*')
+			dst = strconv.AppendInt(dst, int64(a.Scale), 10)
+		}
+		if sym != "" {
+			dst = f.appendSym(append(dst, '+'), sym, symBase, symAddr)
+		} else if a.Disp != 0 {
+			switch {
+			case len(dst) != n:
+				dst = f.appendDispOffset(dst, inst, a.Disp)
+			case a.Disp >= 0 || int64(int32(a.Disp)) != a.Disp:
+				dst = f.appendHex(dst, uint64(a.Disp))
+			default:
+				dst = f.appendDisp(dst, inst, a.Disp)
 			}
 		}
-		prefix += "]"
-		return prefix
+		return append(dst, ']')
 	case Rel:
-		return fmt.Sprintf(".%+#x", int64(a))
+		if pc == 0 {
+			return f.appendOffset(append(dst, '.'), int64(a))
+		}
+		addr := pc + uint64(inst.Len) + uint64(a)
+		if s, base := lookupSym(symname, addr); s != "" {
+			return f.appendSym(dst, s, base, addr)
+		}
+		return f.appendHex(dst, addr)
 	case Reg:
 		if int(a) < len(intelReg) && intelReg[a] != "" {
-			return intelReg[a]
+			return f.appendName(dst, intelReg[a])
 		}
 	}
-	return strings.ToLower(arg.String())
+	return f.appendLowerName(dst, arg.String())
 }
 
 var intelOp = map[Op]string{
@@ -500,6 +576,94 @@ var intelReg = [...]string{
 	X13: "xmm13",
 	X14: "xmm14",
 	X15: "xmm15",
+	X16: "xmm16",
+	X17: "xmm17",
+	X18: "xmm18",
+	X19: "xmm19",
+	X20: "xmm20",
+	X21: "xmm21",
+	X22: "xmm22",
+	X23: "xmm23",
+	X24: "xmm24",
+	X25: "xmm25",
+	X26: "xmm26",
+	X27: "xmm27",
+	X28: "xmm28",
+	X29: "xmm29",
+	X30: "xmm30",
+	X31: "xmm31",
+	Y0:  "ymm0",
+	Y1:  "ymm1",
+	Y2:  "ymm2",
+	Y3:  "ymm3",
+	Y4:  "ymm4",
+	Y5:  "ymm5",
+	Y6:  "ymm6",
+	Y7:  "ymm7",
+	Y8:  "ymm8",
+	Y9:  "ymm9",
+	Y10: "ymm10",
+	Y11: "ymm11",
+	Y12: "ymm12",
+	Y13: "ymm13",
+	Y14: "ymm14",
+	Y15: "ymm15",
+	Y16: "ymm16",
+	Y17: "ymm17",
+	Y18: "ymm18",
+	Y19: "ymm19",
+	Y20: "ymm20",
+	Y21: "ymm21",
+	Y22: "ymm22",
+	Y23: "ymm23",
+	Y24: "ymm24",
+	Y25: "ymm25",
+	Y26: "ymm26",
+	Y27: "ymm27",
+	Y28: "ymm28",
+	Y29: "ymm29",
+	Y30: "ymm30",
+	Y31: "ymm31",
+	Z0:  "zmm0",
+	Z1:  "zmm1",
+	Z2:  "zmm2",
+	Z3:  "zmm3",
+	Z4:  "zmm4",
+	Z5:  "zmm5",
+	Z6:  "zmm6",
+	Z7:  "zmm7",
+	Z8:  "zmm8",
+	Z9:  "zmm9",
+	Z10: "zmm10",
+	Z11: "zmm11",
+	Z12: "zmm12",
+	Z13: "zmm13",
+	Z14: "zmm14",
+	Z15: "zmm15",
+	Z16: "zmm16",
+	Z17: "zmm17",
+	Z18: "zmm18",
+	Z19: "zmm19",
+	Z20: "zmm20",
+	Z21: "zmm21",
+	Z22: "zmm22",
+	Z23: "zmm23",
+	Z24: "zmm24",
+	Z25: "zmm25",
+	Z26: "zmm26",
+	Z27: "zmm27",
+	Z28: "zmm28",
+	Z29: "zmm29",
+	Z30: "zmm30",
+	Z31: "zmm31",
+	K0:  "k0",
+	K1:  "k1",
+	K2:  "k2",
+	K3:  "k3",
+	K4:  "k4",
+	K5:  "k5",
+	K6:  "k6",
+	K7:  "k7",
 
 	// TODO: Maybe the constants are named wrong.
 	SPB: "spl",
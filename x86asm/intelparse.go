@@ -0,0 +1,661 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseIntel parses a single instruction written in the Intel syntax
+// printed by IntelSyntax, such as "lock add dword ptr [rax+rcx*4+0x10], 0x5",
+// for the given processor mode (16, 32, or 64).
+//
+// The result is the instruction that Decode returns for the shortest
+// encoding of the text, so its Opcode, Len, DataSize, and other decoded
+// fields are filled in, and IntelSyntax of the result reproduces the text
+// up to case, spacing, and the spelling of numbers.
+// ParseIntel also accepts a memory argument without a size keyword
+// when the instruction implies the size, as in "mov eax, [rbx]",
+// and common aliases such as je for jz and repe for rep.
+func ParseIntel(text string, mode int) (Inst, error) {
+	switch mode {
+	case 16, 32, 64:
+		// ok
+	default:
+		return Inst{}, ErrInvalidMode
+	}
+	t, err := parseIntelText(text, mode)
+	if err != nil {
+		return Inst{}, fmt.Errorf("parsing %q: %v", text, err)
+	}
+	// Prefer an instruction that prints the immediates as written
+	// to one that agrees only in the bits the operation uses:
+	// "aad 0xffffffcc" in 16-bit mode has a data32 prefix,
+	// and "aad 0xffcc" does not.
+	cands := t.candidates(mode)
+	for _, exact := range []bool{true, false} {
+		inst, ok := parseSearch(cands, func(inst Inst) bool {
+			n := 64
+			if !exact {
+				n = immBits(&inst)
+			}
+			t2, err := parseIntelText(IntelSyntax(inst, 0, nil), mode)
+			return err == nil && t.matches(t2, n)
+		})
+		if ok {
+			return inst, nil
+		}
+	}
+	return Inst{}, fmt.Errorf("parsing %q: %v", text, ErrUnrecognized)
+}
+
+// An intelText is a parsed line of Intel syntax.
+// Two lines that parse to the same intelText
+// describe the same instruction.
+type intelText struct {
+	prefix []string
+	op     string
+	args   []intelArgText
+}
+
+// matches reports whether the text u, printed by IntelSyntax,
+// says what t says. A memory argument without "ptr" in t
+// may have any size, and immediates need only agree in
+// their low immBits bits.
+func (t *intelText) matches(u *intelText, immBits int) bool {
+	if t.op != u.op || len(t.prefix) != len(u.prefix) || len(t.args) != len(u.args) {
+		return false
+	}
+	for i, p := range t.prefix {
+		if u.prefix[i] != p {
+			return false
+		}
+	}
+	for i, a := range t.args {
+		b := u.args[i]
+		if !a.ptr {
+			b.size = ""
+		}
+		b.ptr = a.ptr
+		if x, ok := a.arg.(Imm); ok {
+			if y, ok := b.arg.(Imm); ok && sameImm(x, y, immBits) {
+				b.arg = x
+			}
+		}
+		if a != b {
+			return false
+		}
+	}
+	return true
+}
+
+// An intelArgText is a parsed argument in Intel syntax.
+type intelArgText struct {
+	arg   Arg      // Reg, Imm, Rel, or Mem; nil for a rounding argument
+	size  string   // size keyword before "ptr", if any
+	ptr   bool     // "ptr" keyword present
+	mask  Reg      // opmask decoration
+	zero  bool     // {z} decoration
+	bcst  int      // {1toN} decoration
+	round Rounding // rounding argument
+}
+
+// intelPrefixes maps the prefix keywords accepted in Intel syntax
+// to the ones printed by IntelSyntax.
+var intelPrefixes = map[string]string{
+	"lock":           "lock",
+	"rep":            "rep",
+	"repe":           "rep",
+	"repz":           "rep",
+	"repne":          "repne",
+	"repnz":          "repne",
+	"xacquire":       "xacquire",
+	"xrelease":       "xrelease",
+	"bnd":            "bnd",
+	"hint-taken":     "hint-taken",
+	"hint-not-taken": "hint-not-taken",
+	"addr16":         "addr16",
+	"addr32":         "addr32",
+	"data16":         "data16",
+	"data32":         "data32",
+}
+
+// intelMemBytes maps the size keywords before "ptr" to memory sizes.
+var intelMemBytes = map[string]int{
+	"byte":    1,
+	"word":    2,
+	"dword":   4,
+	"qword":   8,
+	"xmmword": 16,
+	"ymmword": 32,
+	"zmmword": 64,
+}
+
+var (
+	intelOpsByName  = map[string][]Op{}
+	intelOpAliases  = map[string]string{} // other names, like je for jz
+	intelRegsByName = map[string]Reg{}
+)
+
+func init() {
+	for op := Op(1); op <= maxOp; op++ {
+		name := strings.ToLower(op.String())
+		if intelOp[op] != "" {
+			intelOpAliases[name] = intelOp[op]
+			name = intelOp[op]
+		}
+		intelOpsByName[name] = append(intelOpsByName[name], op)
+	}
+	for alias := range intelOpAliases {
+		if intelOpsByName[alias] != nil {
+			delete(intelOpAliases, alias)
+		}
+	}
+	intelOpsByName["int3"] = []Op{INT}
+	for r := Reg(1); r <= regMax; r++ {
+		name := strings.ToLower(r.String())
+		if int(r) < len(intelReg) && intelReg[r] != "" {
+			name = intelReg[r]
+		}
+		intelRegsByName[name] = r
+	}
+}
+
+// parseIntelText parses a line of Intel syntax.
+func parseIntelText(text string, mode int) (*intelText, error) {
+	t := new(intelText)
+	s := strings.TrimSpace(strings.ToLower(text))
+	for {
+		word, rest := s, ""
+		if i := strings.IndexAny(s, " \t"); i >= 0 {
+			word, rest = s[:i], strings.TrimSpace(s[i:])
+		}
+		if p := intelPrefixes[word]; p != "" {
+			t.prefix = append(t.prefix, p)
+			s = rest
+			continue
+		}
+		if word == "" {
+			return nil, fmt.Errorf("missing instruction")
+		}
+		if op := intelOpAliases[word]; op != "" {
+			word = op
+		}
+		if intelOpsByName[word] == nil {
+			return nil, fmt.Errorf("unknown instruction %q", word)
+		}
+		t.op, s = word, rest
+		break
+	}
+	switch t.op {
+	case "call", "jmp", "ret":
+		if s == "far" || strings.HasPrefix(s, "far ") {
+			t.op += " far"
+			s = strings.TrimSpace(s[len("far"):])
+		}
+	}
+	if s == "" {
+		return t, nil
+	}
+
+	for _, text := range splitArgs(s) {
+		a, err := parseIntelArg(text, mode)
+		if err != nil {
+			return nil, err
+		}
+		t.args = append(t.args, a)
+	}
+	return t, nil
+}
+
+// parseIntelArg parses a single argument, including its decorations.
+func parseIntelArg(s string, mode int) (intelArgText, error) {
+	var a intelArgText
+
+	// A rounding argument is only a decoration.
+	if strings.HasPrefix(s, "{") {
+		switch s {
+		case "{rne-sae}", "{rn-sae}": // IntelSyntax prints XED's {rne-sae}
+			a.round = RoundNearest
+		case "{rd-sae}":
+			a.round = RoundDown
+		case "{ru-sae}":
+			a.round = RoundUp
+		case "{rz-sae}":
+			a.round = RoundZero
+		case "{sae}":
+			a.round = RoundSAE
+		default:
+			return a, fmt.Errorf("invalid rounding %q", s)
+		}
+		return a, nil
+	}
+
+	for strings.HasSuffix(s, "}") {
+		i := strings.LastIndex(s, "{")
+		if i < 0 {
+			return a, fmt.Errorf("invalid argument %q", s)
+		}
+		dec := s[i+1 : len(s)-1]
+		s = strings.TrimSpace(s[:i])
+		switch {
+		case dec == "z":
+			a.zero = true
+		case strings.HasPrefix(dec, "1to"):
+			n, err := strconv.Atoi(dec[len("1to"):])
+			if err != nil || n <= 0 {
+				return a, fmt.Errorf("invalid broadcast {%s}", dec)
+			}
+			a.bcst = n
+		default:
+			r, ok := intelRegsByName[dec]
+			if !ok || r < K0 || r > K7 {
+				return a, fmt.Errorf("invalid decoration {%s}", dec)
+			}
+			a.mask = r
+		}
+	}
+
+	switch {
+	case strings.HasSuffix(s, "]"):
+		if i := strings.Index(s, "ptr"); i >= 0 {
+			a.ptr = true
+			a.size = strings.TrimSpace(s[:i])
+			if a.size != "" && intelMemBytes[a.size] == 0 {
+				return a, fmt.Errorf("invalid memory size %q", a.size)
+			}
+			s = strings.TrimSpace(s[i+len("ptr"):])
+		}
+		mem, err := parseIntelMem(s)
+		if err != nil {
+			return a, err
+		}
+		a.arg = mem
+
+	case strings.HasPrefix(s, "."):
+		v, err := parseInt(s[1:])
+		if err != nil {
+			return a, fmt.Errorf("invalid branch target %q", s)
+		}
+		a.arg = Rel(v)
+
+	default:
+		if r, ok := intelRegsByName[s]; ok {
+			a.arg = r
+			break
+		}
+		v, err := parseInt(s)
+		if err != nil {
+			return a, fmt.Errorf("invalid argument %q", s)
+		}
+		if mode == 32 {
+			// IntelSyntax prints 32-bit mode immediates as uint32.
+			v = int64(uint32(v))
+		}
+		a.arg = Imm(v)
+	}
+	return a, nil
+}
+
+// parseIntelMem parses a memory reference seg:[base+index*scale+disp].
+func parseIntelMem(s string) (Mem, error) {
+	var mem Mem
+	i := strings.Index(s, "[")
+	if i < 0 {
+		return mem, fmt.Errorf("invalid memory reference %q", s)
+	}
+	if i > 0 {
+		seg := strings.TrimSpace(s[:i])
+		if !strings.HasSuffix(seg, ":") {
+			return mem, fmt.Errorf("invalid memory reference %q", s)
+		}
+		r, ok := intelRegsByName[strings.TrimSpace(seg[:len(seg)-1])]
+		if !ok || r < ES || r > GS {
+			return mem, fmt.Errorf("invalid segment in %q", s)
+		}
+		mem.Segment = r
+	}
+	body := s[i+1 : len(s)-1]
+	for body != "" {
+		j := strings.IndexAny(body[1:], "+-") + 1
+		if j == 0 {
+			j = len(body)
+		}
+		term := strings.TrimSpace(body[:j])
+		body = body[j:]
+		neg := false
+		switch {
+		case strings.HasPrefix(term, "+"):
+			term = strings.TrimSpace(term[1:])
+		case strings.HasPrefix(term, "-"):
+			neg = true
+			term = strings.TrimSpace(term[1:])
+		}
+		reg, scale := term, ""
+		if k := strings.Index(term, "*"); k >= 0 {
+			reg, scale = strings.TrimSpace(term[:k]), strings.TrimSpace(term[k+1:])
+		}
+		if r, ok := intelRegsByName[reg]; ok && !neg {
+			switch {
+			case scale != "":
+				n, err := strconv.Atoi(scale)
+				if err != nil || mem.Index != 0 {
+					return mem, fmt.Errorf("invalid memory reference %q", s)
+				}
+				mem.Index, mem.Scale = r, uint8(n)
+			case mem.Base == 0:
+				mem.Base = r
+			case mem.Index == 0:
+				mem.Index, mem.Scale = r, 1
+			default:
+				return mem, fmt.Errorf("invalid memory reference %q", s)
+			}
+			continue
+		}
+		v, err := parseInt(term)
+		if err != nil || scale != "" {
+			return mem, fmt.Errorf("invalid memory reference %q", s)
+		}
+		if neg {
+			v = -v
+		}
+		mem.Disp += v
+	}
+	return mem, nil
+}
+
+// candidates returns the instructions that t might describe,
+// undoing the simplifications made by IntelSyntax.
+func (t *intelText) candidates(mode int) []Inst {
+	var base Inst
+	base.Mode = mode
+	// The size keywords usually describe the operands,
+	// but they may also be prefixes that have no effect.
+	var sizePrefix []Prefix
+	n := 0
+	for _, p := range t.prefix {
+		switch p {
+		case "lock":
+			base.Prefix[n] = PrefixLOCK
+		case "rep":
+			base.Prefix[n] = PrefixREP
+		case "repne":
+			base.Prefix[n] = PrefixREPN
+		case "xacquire":
+			base.Prefix[n] = PrefixXACQUIRE
+		case "xrelease":
+			base.Prefix[n] = PrefixXRELEASE
+		case "bnd":
+			base.Prefix[n] = PrefixBND
+		case "hint-taken":
+			base.Prefix[n] = PrefixPT
+		case "hint-not-taken":
+			base.Prefix[n] = PrefixPN
+		case "addr16", "addr32":
+			base.AddrSize = 16
+			if p == "addr32" {
+				base.AddrSize = 32
+			}
+			sizePrefix = append(sizePrefix, PrefixAddrSize)
+			continue
+		case "data16", "data32":
+			base.DataSize = 16
+			if p == "data32" {
+				base.DataSize = 32
+			}
+			sizePrefix = append(sizePrefix, PrefixDataSize)
+			continue
+		}
+		n++
+	}
+
+	var args []Arg
+	for _, a := range t.args {
+		if a.round != 0 {
+			base.Round = a.round
+			continue
+		}
+		if a.mask != 0 {
+			base.Mask = a.mask
+		}
+		if a.zero {
+			base.Zero = true
+		}
+		if a.bcst != 0 {
+			base.Bcst = a.bcst
+		}
+		if mem, ok := a.arg.(Mem); ok {
+			if mem.Base == RIP && base.AddrSize == 32 {
+				mem.Base = EIP
+			}
+			base.MemBytes = intelMemBytes[a.size]
+			if base.Bcst != 0 {
+				// Decoded broadcasts record the element size.
+				base.MemBytes = 0
+			}
+			a.arg = mem
+		}
+		args = append(args, a.arg)
+	}
+
+	var list []Inst
+	add := func(inst Inst, args []Arg) {
+		if len(args) > len(inst.Args) {
+			return
+		}
+		inst.Args = Args{}
+		copy(inst.Args[:], args)
+		sizes := []int{inst.MemBytes}
+		if inst.MemBytes != 0 {
+			// Memory sizes implied by the instruction,
+			// like the byte ptr printed for INVLPG.
+			sizes = append(sizes, 0)
+		} else if t.hasMem() {
+			// Sizes not named in Intel syntax, like m80.
+			sizes = append(sizes, 10)
+		}
+		for _, size := range sizes {
+			inst.MemBytes = size
+			list = append(list, inst)
+			if sizePrefix != nil {
+				inst := inst
+				inst.DataSize, inst.AddrSize = 0, 0
+				i := 0
+				for inst.Prefix[i] != 0 {
+					i++
+				}
+				copy(inst.Prefix[i:], sizePrefix)
+				list = append(list, inst)
+			}
+		}
+	}
+	for _, op := range intelOpsByName[t.op] {
+		inst := base
+		inst.Op = op
+		for _, alt := range intelArgAlternatives(&inst, t.op, args) {
+			for _, alt := range immAlternatives(alt) {
+				add(inst, alt)
+			}
+		}
+		if (op == AAD || op == AAM) && sizePrefix == nil {
+			// IntelSyntax prints the immediate sign-extended to
+			// the operand size, so the text may call for an
+			// operand size prefix that it does not show.
+			i := 0
+			for inst.Prefix[i] != 0 {
+				i++
+			}
+			inst.Prefix[i] = PrefixDataSize
+			for _, alt := range immAlternatives(args) {
+				add(inst, alt)
+			}
+		}
+	}
+	return list
+}
+
+// hasMem reports whether t has a memory argument.
+func (t *intelText) hasMem() bool {
+	for _, a := range t.args {
+		if isMem(a.arg) {
+			return true
+		}
+	}
+	return false
+}
+
+// intelArgAlternatives returns the argument lists that might have been
+// printed as args, most likely first. It may add prefixes to inst.
+func intelArgAlternatives(inst *Inst, name string, args []Arg) [][]Arg {
+	with := func(list ...Arg) []Arg {
+		return list
+	}
+
+	// Decode records the default segments of string operations.
+	if stringSeg(inst.Op) >= -1 {
+		args = append([]Arg(nil), args...)
+		for i, a := range args {
+			if m, ok := a.(Mem); ok && m.Segment == 0 {
+				m.Segment = DS
+				if m.Base == DI || m.Base == EDI || m.Base == RDI {
+					m.Segment = ES
+				}
+				args[i] = m
+			}
+		}
+	}
+	alts := [][]Arg{args}
+	last := func() Arg {
+		if len(args) == 0 {
+			return nil
+		}
+		return args[len(args)-1]
+	}
+
+	switch inst.Op {
+	case INT:
+		if name == "int3" && len(args) == 0 {
+			return [][]Arg{with(Imm(3))}
+		}
+
+	case STOSB, STOSW, STOSD, STOSQ:
+		if len(args) == 1 {
+			return [][]Arg{with(args[0], stringAccum(inst.Op))}
+		}
+
+	case LODSB, LODSW, LODSD, LODSQ, SCASB, SCASW, SCASD, SCASQ:
+		if len(args) == 1 {
+			return [][]Arg{with(stringAccum(inst.Op), args[0])}
+		}
+
+	case NOP:
+		if r := last(); len(args) == 2 && (r == AX || r == EAX) {
+			return [][]Arg{args[:1]}
+		}
+
+	case BLENDVPD, BLENDVPS, PBLENDVB:
+		if len(args) == 2 {
+			return [][]Arg{with(args[0], args[1], X0)}
+		}
+
+	case LCALL, LJMP:
+		if len(args) == 2 {
+			return [][]Arg{with(args[1], args[0])}
+		}
+
+	case MOV:
+		if len(args) != 2 {
+			break
+		}
+		if seg, ok := args[0].(Reg); ok && ES <= seg && seg <= GS && args[1] == AX {
+			// IntelSyntax prints the 32- and 64-bit sources as AX.
+			alts = nil
+			if inst.Mode != 16 {
+				alts = append(alts, with(seg, EAX))
+			}
+			alts = append(alts, with(seg, AX))
+			if inst.Mode == 64 {
+				alts = append(alts, with(seg, RAX))
+			}
+		}
+
+	case MASKMOVDQU, MASKMOVQ:
+		if seg, ok := last().(Reg); ok && ES <= seg && seg <= GS {
+			for i := range inst.Prefix {
+				if inst.Prefix[i] == 0 {
+					inst.Prefix[i] = segmentToPrefix(seg)
+					break
+				}
+			}
+			return [][]Arg{args[:len(args)-1]}
+		}
+
+	case INSB, INSW, INSD, OUTSB, OUTSW, OUTSD, XLATB:
+		// IntelSyntax prints only a segment override, if any,
+		// but the address size and segment are in the arguments.
+		seg := DS
+		if r, ok := last().(Reg); ok && len(args) == 1 && ES <= r && r <= GS {
+			seg = r
+		} else if len(args) != 0 {
+			break
+		}
+		addr := inst.AddrSize
+		if addr == 0 {
+			addr = defaultAddrSize(inst.Mode)
+		}
+		reg := func(r16 Reg) Reg {
+			switch addr {
+			case 32:
+				return r16 - AX + EAX
+			case 64:
+				return r16 - AX + RAX
+			}
+			return r16
+		}
+		switch inst.Op {
+		case INSB, INSW, INSD:
+			return [][]Arg{with(Mem{Segment: ES, Base: reg(DI)}, DX)}
+		case OUTSB, OUTSW, OUTSD:
+			return [][]Arg{with(DX, Mem{Segment: seg, Base: reg(SI)})}
+		}
+		return [][]Arg{with(Mem{Segment: seg, Base: reg(BX)})}
+
+	case FCHS, FABS, FTST, FLDPI, FLDL2E, FLDLG2, F2XM1, FXAM, FLD1, FLDL2T, FSQRT, FRNDINT, FCOS, FSIN:
+		if len(args) == 1 && args[0] == F0 {
+			alts = append(alts, nil)
+		}
+
+	case FPTAN, FSINCOS, FUCOMPP, FCOMPP, FYL2X, FPATAN, FXTRACT, FPREM1, FPREM, FYL2XP1, FSCALE:
+		if len(args) == 2 && args[0] == F0 && args[1] == F1 {
+			alts = append(alts, nil)
+		}
+
+	case FST, FSTP, FISTTP, FIST, FISTP, FBSTP:
+		if len(args) == 2 && args[1] == F0 {
+			alts = append(alts, args[:1])
+		}
+
+	case FLD, FXCH, FCOM, FCOMP, FIADD, FIMUL, FICOM, FICOMP, FISUBR, FIDIV, FUCOM, FUCOMP, FILD, FBLD, FADD, FMUL, FSUB, FSUBR, FISUB, FDIV, FDIVR, FIDIVR:
+		if len(args) == 2 && args[0] == F0 {
+			alts = append(alts, args[1:])
+		}
+	}
+	return alts
+}
+
+// stringAccum returns the accumulator register used by a string operation.
+func stringAccum(op Op) Reg {
+	switch op {
+	case STOSB, LODSB, SCASB:
+		return AL
+	case STOSW, LODSW, SCASW:
+		return AX
+	case STOSD, LODSD, SCASD:
+		return EAX
+	}
+	return RAX
+}
@@ -0,0 +1,114 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestParseIntel checks that ParseIntel inverts IntelSyntax
+// for the Intel syntax in testdata/decode.txt.
+func TestParseIntel(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/decode.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.SplitN(strings.TrimSpace(line), "\t", 4)
+		if len(f) < 4 || f[2] != "intel" || strings.HasPrefix(f[3], "error: ") {
+			continue
+		}
+		mode, err := strconv.Atoi(f[1])
+		if err != nil {
+			t.Errorf("invalid mode %q in: %s", f[1], line)
+			continue
+		}
+		inst, err := ParseIntel(f[3], mode)
+		if err != nil {
+			t.Errorf("ParseIntel(%q, %d): %v", f[3], mode, err)
+			continue
+		}
+		if out := IntelSyntax(inst, 0, nil); out != f[3] {
+			t.Errorf("ParseIntel(%q, %d) = %s", f[3], mode, out)
+		}
+	}
+}
+
+var parseIntelTests = []struct {
+	text string
+	mode int
+	code string
+	out  string
+}{
+	{"ADD EAX, 5", 32, "83c005", "add eax, 0x5"},
+	{"add dword ptr [rax+rcx*4+0x10], 0x5", 64, "8344881005", "add dword ptr [rax+rcx*4+0x10], 0x5"},
+	{"mov eax, [rbx]", 64, "8b03", "mov eax, dword ptr [rbx]"},
+	{"mov rax, qword ptr fs:[0x28]", 64, "64488b042528000000", "mov rax, qword ptr fs:[0x28]"},
+	{"mov al, byte ptr es:[ebx-0x8]", 32, "268a43f8", "mov al, byte ptr es:[ebx-0x8]"},
+	{"lock xadd dword ptr [rdi], eax", 64, "f00fc107", "lock xadd dword ptr [rdi], eax"},
+	{"repe cmpsb byte ptr [rsi], byte ptr [rdi]", 64, "f3a6", "rep cmpsb byte ptr [rsi], byte ptr [rdi]"},
+	{"je .+0x10", 64, "7410", "jz .+0x10"},
+	{"jmp .-2", 64, "ebfe", "jmp .-0x2"},
+	{"vaddps zmm1{k1}{z}, zmm2, zmm3", 64, "62f16cc958cb", "vaddps zmm1{k1}{z}, zmm2, zmm3"},
+	{"vaddps zmm1, zmm2, zmm3, {rz-sae}", 64, "62f16c7858cb", "vaddps zmm1, zmm2, zmm3, {rz-sae}"},
+	{"vaddps zmm1, zmm2, dword ptr [rax]{1to16}", 64, "62f16c585808", "vaddps zmm1, zmm2, dword ptr [rax]{1to16}"},
+	{"fld st0, ptr [eax]", 32, "db28", "fld st0, ptr [eax]"},
+	{"int 0x3", 64, "cd03", "int 0x3"},
+	{"int3", 64, "cc", "int3"},
+	{"mov bx, 0xaccc", 64, "66bbccac", "mov bx, -0x5334"},
+	{"mov bx, 0xffffaccc", 32, "66bbccac", "mov bx, 0xffffaccc"},
+	{"mov dword ptr [rax], 0xffffffff", 64, "c700ffffffff", "mov dword ptr [rax], -0x1"},
+	{"add al, -1", 64, "04ff", "add al, 0xff"},
+	{"aad 0xffb8", 16, "d5b8", "aad 0xffb8"},
+	{"aad 0xb8", 32, "d5b8", "aad 0xffffffb8"},
+	{"aad 0xffffffcc", 16, "66d5cc", "aad 0xffffffcc"},
+	{"aam 0xffcc", 32, "66d4cc", "aam 0xffcc"},
+	{"mov ax, word ptr [bx+0xff8c]", 16, "8b878cff", "mov ax, word ptr [bx+0xff8c]"},
+	{"mov ax, word ptr [bx-0x74]", 16, "8b478c", "mov ax, word ptr [bx-0x74]"},
+	{"mov byte ptr [0xb2dbc6ee], al", 16, "67a2eec6dbb2", "mov byte ptr [0xb2dbc6ee], al"},
+}
+
+func TestParseIntelInput(t *testing.T) {
+	for _, tt := range parseIntelTests {
+		inst, err := ParseIntel(tt.text, tt.mode)
+		if err != nil {
+			t.Errorf("ParseIntel(%q, %d): %v", tt.text, tt.mode, err)
+			continue
+		}
+		e := Encoder{Exact: true}
+		code, err := e.Encode(inst)
+		if err != nil {
+			t.Errorf("ParseIntel(%q, %d) = %v: %v", tt.text, tt.mode, inst, err)
+			continue
+		}
+		if out := IntelSyntax(inst, 0, nil); hex.EncodeToString(code) != tt.code || out != tt.out {
+			t.Errorf("ParseIntel(%q, %d) = %x %s, want %s %s", tt.text, tt.mode, code, out, tt.code, tt.out)
+		}
+	}
+}
+
+func TestParseIntelError(t *testing.T) {
+	for _, text := range []string{
+		"",
+		"lock",
+		"frob eax",
+		"mov eax, dword ptr [rbx",
+		"mov eax, tword ptr [rbx]",
+		"mov rax, eax",
+		"mov eax, 0x123456789",
+		"vaddps zmm1{k8}, zmm2, zmm3",
+	} {
+		if inst, err := ParseIntel(text, 64); err == nil {
+			t.Errorf("ParseIntel(%q, 64) = %s, want error", text, IntelSyntax(inst, 0, nil))
+		}
+	}
+	if _, err := ParseIntel("nop", 8); err != ErrInvalidMode {
+		t.Errorf("ParseIntel with mode 8: %v, want ErrInvalidMode", err)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+// MASMSyntax returns the Microsoft assembler (MASM) syntax for the instruction,
+// as accepted by ml and ml64 and by compatible assemblers like UASM.
+// Like NASMSyntax, MASMSyntax is meant to be assembled again,
+// except that MASM cannot write operand and address size overrides
+// that the arguments do not imply, branch hints, or segment overrides
+// on instructions without memory arguments, so those are left out.
+// If pc is not zero, it is the program counter of the instruction,
+// and branch targets and RIP-relative addresses are printed as absolute
+// addresses, named using symname if that is not nil.
+func MASMSyntax(inst Inst, pc uint64, symname SymLookup) string {
+	f := Formatter{Syntax: SyntaxMASM}
+	return f.asmSyntax(inst, pc, symname)
+}
+
+var masmOp = map[Op]string{
+	LCALL:     "call",
+	LJMP:      "jmp",
+	LRET:      "retf",
+	MOVSD_XMM: "movsd",
+}
+
+var masmSize = map[int]string{
+	1:  "byte",
+	2:  "word",
+	4:  "dword",
+	6:  "fword",
+	8:  "qword",
+	10: "tbyte",
+	16: "xmmword",
+	32: "ymmword",
+	64: "zmmword",
+}
@@ -0,0 +1,126 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Support for testing against MASM, or an assembler like UASM
+// that accepts the same syntax.
+
+package x86asm
+
+import (
+	"bytes"
+	"debug/pe"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMASM32(t *testing.T) { testMASM(t, 32) }
+func TestMASM64(t *testing.T) { testMASM(t, 64) }
+
+func testMASM(t *testing.T, mode int) {
+	if testing.Short() {
+		t.Skip("skipping masm test in short mode")
+	}
+	if masmTool(mode) == "" {
+		t.Skip("skipping masm test: no ml, uasm, or jwasm found")
+	}
+	testAsmRoundTrip(t, mode, MASMSyntax, masmAsm)
+}
+
+// masmTool returns the name of the assembler to use for mode,
+// or "" if none is installed.
+func masmTool(mode int) string {
+	ml := "ml"
+	if mode == 64 {
+		ml = "ml64"
+	}
+	for _, name := range []string{ml, "uasm", "jwasm"} {
+		if _, err := exec.LookPath(name); err == nil {
+			return name
+		}
+	}
+	return ""
+}
+
+var masmError = regexp.MustCompile(`^x\.asm\((\d+)\) ?: (?i:(?:fatal )?error) [A-Z]\d+: ?(.*)`)
+
+// masmAsm assembles lines with the tool chosen by masmTool, as an asmFunc.
+func masmAsm(dir string, mode int, lines []string) (code map[int][]byte, errs map[int]string, err error) {
+	var buf bytes.Buffer
+	header := ".code\n"
+	if mode == 32 {
+		header = ".686p\n.xmm\n.model flat\n.code\n"
+	}
+	buf.WriteString(header)
+	// Line i is x.asm:2*i+first.
+	first := strings.Count(header, "\n") + 1
+	for i, line := range lines {
+		fmt.Fprintf(&buf, "L%d: %s\n\tdb %d-($-L%d) dup (0CCh)\n", i, line, asmSlot, i)
+	}
+	buf.WriteString("end\n")
+	if err := ioutil.WriteFile(filepath.Join(dir, "x.asm"), buf.Bytes(), 0666); err != nil {
+		return nil, nil, err
+	}
+
+	tool := masmTool(mode)
+	args := []string{"-nologo", "-c"}
+	switch {
+	case mode == 32:
+		args = append(args, "-coff")
+	case tool != "ml64":
+		args = append(args, "-win64")
+	}
+	args = append(args, "-Fox.obj", "x.asm")
+	cmd := exec.Command(tool, args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		errs = map[int]string{}
+		for _, msg := range strings.Split(string(out), "\n") {
+			msg = strings.TrimSpace(msg)
+			if m := masmError.FindStringSubmatch(msg); m != nil {
+				n, _ := strconv.Atoi(m[1])
+				if i := (n - first) / 2; 0 <= i && i < len(lines) {
+					errs[i] = m[2]
+					continue
+				}
+			}
+			if msg != "" {
+				errs[-1] += msg + "\n"
+			}
+		}
+		if errs[-1] == "" {
+			errs[-1] = err.Error()
+		}
+		return nil, errs, nil
+	}
+
+	f, err := pe.Open(filepath.Join(dir, "x.obj"))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	var text []byte
+	for _, s := range f.Sections {
+		if strings.HasPrefix(s.Name, ".text") {
+			if text, err = s.Data(); err != nil {
+				return nil, nil, err
+			}
+			break
+		}
+	}
+	if len(text) != asmSlot*len(lines) {
+		return nil, nil, fmt.Errorf("%s wrote %d bytes of code for %d lines", tool, len(text), len(lines))
+	}
+	code = map[int][]byte{}
+	for i := range lines {
+		code[i] = text[asmSlot*i : asmSlot*(i+1)]
+	}
+	return code, nil, nil
+}
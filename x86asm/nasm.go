@@ -0,0 +1,479 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NASMSyntax returns the NASM assembler syntax for the instruction,
+// which YASM accepts too.
+// Unlike IntelSyntax, which matches Intel's XED tool, NASMSyntax
+// is meant to be assembled again: NASM assembles the text to an
+// instruction that NASMSyntax prints the same way, although not
+// necessarily to the same bytes. Prefixes not evident from the
+// arguments are written using NASM's o16, o32, o64, a16, and a32.
+// If pc is not zero, it is the program counter of the instruction,
+// and branch targets and RIP-relative addresses are printed as absolute
+// addresses, named using symname if that is not nil.
+func NASMSyntax(inst Inst, pc uint64, symname SymLookup) string {
+	f := Formatter{Syntax: SyntaxNASM}
+	return f.asmSyntax(inst, pc, symname)
+}
+
+// asmSyntax implements NASMSyntax and MASMSyntax.
+// The two differ mainly in how they write memory arguments,
+// numbers, and floating-point registers, and in that MASM has
+// no way to write the prefixes that its arguments do not imply.
+func (f *Formatter) asmSyntax(inst Inst, pc uint64, symname SymLookup) string {
+	masm := f.Syntax == SyntaxMASM
+	var args []Arg
+	for _, a := range inst.Args {
+		if a == nil {
+			break
+		}
+		args = append(args, a)
+	}
+
+	// String instructions are written without arguments,
+	// except that MASM needs them to write a segment override
+	// or an address size different from the mode's.
+	switch inst.Op {
+	case INSB, INSW, INSD, OUTSB, OUTSW, OUTSD, MONITOR, MWAIT, XLATB:
+		args = nil
+
+	case MOVSB, MOVSW, MOVSD, MOVSQ, CMPSB, CMPSW, CMPSD, CMPSQ:
+		if !masm || !asmStringArgs(&inst) {
+			args = nil
+		}
+
+	case STOSB, STOSW, STOSD, STOSQ:
+		if !masm || !asmStringArgs(&inst) {
+			args = nil
+		} else {
+			args = args[:1]
+		}
+
+	case LODSB, LODSW, LODSD, LODSQ, SCASB, SCASW, SCASD, SCASQ:
+		if !masm || !asmStringArgs(&inst) {
+			args = nil
+		} else {
+			args = args[1:]
+		}
+
+	case AAM, AAD:
+		if imm, ok := args[0].(Imm); ok {
+			args[0] = Imm(uint8(imm))
+		}
+
+	case INT:
+		if inst.Opcode>>24 == 0xCC {
+			args = nil
+		}
+	}
+
+	memShown := false
+	for _, a := range args {
+		if isMem(a) {
+			memShown = true
+		}
+	}
+
+	// Write the prefixes that the instruction and its arguments
+	// do not imply.
+	var prefix string
+	for _, p := range inst.Prefix {
+		if p == 0 {
+			break
+		}
+		if p&PrefixIgnored != 0 {
+			continue
+		}
+		implicit := p&PrefixImplicit != 0
+		p &^= PrefixImplicit | PrefixInvalid
+		name := ""
+		switch p {
+		case PrefixLOCK:
+			name = "lock"
+		case PrefixREP:
+			name = "rep"
+			switch inst.Op {
+			case CMPSB, CMPSW, CMPSD, CMPSQ, SCASB, SCASW, SCASD, SCASQ:
+				name = "repe"
+			}
+		case PrefixREPN:
+			name = "repne"
+		case PrefixXACQUIRE:
+			name = "xacquire"
+		case PrefixXRELEASE:
+			name = "xrelease"
+		case PrefixBND:
+			name = "bnd"
+		case PrefixPN, PrefixPT:
+			// NASM has no names for the branch hints,
+			// but it does for the segment overrides that encode them.
+			if !masm {
+				name = strings.ToLower((p & 0xFF).String())
+			}
+		case PrefixCS, PrefixDS, PrefixES, PrefixFS, PrefixGS, PrefixSS:
+			if !masm && (!implicit || !memShown) {
+				prefix += strings.ToLower(p.String()) + " "
+			}
+			continue
+		case PrefixDataSize, PrefixData16, PrefixData32:
+			if !masm && (!implicit || asmSizeHidden(&inst)) {
+				if p == PrefixData32 || p == PrefixDataSize && inst.Mode == 16 {
+					prefix += "o32 "
+				} else {
+					prefix += "o16 "
+				}
+			}
+			continue
+		case PrefixAddrSize, PrefixAddr16, PrefixAddr32:
+			switch inst.Op {
+			case JCXZ, JECXZ, JRCXZ:
+				implicit, memShown = true, true
+			}
+			if !masm && (!implicit || !memShown) {
+				if p == PrefixAddr16 || p == PrefixAddrSize && inst.Mode == 32 {
+					prefix += "a16 "
+				} else {
+					prefix += "a32 "
+				}
+			}
+			continue
+		default:
+			if p.IsREX() && p&PrefixREXW != 0 && !masm {
+				switch inst.Op {
+				case LCALL, LJMP, LRET, SYSRET, SYSEXIT:
+					prefix += "o64 "
+				}
+			}
+			continue
+		}
+		if !implicit && name != "" {
+			prefix += name + " "
+		}
+	}
+
+	if inst.Op == 0 {
+		if prefix == "" {
+			return "<no instruction>"
+		}
+		return f.name(prefix[:len(prefix)-1])
+	}
+
+	var op string
+	if masm {
+		op = masmOp[inst.Op]
+	} else {
+		op = nasmOp[inst.Op]
+	}
+	if op == "" {
+		op = strings.ToLower(inst.Op.String())
+	}
+
+	var sargs []string
+	switch inst.Op {
+	case INT:
+		if args == nil {
+			op = "int3"
+		}
+
+	case LCALL, LJMP:
+		if len(args) == 2 {
+			// A direct far branch is written segment:offset.
+			sargs = []string{f.asmArg(&inst, pc, symname, args[0]) + ":" + f.asmArg(&inst, pc, symname, args[1])}
+			args = nil
+		} else if !masm {
+			op += " far"
+		}
+	}
+
+	for i, a := range args {
+		arg := f.asmArg(&inst, pc, symname, a)
+		if i == 0 && inst.Mask != 0 {
+			arg += "{" + f.asmArg(&inst, pc, symname, inst.Mask) + "}"
+			if inst.Zero {
+				arg += f.name("{z}")
+			}
+		}
+		if inst.Bcst != 0 && isMem(a) && !masm {
+			arg += f.name(fmt.Sprintf("{1to%d}", inst.Bcst))
+		}
+		sargs = append(sargs, arg)
+	}
+
+	// EVEX rounding is written as an extra argument
+	// after the last register or memory argument.
+	if inst.Round != 0 {
+		i := len(sargs)
+		for i > 0 && isImm(args[i-1]) {
+			i--
+		}
+		round := "{" + inst.Round.String() + "}"
+		sargs = append(sargs[:i], append([]string{f.name(round)}, sargs[i:]...)...)
+	}
+
+	return f.join(prefix, op, sargs)
+}
+
+// asmStringArgs reports whether the string instruction inst
+// has a segment override or address size override,
+// which MASM can only write using the instruction's arguments.
+func asmStringArgs(inst *Inst) bool {
+	for _, p := range inst.Prefix {
+		if p == 0 {
+			break
+		}
+		if p&PrefixIgnored == 0 && (isSegment(p&0xFF) || p&0xFF == PrefixAddrSize) {
+			return true
+		}
+	}
+	return false
+}
+
+// asmSizeHidden reports whether the arguments of inst, as written by
+// asmSyntax, leave its operand size unstated, so that a used operand
+// size override must be written as a prefix.
+func asmSizeHidden(inst *Inst) bool {
+	switch inst.Op {
+	case CALL, JMP, PUSH, POP:
+		switch a := inst.Args[0].(type) {
+		case Rel, Imm:
+			return true
+		case Reg:
+			return ES <= a && a <= GS
+		}
+		return false
+
+	case ENTER, FLDENV, FNSAVE, FNSTENV, FRSTOR, LCALL, LEAVE, LGDT, LIDT, LJMP, LRET,
+		RET, SGDT, SIDT, SYSEXIT, SYSRET, XBEGIN:
+		return true
+	}
+	return isCondJmp[inst.Op] || isLoop[inst.Op]
+}
+
+func (f *Formatter) asmArg(inst *Inst, pc uint64, symname SymLookup, arg Arg) string {
+	switch a := arg.(type) {
+	case Imm:
+		if inst.Mode != 64 && a < 0 {
+			// Write a sign-extended immediate as the unsigned value
+			// of the operand size, as in add al, 0xff.
+			return f.imm(a, asmImmBytes(inst))
+		}
+		if Imm(int32(a)) != a {
+			return f.imm(a, 8)
+		}
+		return f.imm(a, 0)
+
+	case Mem:
+		return f.asmMem(inst, pc, symname, a)
+
+	case Rel:
+		if pc == 0 {
+			// $ is the address of the instruction itself.
+			return "$" + f.offset(int64(inst.Len)+int64(a))
+		}
+		addr := pc + uint64(inst.Len) + uint64(a)
+		return f.asmAddr(symname, addr)
+
+	case Reg:
+		if f.Syntax == SyntaxMASM && F0 <= a && a <= F7 {
+			return f.name(fmt.Sprintf("st(%d)", a-F0))
+		}
+		if M0 <= a && a <= M7 {
+			return f.name(fmt.Sprintf("mm%d", a-M0))
+		}
+		if int(a) < len(intelReg) && intelReg[a] != "" {
+			return f.name(intelReg[a])
+		}
+	}
+	return f.name(strings.ToLower(arg.String()))
+}
+
+// asmAddr returns the symbolic form of addr, like sym+0x10 or sym+10h,
+// or else the address itself.
+func (f *Formatter) asmAddr(symname SymLookup, addr uint64) string {
+	if symname != nil {
+		if s, base := symname(addr); s != "" {
+			if addr != base {
+				s += f.offset(int64(addr - base))
+			}
+			return s
+		}
+	}
+	return f.hex(addr)
+}
+
+// asmImmBytes returns the size in bytes of the operation
+// that uses the immediate argument of inst.
+func asmImmBytes(inst *Inst) int {
+	switch a := inst.Args[0].(type) {
+	case Reg:
+		if n := regBytes(a); 1 <= n && n <= 4 {
+			return n
+		}
+	case Mem:
+		if 1 <= inst.MemBytes && inst.MemBytes <= 4 {
+			return inst.MemBytes
+		}
+	}
+	if inst.DataSize == 16 {
+		return 2
+	}
+	return 4
+}
+
+func (f *Formatter) asmMem(inst *Inst, pc uint64, symname SymLookup, a Mem) string {
+	masm := f.Syntax == SyntaxMASM
+	// A RIP-relative address is written relative to the instruction
+	// or, given the pc, as the absolute address or symbol.
+	rel := ""
+	if a.Base == RIP || a.Base == EIP {
+		if addr, ok := memTarget(inst, pc, a); ok {
+			rel = f.asmAddr(symname, addr)
+		} else {
+			rel = "$" + f.offset(int64(inst.Len)+a.Disp)
+		}
+		a.Base = 0
+	}
+
+	// Leave out the segment when it is the default.
+	switch inst.Op {
+	case MOVSB, MOVSW, MOVSD, MOVSQ, CMPSB, CMPSW, CMPSD, CMPSQ, STOSB, STOSW, STOSD, STOSQ, SCASB, SCASW, SCASD, SCASQ, LODSB, LODSW, LODSD, LODSQ:
+		// MASM insists on the ES for the destination.
+		switch a.Base {
+		case SI, ESI, RSI:
+			if a.Segment == DS {
+				a.Segment = 0
+			}
+		}
+	case LEA:
+		a.Segment = 0
+	default:
+		switch a.Base {
+		case SP, ESP, RSP, BP, EBP, RBP:
+			if a.Segment == SS {
+				a.Segment = 0
+			}
+		default:
+			if a.Segment == DS {
+				a.Segment = 0
+			}
+		}
+	}
+	if inst.Mode == 64 && a.Segment != FS && a.Segment != GS {
+		a.Segment = 0
+	}
+
+	var s string
+	if a.Base != 0 {
+		s += f.asmArg(inst, pc, symname, a.Base)
+	}
+	if a.Index != 0 {
+		if s != "" {
+			s += "+"
+		}
+		s += f.asmArg(inst, pc, symname, a.Index)
+		if a.Scale > 1 || a.Base == 0 {
+			s += fmt.Sprintf("*%d", a.Scale)
+		}
+	}
+	switch {
+	case rel != "":
+		s = rel
+	case s == "":
+		s = f.hex(uint64(a.Disp))
+		if a.Disp < 0 && int64(int32(a.Disp)) == a.Disp {
+			s = f.disp(inst, a.Disp)
+		}
+	case a.Disp != 0:
+		s += f.dispOffset(inst, a.Disp)
+	}
+
+	size := asmMemSize(inst, masm)
+	if masm {
+		if a.Segment != 0 {
+			s = f.name(strings.ToLower(a.Segment.String())) + ":[" + s + "]"
+		} else {
+			s = "[" + s + "]"
+		}
+		switch {
+		case size == "":
+			return s
+		case inst.Bcst != 0:
+			return f.name(size+" bcst ") + s
+		}
+		return f.name(size+" ptr ") + s
+	}
+
+	if a.Segment != 0 {
+		s = f.name(strings.ToLower(a.Segment.String())) + ":" + s
+	}
+	switch {
+	case rel != "":
+		s = f.name("rel ") + s
+	case a.Base == 0 && a.Index != 0:
+		// Keep NASM from turning [eax*2] into [eax+eax].
+		s = f.name("nosplit ") + s
+	}
+	s = "[" + s + "]"
+	if size != "" {
+		s = f.name(size+" ") + s
+	}
+	return s
+}
+
+// asmMemSize returns the NASM or MASM name for the size
+// of the memory argument of inst.
+func asmMemSize(inst *Inst, masm bool) string {
+	n := inst.MemBytes
+	switch inst.Op {
+	case STOSB, MOVSB, CMPSB, LODSB, SCASB:
+		n = 1
+	case STOSW, MOVSW, CMPSW, LODSW, SCASW:
+		n = 2
+	case STOSD, MOVSD, CMPSD, LODSD, SCASD:
+		n = 4
+	case STOSQ, MOVSQ, CMPSQ, LODSQ, SCASQ:
+		n = 8
+	case LCALL, LJMP, LDS, LES, LFS, LGS, LSS:
+		// NASM takes the size of a far pointer from the operand size.
+		if !masm {
+			return ""
+		}
+		n = inst.DataSize/8 + 2
+	}
+	if masm {
+		return masmSize[n]
+	}
+	return nasmSize[n]
+}
+
+var nasmOp = map[Op]string{
+	ICEBP:     "int1",
+	IRET:      "iretw",
+	LCALL:     "call",
+	LJMP:      "jmp",
+	LRET:      "retf",
+	MOVSD_XMM: "movsd",
+	POPA:      "popaw",
+	POPF:      "popfw",
+	PUSHA:     "pushaw",
+	PUSHF:     "pushfw",
+}
+
+var nasmSize = map[int]string{
+	1:  "byte",
+	2:  "word",
+	4:  "dword",
+	8:  "qword",
+	10: "tword",
+	16: "oword",
+	32: "yword",
+	64: "zword",
+}
@@ -0,0 +1,199 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Support for testing against NASM and, in masmext_test.go, MASM.
+
+package x86asm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestNASM32(t *testing.T) { testNASM(t, 32) }
+func TestNASM64(t *testing.T) { testNASM(t, 64) }
+
+func testNASM(t *testing.T, mode int) {
+	if testing.Short() {
+		t.Skip("skipping nasm test in short mode")
+	}
+	if _, err := exec.LookPath("nasm"); err != nil {
+		t.Skip("skipping nasm test: nasm not found")
+	}
+	testAsmRoundTrip(t, mode, NASMSyntax, nasmAsm)
+}
+
+// An asmFunc assembles lines in dir, each padded to asmSlot bytes,
+// and returns the code for each line.
+// If the assembler rejects some lines, it instead returns
+// their error messages, indexed by line, or under -1
+// if the messages do not name a line.
+type asmFunc func(dir string, mode int, lines []string) (code map[int][]byte, errs map[int]string, err error)
+
+// asmSlot is the number of bytes each line is assembled into,
+// enough for any instruction.
+const asmSlot = 32
+
+// testAsmRoundTrip checks that the text that syntax prints for the
+// instructions in testdata/decode.txt assembles, using assemble,
+// to instructions that syntax prints the same way.
+// Text that the assembler rejects is only logged, with -mismatch.
+func testAsmRoundTrip(t *testing.T, mode int, syntax func(Inst, uint64, SymLookup) string, assemble asmFunc) {
+	lines, err := asmTestLines(mode, syntax)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "x86asm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// The assembler may stop after some number of errors,
+	// so drop the rejected lines until it succeeds.
+	var rejected []string
+	var code map[int][]byte
+	for {
+		var errs map[int]string
+		code, errs, err = assemble(dir, mode, lines)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if errs == nil {
+			break
+		}
+		var keep []string
+		for i, line := range lines {
+			if msg, ok := errs[i]; ok {
+				rejected = append(rejected, fmt.Sprintf("%s: %s", line, msg))
+				continue
+			}
+			keep = append(keep, line)
+		}
+		if len(keep) == len(lines) {
+			t.Fatalf("assembler failed without reporting a line:\n%s", errs[-1])
+		}
+		lines = keep
+	}
+	if *mismatch {
+		for _, r := range rejected {
+			t.Logf("rejected: %s", r)
+		}
+	}
+
+	ok := 0
+	for i, line := range lines {
+		enc := code[i]
+		inst, err := Decode(enc, mode)
+		if err != nil {
+			t.Errorf("%s: assembled to % x: %v", line, enc, err)
+			continue
+		}
+		enc = enc[:inst.Len]
+		if text := syntax(inst, 0, nil); text != line {
+			t.Errorf("%s: assembled to % x, which decodes as %s", line, enc, text)
+			continue
+		}
+		ok++
+	}
+	t.Logf("%d round trips, %d lines rejected by the assembler", ok, len(rejected))
+}
+
+// asmTestLines returns the distinct text that syntax prints
+// for the valid instructions in testdata/decode.txt in the given mode.
+func asmTestLines(mode int, syntax func(Inst, uint64, SymLookup) string) ([]string, error) {
+	data, err := ioutil.ReadFile("testdata/decode.txt")
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	var lines []string
+Lines:
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.SplitN(strings.TrimSpace(line), "\t", 4)
+		if len(f) < 4 || f[1] != strconv.Itoa(mode) {
+			continue
+		}
+		code, err := hex.DecodeString(strings.Replace(f[0], "|", "", -1))
+		if err != nil {
+			continue
+		}
+		inst, err := Decode(code, mode)
+		if err != nil || inst.Op == 0 {
+			continue
+		}
+		for _, p := range inst.Prefix {
+			if p&PrefixInvalid != 0 {
+				continue Lines
+			}
+		}
+		text := syntax(inst, 0, nil)
+		if seen[text] {
+			continue
+		}
+		seen[text] = true
+		lines = append(lines, text)
+	}
+	return lines, nil
+}
+
+var nasmError = regexp.MustCompile(`^x\.asm:(\d+): (?:error|fatal): (.*)`)
+
+// nasmAsm assembles lines with nasm, as an asmFunc.
+func nasmAsm(dir string, mode int, lines []string) (code map[int][]byte, errs map[int]string, err error) {
+	// Line i is x.asm:2*i+2.
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "bits %d\n", mode)
+	for i, line := range lines {
+		fmt.Fprintf(&buf, "L%d: %s\n\ttimes %d-($-L%d) db 0xcc\n", i, line, asmSlot, i)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "x.asm"), buf.Bytes(), 0666); err != nil {
+		return nil, nil, err
+	}
+
+	cmd := exec.Command("nasm", "-f", "bin", "-o", "x.bin", "x.asm")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		errs = map[int]string{}
+		for _, msg := range strings.Split(string(out), "\n") {
+			if m := nasmError.FindStringSubmatch(msg); m != nil {
+				n, _ := strconv.Atoi(m[1])
+				if i := (n - 2) / 2; 0 <= i && i < len(lines) {
+					errs[i] = m[2]
+					continue
+				}
+			}
+			if msg != "" {
+				errs[-1] += msg + "\n"
+			}
+		}
+		if errs[-1] == "" {
+			errs[-1] = err.Error()
+		}
+		return nil, errs, nil
+	}
+
+	bin, err := ioutil.ReadFile(filepath.Join(dir, "x.bin"))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(bin) != asmSlot*len(lines) {
+		return nil, nil, fmt.Errorf("nasm wrote %d bytes for %d lines", len(bin), len(lines))
+	}
+	code = map[int][]byte{}
+	for i := range lines {
+		code[i] = bin[asmSlot*i : asmSlot*(i+1)]
+	}
+	return code, nil, nil
+}
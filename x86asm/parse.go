@@ -0,0 +1,200 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package x86asm
+
+import (
+	"strconv"
+	"strings"
+)
+
+// parseSearch returns the instruction decoded from an encoding of
+// the first candidate instruction for which match reports true.
+// The parsers use it to undo the simplifications made when printing
+// an instruction: they list every instruction the text might describe,
+// and match checks that the printed form of each is the original text.
+// Every encoding of a candidate is tried, not just the one Encode
+// prefers, since the syntaxes print some forms differently, like
+// INT3 and INT 3, or a shift by 1 with and without an immediate.
+func parseSearch(cands []Inst, match func(Inst) bool) (Inst, bool) {
+	return parseSearchVariants(cands, nil, match)
+}
+
+// parseSearchVariants is like parseSearch but also tries
+// the encodings that vary returns for each encoding.
+func parseSearchVariants(cands []Inst, vary func([]byte) [][]byte, match func(Inst) bool) (Inst, bool) {
+	tried := map[Inst]bool{}
+	for _, c := range cands {
+		for _, enc := range encodings(c) {
+			encs := [][]byte{enc}
+			if swap := mandatoryFirst(enc); swap != nil {
+				encs = append(encs, swap)
+			}
+			if vary != nil {
+				encs = append(encs, vary(enc)...)
+			}
+			for _, enc := range encs {
+				inst, err := Decode(enc, c.Mode)
+				if err != nil || tried[inst] {
+					continue
+				}
+				tried[inst] = true
+				if match(inst) {
+					return inst, true
+				}
+			}
+		}
+	}
+	return Inst{}, false
+}
+
+// mandatoryFirst returns enc with a mandatory F2 or F3 prefix moved
+// before the other legacy prefixes, or nil if there is no such prefix.
+// Encode puts the mandatory prefix last, but GoSyntax prints only
+// the last prefix, so the text may call for the other order.
+func mandatoryFirst(enc []byte) []byte {
+	n := legacyPrefixLen(enc)
+	if n < 2 || enc[n-1] != 0xF2 && enc[n-1] != 0xF3 {
+		return nil
+	}
+	swap := append([]byte{enc[n-1]}, enc[:n-1]...)
+	return append(swap, enc[n:]...)
+}
+
+// legacyPrefixLen returns the number of legacy prefix bytes
+// at the start of enc.
+func legacyPrefixLen(enc []byte) int {
+	n := 0
+	for n < len(enc) {
+		switch enc[n] {
+		case 0x26, 0x2E, 0x36, 0x3E, 0x64, 0x65, 0x66, 0x67, 0xF0, 0xF2, 0xF3:
+			n++
+			continue
+		}
+		break
+	}
+	return n
+}
+
+// splitArgs splits s at the commas that are not inside
+// brackets, braces, or parentheses.
+func splitArgs(s string) []string {
+	var args []string
+	depth := 0
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '[', '{', '(':
+			depth++
+		case ']', '}', ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				args = append(args, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(args, strings.TrimSpace(s[start:]))
+}
+
+// parseInt parses a signed decimal or 0x-prefixed hexadecimal number,
+// accepting unsigned 64-bit values as their two's complement.
+func parseInt(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	neg := false
+	switch {
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	case strings.HasPrefix(s, "-"):
+		neg = true
+		s = s[1:]
+	}
+	v, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return 0, err
+	}
+	if neg {
+		return -int64(v), nil
+	}
+	return int64(v), nil
+}
+
+// immBits returns the number of significant bits in the immediate
+// arguments of inst: the size of the operation, which the syntaxes
+// may print sign-extended to a larger size.
+func immBits(inst *Inst) int {
+	switch inst.Op {
+	case AAD, AAM, INT:
+		return 8
+	case ENTER, RET, LRET:
+		return 16
+	}
+	for _, a := range inst.Args {
+		switch a := a.(type) {
+		case Reg:
+			if n := regBits(a); n != 0 {
+				return n
+			}
+			if AL <= a && a <= R15B {
+				return 8
+			}
+		case Mem:
+			switch inst.MemBytes {
+			case 1, 2, 4, 8:
+				return 8 * inst.MemBytes
+			}
+		}
+	}
+	if inst.DataSize == 0 {
+		return 64
+	}
+	return inst.DataSize
+}
+
+// sameImm reports whether the immediates x and y have the same
+// low n bits.
+func sameImm(x, y Imm, n int) bool {
+	if n >= 64 {
+		return x == y
+	}
+	mask := uint64(1)<<uint(n) - 1
+	return uint64(x)&mask == uint64(y)&mask
+}
+
+// immAlternatives returns args followed by the lists that replace
+// an immediate printed as an unsigned value, like 0xb8, 0xffb8,
+// or 0xffffaccc, by the smaller signed value it extends, like -0x48
+// or -0x5334, which an encoding with a smaller immediate accepts.
+func immAlternatives(args []Arg) [][]Arg {
+	alts := [][]Arg{args}
+	for i, a := range args {
+		v, ok := a.(Imm)
+		if !ok || v < 0 || v >= 1<<32 {
+			continue
+		}
+		var last Imm
+		for _, n := range []uint{8, 16, 32} {
+			sv := v << (64 - n) >> (64 - n)
+			if sv >= 0 || sv == last || !truncates(sv, v) {
+				continue
+			}
+			alt := append([]Arg(nil), args...)
+			alt[i] = sv
+			alts = append(alts, alt)
+			last = sv
+		}
+	}
+	return alts
+}
+
+// truncates reports whether v is sv truncated to 8, 16, or 32 bits.
+func truncates(sv, v Imm) bool {
+	for _, n := range []uint{8, 16, 32} {
+		if v == sv&(1<<n-1) {
+			return true
+		}
+	}
+	return false
+}
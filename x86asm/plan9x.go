@@ -4,19 +4,25 @@
 
 package x86asm
 
-import (
-	"fmt"
-	"strings"
-)
+import "fmt"
 
-// plan9Syntax returns the Go assembler syntax for the instruction.
+// A SymLookup queries the symbol table for the program being disassembled.
+// Given an address, it returns the name and base address of the symbol
+// containing that address, if any; otherwise it returns "", 0.
+type SymLookup func(addr uint64) (name string, base uint64)
+
+// GoSyntax returns the Go assembler syntax for the instruction.
 // The syntax was originally defined by Plan 9.
 // The pc is the program counter of the instruction, used for expanding
 // PC-relative addresses into absolute ones.
-// The symname function queries the symbol table for the program
-// being disassembled. Given a target address it returns the name and base
-// address of the symbol containing the target, if any; otherwise it returns "", 0.
-func plan9Syntax(inst Inst, pc uint64, symname func(uint64) (string, uint64)) string {
+// The symname function, which may be nil, names the targets
+// of those addresses and of absolute addresses.
+func GoSyntax(inst Inst, pc uint64, symname SymLookup) string {
+	f := Formatter{Syntax: SyntaxGo}
+	return f.goSyntax(inst, pc, symname)
+}
+
+func (f *Formatter) goSyntax(inst Inst, pc uint64, symname SymLookup) string {
 	if symname == nil {
 		symname = func(uint64) (string, uint64) { return "", 0 }
 	}
@@ -26,12 +32,16 @@ func plan9Syntax(inst Inst, pc uint64, symname func(uint64) (string, uint64)) st
 		if a == nil {
 			continue
 		}
-		args = append(args, plan9Arg(&inst, pc, symname, a))
+		if i == 0 && inst.Mask != 0 {
+			// The opmask is an extra operand before the destination.
+			args = append(args, f.plan9Arg(&inst, pc, symname, inst.Mask))
+		}
+		args = append(args, f.plan9Arg(&inst, pc, symname, a))
 	}
 
 	var last Prefix
 	for _, p := range inst.Prefix {
-		if p == 0 || p.IsREX() {
+		if p == 0 || p.IsREX() || p.IsVEX() {
 			break
 		}
 		last = p
@@ -61,17 +71,32 @@ func plan9Syntax(inst Inst, pc uint64, symname func(uint64) (string, uint64)) st
 		}
 	}
 
-	if args != nil {
-		op += " " + strings.Join(args, ", ")
+	switch inst.Round {
+	case RoundNearest:
+		op += ".RN_SAE"
+	case RoundDown:
+		op += ".RD_SAE"
+	case RoundUp:
+		op += ".RU_SAE"
+	case RoundZero:
+		op += ".RZ_SAE"
+	case RoundSAE:
+		op += ".SAE"
+	}
+	if inst.Bcst != 0 {
+		op += ".BCST"
+	}
+	if inst.Zero {
+		op += ".Z"
 	}
 
-	return prefix + op
+	return f.join(prefix, op, args)
 }
 
-func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg Arg) string {
+func (f *Formatter) plan9Arg(inst *Inst, pc uint64, symname SymLookup, arg Arg) string {
 	switch a := arg.(type) {
 	case Reg:
-		return plan9Reg[a]
+		return f.name(plan9Reg[a])
 	case Rel:
 		if pc == 0 {
 			break
@@ -85,7 +110,7 @@ func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg
 		if s, base := symname(addr); s != "" && addr == base {
 			return fmt.Sprintf("%s(SB)", s)
 		}
-		return fmt.Sprintf("%#x", addr)
+		return f.hex(addr)
 
 	case Imm:
 		if s, base := symname(uint64(a)); s != "" {
@@ -96,96 +121,139 @@ func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg
 			return fmt.Sprintf("$%s%s(SB)", s, suffix)
 		}
 		if inst.Mode == 32 {
-			return fmt.Sprintf("$%#x", uint32(a))
+			return "$" + f.imm(a, 4)
 		}
 		if Imm(int32(a)) == a {
-			return fmt.Sprintf("$%#x", int64(a))
+			return "$" + f.imm(a, 0)
 		}
-		return fmt.Sprintf("$%#x", uint64(a))
+		return "$" + f.imm(a, 8)
 	case Mem:
-		if a.Segment == 0 && a.Disp != 0 && a.Base == 0 && (a.Index == 0 || a.Scale == 0) {
-			if s, base := symname(uint64(a.Disp)); s != "" {
+		if addr, ok := memTarget(inst, pc, a); ok {
+			if s, base := symname(addr); s != "" {
 				suffix := ""
-				if uint64(a.Disp) != base {
-					suffix = fmt.Sprintf("%+d", uint64(a.Disp)-base)
+				if addr != base {
+					suffix = fmt.Sprintf("%+d", addr-base)
 				}
 				return fmt.Sprintf("%s%s(SB)", s, suffix)
 			}
 		}
 		s := ""
 		if a.Segment != 0 {
-			s += fmt.Sprintf("%s:", plan9Reg[a.Segment])
+			s += fmt.Sprintf("%s:", f.name(plan9Reg[a.Segment]))
 		}
 		if a.Disp != 0 {
-			s += fmt.Sprintf("%#x", a.Disp)
+			s += f.disp(inst, a.Disp)
 		} else {
 			s += "0"
 		}
 		if a.Base != 0 {
-			s += fmt.Sprintf("(%s)", plan9Reg[a.Base])
+			s += fmt.Sprintf("(%s)", f.name(plan9Reg[a.Base]))
 		}
 		if a.Index != 0 && a.Scale != 0 {
-			s += fmt.Sprintf("(%s*%d)", plan9Reg[a.Index], a.Scale)
+			s += fmt.Sprintf("(%s*%d)", f.name(plan9Reg[a.Index]), a.Scale)
 		}
 		return s
 	}
-	return arg.String()
+	return f.name(arg.String())
+}
+
+// memTarget returns the address that the memory argument a of inst
+// refers to, if it is an absolute address or, given the pc of inst,
+// a RIP-relative one.
+func memTarget(inst *Inst, pc uint64, a Mem) (uint64, bool) {
+	if a.Segment != 0 || a.Index != 0 && a.Scale != 0 {
+		return 0, false
+	}
+	switch a.Base {
+	case 0:
+		if a.Disp != 0 {
+			return uint64(a.Disp), true
+		}
+	case RIP:
+		if pc != 0 {
+			return pc + uint64(inst.Len) + uint64(a.Disp), true
+		}
+	case EIP:
+		if pc != 0 {
+			return uint64(uint32(pc + uint64(inst.Len) + uint64(a.Disp))), true
+		}
+	}
+	return 0, false
 }
 
 var plan9Suffix = [maxOp + 1]bool{
-	ADC:       true,
-	ADD:       true,
-	AND:       true,
-	BSF:       true,
-	BSR:       true,
-	BT:        true,
-	BTC:       true,
-	BTR:       true,
-	BTS:       true,
-	CMP:       true,
-	CMPXCHG:   true,
-	CVTSI2SD:  true,
-	CVTSI2SS:  true,
-	CVTSD2SI:  true,
-	CVTSS2SI:  true,
-	CVTTSD2SI: true,
-	CVTTSS2SI: true,
-	DEC:       true,
-	DIV:       true,
-	FLDENV:    true,
-	FRSTOR:    true,
-	IDIV:      true,
-	IMUL:      true,
-	IN:        true,
-	INC:       true,
-	LEA:       true,
-	MOV:       true,
-	MOVNTI:    true,
-	MUL:       true,
-	NEG:       true,
-	NOP:       true,
-	NOT:       true,
-	OR:        true,
-	OUT:       true,
-	POP:       true,
-	POPA:      true,
-	PUSH:      true,
-	PUSHA:     true,
-	RCL:       true,
-	RCR:       true,
-	ROL:       true,
-	ROR:       true,
-	SAR:       true,
-	SBB:       true,
-	SHL:       true,
-	SHLD:      true,
-	SHR:       true,
-	SHRD:      true,
-	SUB:       true,
-	TEST:      true,
-	XADD:      true,
-	XCHG:      true,
-	XOR:       true,
+	ADC:        true,
+	ADD:        true,
+	AND:        true,
+	ANDN:       true,
+	BEXTR:      true,
+	BLSI:       true,
+	BLSMSK:     true,
+	BLSR:       true,
+	BSF:        true,
+	BSR:        true,
+	BT:         true,
+	BTC:        true,
+	BTR:        true,
+	BTS:        true,
+	BZHI:       true,
+	CMP:        true,
+	CMPXCHG:    true,
+	CVTSI2SD:   true,
+	CVTSI2SS:   true,
+	CVTSD2SI:   true,
+	CVTSS2SI:   true,
+	CVTTSD2SI:  true,
+	CVTTSS2SI:  true,
+	DEC:        true,
+	DIV:        true,
+	FLDENV:     true,
+	FRSTOR:     true,
+	IDIV:       true,
+	IMUL:       true,
+	IN:         true,
+	INC:        true,
+	LEA:        true,
+	MOV:        true,
+	MOVNTI:     true,
+	MUL:        true,
+	MULX:       true,
+	NEG:        true,
+	NOP:        true,
+	NOT:        true,
+	OR:         true,
+	OUT:        true,
+	PDEP:       true,
+	PEXT:       true,
+	POP:        true,
+	POPA:       true,
+	PUSH:       true,
+	PUSHA:      true,
+	RCL:        true,
+	RCR:        true,
+	ROL:        true,
+	ROR:        true,
+	RORX:       true,
+	SAR:        true,
+	SARX:       true,
+	SBB:        true,
+	SHL:        true,
+	SHLD:       true,
+	SHLX:       true,
+	SHR:        true,
+	SHRD:       true,
+	SHRX:       true,
+	SUB:        true,
+	TEST:       true,
+	VCVTSD2SI:  true,
+	VCVTSI2SD:  true,
+	VCVTSI2SS:  true,
+	VCVTSS2SI:  true,
+	VCVTTSD2SI: true,
+	VCVTTSS2SI: true,
+	XADD:       true,
+	XCHG:       true,
+	XOR:        true,
 }
 
 var plan9Reg = [...]string{
@@ -292,6 +360,94 @@ var plan9Reg = [...]string{
 	X13:  "X13",
 	X14:  "X14",
 	X15:  "X15",
+	X16:  "X16",
+	X17:  "X17",
+	X18:  "X18",
+	X19:  "X19",
+	X20:  "X20",
+	X21:  "X21",
+	X22:  "X22",
+	X23:  "X23",
+	X24:  "X24",
+	X25:  "X25",
+	X26:  "X26",
+	X27:  "X27",
+	X28:  "X28",
+	X29:  "X29",
+	X30:  "X30",
+	X31:  "X31",
+	Y0:   "Y0",
+	Y1:   "Y1",
+	Y2:   "Y2",
+	Y3:   "Y3",
+	Y4:   "Y4",
+	Y5:   "Y5",
+	Y6:   "Y6",
+	Y7:   "Y7",
+	Y8:   "Y8",
+	Y9:   "Y9",
+	Y10:  "Y10",
+	Y11:  "Y11",
+	Y12:  "Y12",
+	Y13:  "Y13",
+	Y14:  "Y14",
+	Y15:  "Y15",
+	Y16:  "Y16",
+	Y17:  "Y17",
+	Y18:  "Y18",
+	Y19:  "Y19",
+	Y20:  "Y20",
+	Y21:  "Y21",
+	Y22:  "Y22",
+	Y23:  "Y23",
+	Y24:  "Y24",
+	Y25:  "Y25",
+	Y26:  "Y26",
+	Y27:  "Y27",
+	Y28:  "Y28",
+	Y29:  "Y29",
+	Y30:  "Y30",
+	Y31:  "Y31",
+	Z0:   "Z0",
+	Z1:   "Z1",
+	Z2:   "Z2",
+	Z3:   "Z3",
+	Z4:   "Z4",
+	Z5:   "Z5",
+	Z6:   "Z6",
+	Z7:   "Z7",
+	Z8:   "Z8",
+	Z9:   "Z9",
+	Z10:  "Z10",
+	Z11:  "Z11",
+	Z12:  "Z12",
+	Z13:  "Z13",
+	Z14:  "Z14",
+	Z15:  "Z15",
+	Z16:  "Z16",
+	Z17:  "Z17",
+	Z18:  "Z18",
+	Z19:  "Z19",
+	Z20:  "Z20",
+	Z21:  "Z21",
+	Z22:  "Z22",
+	Z23:  "Z23",
+	Z24:  "Z24",
+	Z25:  "Z25",
+	Z26:  "Z26",
+	Z27:  "Z27",
+	Z28:  "Z28",
+	Z29:  "Z29",
+	Z30:  "Z30",
+	Z31:  "Z31",
+	K0:   "K0",
+	K1:   "K1",
+	K2:   "K2",
+	K3:   "K3",
+	K4:   "K4",
+	K5:   "K5",
+	K6:   "K6",
+	K7:   "K7",
 	CS:   "CS",
 	SS:   "SS",
 	DS:   "DS",
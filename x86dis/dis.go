@@ -0,0 +1,357 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// X86dis disassembles the executable sections of x86 binaries
+// in ELF, PE, and Mach-O format.
+//
+// Usage:
+//
+//	x86dis [-syntax=syntax] [-func=regexp] [-source] [-remap=old=new] file...
+//
+// X86dis decodes each executable section from start to end,
+// starting afresh at each function symbol, and prints each
+// function's instructions under its name. The symbols come from
+// .symtab or .dynsym in ELF files, from the COFF symbol table and
+// the export directory in PE files, and from the symbol table in
+// Mach-O files. For Go binaries, they also come from the Go runtime's
+// function table (pclntab), which remains in stripped binaries,
+// and each instruction at which the source line changes
+// is shown with its file and line, as in go tool objdump.
+// Branch and call targets and RIP-relative addresses are shown
+// as the names of the functions and data symbols they refer to.
+//
+// In unlinked ELF object files (.o files), addresses are offsets in their
+// sections, and the fields that the linker fills in are shown by their
+// relocations: a relocated branch or RIP-relative address is shown as the
+// symbol, as in "call foo" or "[rip+bar@GOTPCREL]", and other relocated
+// fields, such as absolute addresses, are listed in a trailing comment.
+//
+// The -syntax flag selects the assembler syntax:
+// gnu (the default), intel, go, nasm, or masm.
+// The -func flag restricts the output to functions whose names match
+// the given regular expression.
+//
+// The -source flag interleaves the source lines with the instructions,
+// as objdump -S does, along with the starts and ends of inlined calls,
+// using the DWARF line table and debugging information if present and
+// otherwise the Go line table. The -remap=old=new flag reads source files
+// whose names begin with old from the same path beginning with new instead,
+// for binaries built elsewhere; it may be repeated.
+package main
+
+import (
+	"bytes"
+	"debug/dwarf"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"text/tabwriter"
+
+	"rsc.io/x86/x86asm"
+)
+
+var (
+	syntaxFlag = flag.String("syntax", "gnu", "print instructions in `syntax` gnu, intel, go, nasm, or masm")
+	funcFlag   = flag.String("func", "", "only disassemble functions matching `regexp`")
+	sourceFlag = flag.Bool("source", false, "interleave source lines and inlined calls")
+	remapFlag  remapList
+)
+
+func init() {
+	flag.Var(&remapFlag, "remap", "read source files named with prefix old from prefix new (`old=new`); may be repeated")
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: x86dis [-syntax=syntax] [-func=regexp] [-source] [-remap=old=new] file...\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+var syntaxes = map[string]x86asm.Syntax{
+	"gnu":   x86asm.SyntaxGNU,
+	"intel": x86asm.SyntaxIntel,
+	"go":    x86asm.SyntaxGo,
+	"nasm":  x86asm.SyntaxNASM,
+	"masm":  x86asm.SyntaxMASM,
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("x86dis: ")
+
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+	}
+
+	syntax, ok := syntaxes[*syntaxFlag]
+	if !ok {
+		log.Fatalf("invalid -syntax %q: must be gnu, intel, go, nasm, or masm", *syntaxFlag)
+	}
+	opt := &options{
+		syntax: syntax,
+		source: *sourceFlag,
+		remap:  remapFlag,
+	}
+	if *funcFlag != "" {
+		var err error
+		opt.match, err = regexp.Compile(*funcFlag)
+		if err != nil {
+			log.Fatalf("invalid -func: %v", err)
+		}
+	}
+
+	exit := 0
+	for i, file := range flag.Args() {
+		if flag.NArg() > 1 {
+			if i > 0 {
+				fmt.Println()
+			}
+			fmt.Printf("%s:\n", file)
+		}
+		if err := dump(os.Stdout, file, opt); err != nil {
+			log.Print(err)
+			exit = 1
+		}
+	}
+	os.Exit(exit)
+}
+
+// options holds the settings from the command line.
+type options struct {
+	syntax x86asm.Syntax
+	match  *regexp.Regexp // functions to print, or nil for all
+	source bool           // interleave source lines
+	remap  remapList      // source path prefixes to replace
+}
+
+// An objFile is the code and symbols of an object file.
+type objFile struct {
+	mode  int
+	text  []section // executable sections
+	syms  symtab    // all symbols, for resolving addresses
+	funcs symtab    // function symbols, for labeling code
+	sects symtab    // sections, for addresses no symbol covers
+
+	// lineOf, if not nil, returns the source file and line
+	// of the instruction at pc, or "", 0 if unknown.
+	lineOf func(pc uint64) (file string, line int)
+
+	// dwarf, if not nil, is the file's DWARF debugging information.
+	// It is only read if needed.
+	dwarf *dwarf.Data
+
+	// relocatable reports whether the file is unlinked.
+	// Its sections are laid out at addresses starting at relocBase,
+	// but addresses are printed as offsets in their sections.
+	relocatable bool
+}
+
+// A section is an executable section of an objFile.
+type section struct {
+	name   string
+	addr   uint64
+	data   []byte
+	relocs []reloc // relocations, sorted by address, in relocatable files
+}
+
+// A sym is a symbol in an objFile.
+type sym struct {
+	name string
+	addr uint64
+	size uint64
+}
+
+// addSym adds a symbol without a known size to obj.
+func (obj *objFile) addSym(name string, addr uint64, isFunc bool) {
+	s := sym{name, addr, 0}
+	obj.syms = append(obj.syms, s)
+	if isFunc {
+		obj.funcs = append(obj.funcs, s)
+	}
+}
+
+// A symtab is a list of symbols sorted by address.
+type symtab []sym
+
+// sort sorts t by address, placing the largest of the symbols
+// at an address last, so that lookup finds it.
+func (t symtab) sort() {
+	sort.SliceStable(t, func(i, j int) bool {
+		if t[i].addr != t[j].addr {
+			return t[i].addr < t[j].addr
+		}
+		return t[i].size < t[j].size
+	})
+}
+
+// fillSizes sets the size of each symbol in t that has none
+// to extend up to the next symbol, for formats that do not record sizes.
+func (t symtab) fillSizes() {
+	for i := range t {
+		if t[i].size != 0 {
+			continue
+		}
+		for _, next := range t[i+1:] {
+			if next.addr > t[i].addr {
+				t[i].size = next.addr - t[i].addr
+				break
+			}
+		}
+	}
+}
+
+// lookup returns the symbol containing addr, as an x86asm.SymLookup.
+// A symbol with no size contains only its own address.
+func (t symtab) lookup(addr uint64) (string, uint64) {
+	i := sort.Search(len(t), func(i int) bool { return t[i].addr > addr }) - 1
+	if i >= 0 {
+		s := t[i]
+		if addr == s.addr || addr < s.addr+s.size {
+			return s.name, s.addr
+		}
+	}
+	return "", 0
+}
+
+// lookup returns the symbol containing addr, as an x86asm.SymLookup,
+// or if there is none, the section containing addr, if it is known.
+// Sections come last so that an address in a function at the start
+// of a section is shown as an offset from the function.
+func (obj *objFile) lookup(addr uint64) (string, uint64) {
+	if name, base := obj.syms.lookup(addr); name != "" {
+		return name, base
+	}
+	return obj.sects.lookup(addr)
+}
+
+// openObj reads the code and symbols of file, and with withDWARF
+// its DWARF information, choosing the parser by the file's leading
+// magic number.
+func openObj(file string, withDWARF bool) (*objFile, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	magic := make([]byte, 4)
+	_, err = io.ReadFull(f, magic)
+	f.Close()
+	if err != nil {
+		return nil, fmt.Errorf("%s: unrecognized file format", file)
+	}
+	switch {
+	case bytes.HasPrefix(magic, []byte("\x7fELF")):
+		return openELF(file, withDWARF)
+	case bytes.HasPrefix(magic, []byte("MZ")):
+		return openPE(file, withDWARF)
+	case bytes.Equal(magic, []byte("\xce\xfa\xed\xfe")), bytes.Equal(magic, []byte("\xcf\xfa\xed\xfe")):
+		return openMachO(file, withDWARF)
+	}
+	return nil, fmt.Errorf("%s: unrecognized file format", file)
+}
+
+// dump prints the disassembly of file to w.
+func dump(w io.Writer, file string, opt *options) error {
+	obj, err := openObj(file, opt.source)
+	if err != nil {
+		return err
+	}
+	var src *sourceView
+	if opt.source {
+		if src, err = newSourceView(obj, opt.remap); err != nil {
+			return fmt.Errorf("%s: reading DWARF: %v", file, err)
+		}
+	}
+
+	f := &x86asm.Formatter{Syntax: opt.syntax}
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, sect := range obj.text {
+		for _, c := range obj.chunks(sect) {
+			name := c.name
+			if name == "" {
+				name = sect.name
+			}
+			if opt.match != nil && !opt.match.MatchString(name) {
+				continue
+			}
+			fmt.Fprintf(tw, "%s:\n", name)
+			if src != nil {
+				src.reset()
+			}
+			var lastFile string
+			var lastLine int
+			d := x86asm.NewDisassembler(sect.data[c.start-sect.addr:c.end-sect.addr], c.start, obj.mode)
+			for {
+				pc, inst, raw, err := d.Next()
+				if err == io.EOF {
+					break
+				}
+				addr := pc // address to print
+				if obj.relocatable {
+					addr -= sect.addr
+				}
+				text := "(bad)"
+				if err == nil {
+					symname, notes := obj.instSyms(&sect, pc, inst)
+					text = f.Format(inst, pc, symname) + relocComment(opt.syntax, notes)
+				}
+				if src != nil {
+					if notes := src.annotate(addr); len(notes) > 0 {
+						tw.Flush()
+						for _, n := range notes {
+							fmt.Fprintln(w, n)
+						}
+					}
+				}
+				fmt.Fprint(tw, "  ")
+				if obj.lineOf != nil && src == nil {
+					// Show the source line in a column of its own
+					// where it changes.
+					pos := ""
+					if file, line := obj.lineOf(pc); file != "" && (file != lastFile || line != lastLine) {
+						pos = fmt.Sprintf("%s:%d", filepath.Base(file), line)
+						lastFile, lastLine = file, line
+					}
+					fmt.Fprintf(tw, "%s\t", pos)
+				}
+				fmt.Fprintf(tw, "%#x\t%x\t%s\n", addr, raw, text)
+			}
+			tw.Flush()
+		}
+	}
+	return tw.Flush()
+}
+
+// A chunk is a range of code in a section that is decoded from its start:
+// a function, or the code before the first function in a section.
+type chunk struct {
+	name       string // function name, or "" for code before the first function
+	start, end uint64
+}
+
+// chunks splits sect into chunks at the function symbols.
+func (obj *objFile) chunks(sect section) []chunk {
+	end := sect.addr + uint64(len(sect.data))
+	cs := []chunk{{"", sect.addr, end}}
+	for _, s := range obj.funcs {
+		if s.addr < sect.addr || s.addr >= end {
+			continue
+		}
+		last := &cs[len(cs)-1]
+		switch {
+		case s.addr > last.start:
+			last.end = s.addr
+			cs = append(cs, chunk{s.name, s.addr, end})
+		case last.name == "":
+			last.name = s.name
+		}
+	}
+	return cs
+}
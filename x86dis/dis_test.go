@@ -0,0 +1,286 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"rsc.io/x86/x86asm"
+)
+
+const testProg = `package main
+
+var v int
+
+//go:noinline
+func f(x int) int { return x*3 + 1 }
+
+func main() { v = f(v) }
+`
+
+// buildTestProg builds testProg for goos/goarch in dir,
+// stripping its symbols if strip is set,
+// and returns the name of the binary.
+func buildTestProg(t *testing.T, dir, goos, goarch string, strip bool) string {
+	src := filepath.Join(dir, "x.go")
+	if err := ioutil.WriteFile(src, []byte(testProg), 0666); err != nil {
+		t.Fatal(err)
+	}
+	exe := filepath.Join(dir, "x."+goos+"."+goarch)
+	args := []string{"build", "-o", exe}
+	if strip {
+		exe += ".s"
+		args = []string{"build", "-ldflags=-s", "-o", exe}
+	}
+	cmd := exec.Command("go", append(args, src)...)
+	cmd.Env = append(os.Environ(), "GOOS="+goos, "GOARCH="+goarch, "CGO_ENABLED=0", "GOFLAGS=")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go build: %v\n%s", err, out)
+	}
+	return exe
+}
+
+var dumpTests = []struct {
+	goos   string
+	goarch string
+	strip  bool
+	syntax x86asm.Syntax
+	want   []string
+}{
+	{"linux", "amd64", false, x86asm.SyntaxGo, []string{`CALL main\.f\(SB\)`, `MOVQ AX, main\.v\(SB\)`}},
+	{"linux", "amd64", false, x86asm.SyntaxGNU, []string{`callq main\.f\n`, `mov %rax,main\.v\(%rip\)`}},
+	{"linux", "amd64", false, x86asm.SyntaxIntel, []string{`call main\.f\n`, `mov qword ptr \[rip\+main\.v\], rax`}},
+	{"linux", "386", false, x86asm.SyntaxGo, []string{`CALL main\.f\(SB\)`, `MOVL AX, main\.v\(SB\)`}},
+	{"windows", "amd64", false, x86asm.SyntaxGo, []string{`CALL main\.f\(SB\)`, `MOVQ AX, main\.v\(SB\)`}},
+	{"windows", "386", false, x86asm.SyntaxGo, []string{`CALL main\.f\(SB\)`, `MOVL AX, main\.v\(SB\)`}},
+	{"darwin", "amd64", false, x86asm.SyntaxGo, []string{`CALL main\.f\(SB\)`, `MOVQ AX, main\.v\(SB\)`}},
+
+	// Stripped binaries still have the pclntab, for function names and lines,
+	// although data symbols like main.v are gone.
+	{"linux", "amd64", true, x86asm.SyntaxGNU, []string{`main\.main:\n  x\.go:8 `, `callq main\.f\n`}},
+	{"windows", "amd64", true, x86asm.SyntaxGNU, []string{`main\.main:\n  x\.go:8 `, `callq main\.f\n`}},
+	{"darwin", "amd64", true, x86asm.SyntaxGNU, []string{`main\.main:\n  x\.go:8 `, `callq main\.f\n`}},
+}
+
+func TestDump(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping x86dis test in short mode")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("skipping x86dis test: go command not found")
+	}
+	dir, err := ioutil.TempDir("", "x86dis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	exes := map[string]string{}
+	match := regexp.MustCompile(`^main\.main$`)
+	for _, tt := range dumpTests {
+		target := tt.goos + "/" + tt.goarch
+		if tt.strip {
+			target += " stripped"
+		}
+		exe := exes[target]
+		if exe == "" {
+			exe = buildTestProg(t, dir, tt.goos, tt.goarch, tt.strip)
+			exes[target] = exe
+		}
+		var buf bytes.Buffer
+		if err := dump(&buf, exe, &options{syntax: tt.syntax, match: match}); err != nil {
+			t.Errorf("%s %v: %v", target, tt.syntax, err)
+			continue
+		}
+		out := buf.String()
+		if !strings.HasPrefix(out, "main.main:\n") || strings.Contains(out, "main.f:") {
+			t.Errorf("%s %v: -func=%v did not select only main.main:\n%s", target, tt.syntax, match, out)
+			continue
+		}
+		for _, w := range tt.want {
+			if !regexp.MustCompile(w).MatchString(out) {
+				t.Errorf("%s %v: output does not match %s:\n%s", target, tt.syntax, w, out)
+			}
+		}
+	}
+}
+
+const testCProg = `#include <stdio.h>
+
+static inline int sq(int x) {
+	return x * x;
+}
+
+int __attribute__((noinline)) f(int x) {
+	return sq(x) + 1;
+}
+
+int main(int argc, char **argv) {
+	printf("%d\n", f(argc));
+	return 0;
+}
+`
+
+func TestDumpSource(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping x86dis test in short mode")
+	}
+	if _, err := exec.LookPath("cc"); err != nil {
+		t.Skip("skipping x86dis -source test: cc not found")
+	}
+	dir, err := ioutil.TempDir("", "x86dis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	src := filepath.Join(dir, "x.c")
+	if err := ioutil.WriteFile(src, []byte(testCProg), 0666); err != nil {
+		t.Fatal(err)
+	}
+	exe := filepath.Join(dir, "x")
+	cmd := exec.Command("cc", "-g", "-O2", "-o", exe, src)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Skipf("skipping x86dis -source test: cc failed: %v\n%s", err, out)
+	}
+
+	// Move the source, so that it can only be found with -remap.
+	moved := filepath.Join(dir, "moved")
+	if err := os.Mkdir(moved, 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(src, filepath.Join(moved, "x.c")); err != nil {
+		t.Fatal(err)
+	}
+
+	opt := &options{
+		syntax: x86asm.SyntaxGNU,
+		match:  regexp.MustCompile(`^f$`),
+		source: true,
+	}
+	var buf bytes.Buffer
+	if err := dump(&buf, exe, opt); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, src+":8\n") || strings.Contains(out, "return sq(x) + 1;") {
+		t.Errorf("-source without -remap: want position but no text:\n%s", out)
+	}
+
+	opt.remap = remapList{{dir, moved}}
+	buf.Reset()
+	if err := dump(&buf, exe, opt); err != nil {
+		t.Fatal(err)
+	}
+	out = buf.String()
+	want := []string{
+		`(?m)^inlined sq, called at ` + regexp.QuoteMeta(src) + `:8$`,
+		`(?m)^` + regexp.QuoteMeta(src) + `:4\n\treturn x \* x;\n  0x`,
+		`(?m)^end of inlined sq$`,
+		`(?m)^` + regexp.QuoteMeta(src) + `:8\n\treturn sq\(x\) \+ 1;\n  0x`,
+	}
+	for _, w := range want {
+		if !regexp.MustCompile(w).MatchString(out) {
+			t.Errorf("-source output does not match %s:\n%s", w, out)
+		}
+	}
+}
+
+const testRelocProg = `extern int g(int);
+extern int arr[];
+int counter;
+
+int f(int x) {
+	int s = 0;
+	while (x-- > 0)
+		s += g(x) + arr[x];
+	return s + counter;
+}
+
+int h(void) {
+	return counter;
+}
+`
+
+var relocTests = []struct {
+	flags  []string
+	syntax x86asm.Syntax
+	want   []string
+}{
+	{
+		[]string{"-fPIC"},
+		x86asm.SyntaxGNU,
+		[]string{`(?m)^f:\n  0x0 `, `callq g\n`, `mov arr@GOTPCREL\(%rip\),%r`, `mov counter@GOTPCREL\(%rip\),%r`},
+	},
+	{
+		[]string{"-fPIC"},
+		x86asm.SyntaxIntel,
+		[]string{`call g\n`, `qword ptr \[rip\+arr@GOTPCREL\]`},
+	},
+	{
+		[]string{"-fno-pic"},
+		x86asm.SyntaxGNU,
+		[]string{`callq g\n`, `\(,%r[a-z0-9]+,4\),%eax  # arr\n`, `counter\(%rip\)`},
+	},
+	{
+		[]string{"-m32", "-fPIC"},
+		x86asm.SyntaxGNU,
+		[]string{`call g\n`, `  # arr@GOT\n`, `  # counter@GOT`},
+	},
+	{
+		// Local branches within f, which starts its section,
+		// are shown relative to f, not to the section.
+		[]string{"-O1"},
+		x86asm.SyntaxGNU,
+		[]string{`j[a-z]+ f\+0x[0-9a-f]+\n`},
+	},
+}
+
+func TestDumpRelocatable(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping x86dis test in short mode")
+	}
+	if _, err := exec.LookPath("cc"); err != nil {
+		t.Skip("skipping x86dis relocation test: cc not found")
+	}
+	dir, err := ioutil.TempDir("", "x86dis")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	src := filepath.Join(dir, "x.c")
+	if err := ioutil.WriteFile(src, []byte(testRelocProg), 0666); err != nil {
+		t.Fatal(err)
+	}
+	for i, tt := range relocTests {
+		obj := filepath.Join(dir, fmt.Sprintf("x%d.o", i))
+		args := append([]string{"-O2", "-c", "-o", obj}, tt.flags...)
+		cmd := exec.Command("cc", append(args, src)...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Logf("skipping cc %v: %v\n%s", tt.flags, err, out)
+			continue
+		}
+		var buf bytes.Buffer
+		if err := dump(&buf, obj, &options{syntax: tt.syntax}); err != nil {
+			t.Errorf("cc %v: %v", tt.flags, err)
+			continue
+		}
+		out := buf.String()
+		for _, w := range tt.want {
+			if !regexp.MustCompile(w).MatchString(out) {
+				t.Errorf("cc %v, %v: output does not match %s:\n%s", tt.flags, tt.syntax, w, out)
+			}
+		}
+	}
+}
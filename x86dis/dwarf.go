@@ -0,0 +1,129 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Use of DWARF debugging information, for -source.
+
+package main
+
+import (
+	"debug/dwarf"
+	"io"
+	"sort"
+)
+
+// A lineTable maps addresses to source lines.
+// It holds the rows of the DWARF line programs, sorted by address.
+type lineTable []lineRow
+
+// A lineRow is a row of a DWARF line program.
+type lineRow struct {
+	addr uint64
+	file string // "" for the end of a sequence
+	line int
+}
+
+// lookup returns the source file and line of the instruction at pc,
+// or "", 0 if unknown, as an objFile.lineOf.
+func (t lineTable) lookup(pc uint64) (string, int) {
+	i := sort.Search(len(t), func(i int) bool { return t[i].addr > pc }) - 1
+	if i < 0 {
+		return "", 0
+	}
+	return t[i].file, t[i].line
+}
+
+// An inline is an address range holding an inlined call of a function.
+type inline struct {
+	name       string
+	start, end uint64
+	callFile   string
+	callLine   int
+}
+
+// readDWARF reads the line table and the inlined calls in d.
+func readDWARF(d *dwarf.Data) (lineTable, []inline, error) {
+	var (
+		lines   lineTable
+		inls    []inline
+		origins []dwarf.Offset // function of each of inls
+		names   = map[dwarf.Offset]string{}
+		files   []*dwarf.LineFile
+	)
+	r := d.Reader()
+	for {
+		e, err := r.Next()
+		if err != nil {
+			return nil, nil, err
+		}
+		if e == nil {
+			break
+		}
+		switch e.Tag {
+		case dwarf.TagCompileUnit:
+			files = nil
+			lr, err := d.LineReader(e)
+			if err != nil {
+				return nil, nil, err
+			}
+			if lr == nil {
+				continue
+			}
+			var le dwarf.LineEntry
+			for {
+				if err := lr.Next(&le); err != nil {
+					if err == io.EOF {
+						break
+					}
+					return nil, nil, err
+				}
+				row := lineRow{addr: le.Address}
+				if !le.EndSequence && le.File != nil {
+					row.file, row.line = le.File.Name, le.Line
+				}
+				lines = append(lines, row)
+			}
+			files = lr.Files()
+
+		case dwarf.TagSubprogram:
+			if name, ok := e.Val(dwarf.AttrName).(string); ok {
+				names[e.Offset] = name
+			}
+
+		case dwarf.TagInlinedSubroutine:
+			ranges, err := d.Ranges(e)
+			if err != nil || len(ranges) == 0 {
+				continue
+			}
+			origin, _ := e.Val(dwarf.AttrAbstractOrigin).(dwarf.Offset)
+			inl := inline{}
+			if i, ok := e.Val(dwarf.AttrCallFile).(int64); ok && 0 <= i && i < int64(len(files)) && files[i] != nil {
+				inl.callFile = files[i].Name
+			}
+			if n, ok := e.Val(dwarf.AttrCallLine).(int64); ok {
+				inl.callLine = int(n)
+			}
+			for _, rg := range ranges {
+				inl.start, inl.end = rg[0], rg[1]
+				inls = append(inls, inl)
+				origins = append(origins, origin)
+			}
+		}
+	}
+
+	// A sequence may end where the next begins:
+	// sort the end first, so that lookup finds the beginning.
+	sort.SliceStable(lines, func(i, j int) bool {
+		if lines[i].addr != lines[j].addr {
+			return lines[i].addr < lines[j].addr
+		}
+		return lines[i].file == "" && lines[j].file != ""
+	})
+	for i := range inls {
+		inls[i].name = names[origins[i]]
+		if inls[i].name == "" {
+			inls[i].name = "?"
+		}
+	}
+	return lines, inls, nil
+}
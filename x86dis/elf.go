@@ -0,0 +1,129 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Parsing of ELF executables (Linux, FreeBSD, and so on).
+
+package main
+
+import (
+	"debug/elf"
+	"fmt"
+)
+
+// openELF reads the code and symbols of the ELF file,
+// and with withDWARF its DWARF information.
+func openELF(file string, withDWARF bool) (*objFile, error) {
+	f, err := elf.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	obj := new(objFile)
+	switch f.Machine {
+	case elf.EM_X86_64:
+		obj.mode = 64
+	case elf.EM_386:
+		obj.mode = 32
+	default:
+		return nil, fmt.Errorf("%s: not an x86 binary (machine %v)", file, f.Machine)
+	}
+
+	// The sections of a relocatable file all have address 0,
+	// and its symbol values are offsets in their sections.
+	// Lay the sections out one after another instead.
+	addrs := make([]uint64, len(f.Sections))
+	if f.Type == elf.ET_REL {
+		obj.relocatable = true
+		next := uint64(relocBase)
+		for i, sect := range f.Sections {
+			if sect.Flags&elf.SHF_ALLOC == 0 {
+				continue
+			}
+			if a := sect.Addralign; a > 1 {
+				next = (next + a - 1) &^ (a - 1)
+			}
+			addrs[i] = next
+			next += sect.Size
+			// Name addresses in the section that no symbol covers.
+			obj.sects = append(obj.sects, sym{sect.Name, addrs[i], sect.Size})
+		}
+	} else {
+		for i, sect := range f.Sections {
+			addrs[i] = sect.Addr
+		}
+	}
+
+	// Use the static symbol table if present and otherwise the dynamic one.
+	syms, err := f.Symbols()
+	if err != nil || len(syms) == 0 {
+		syms, _ = f.DynamicSymbols()
+	}
+	for _, s := range syms {
+		if s.Section == elf.SHN_UNDEF || s.Section >= elf.SHN_LORESERVE || s.Name == "" {
+			continue
+		}
+		addr := s.Value
+		if obj.relocatable && int(s.Section) < len(addrs) {
+			addr += addrs[s.Section]
+		}
+		if addr == 0 {
+			continue
+		}
+		switch elf.ST_TYPE(s.Info) {
+		case elf.STT_FUNC:
+			obj.funcs = append(obj.funcs, sym{s.Name, addr, s.Size})
+			fallthrough
+		case elf.STT_OBJECT, elf.STT_NOTYPE:
+			obj.syms = append(obj.syms, sym{s.Name, addr, s.Size})
+		}
+	}
+
+	for i, sect := range f.Sections {
+		if sect.Type != elf.SHT_PROGBITS || sect.Flags&elf.SHF_EXECINSTR == 0 {
+			continue
+		}
+		data, err := sect.Data()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", file, err)
+		}
+		text := section{name: sect.Name, addr: addrs[i], data: data}
+		if obj.relocatable {
+			for _, rs := range f.Sections {
+				if (rs.Type != elf.SHT_REL && rs.Type != elf.SHT_RELA) || int(rs.Info) != i {
+					continue
+				}
+				relocs, err := readELFRelocs(f, rs, data, text.addr, syms)
+				if err != nil {
+					return nil, fmt.Errorf("%s: reading %s: %v", file, rs.Name, err)
+				}
+				text.relocs = append(text.relocs, relocs...)
+			}
+		}
+		obj.text = append(obj.text, text)
+	}
+
+	if sect := f.Section(".gopclntab"); sect != nil {
+		var text uint64
+		if t := f.Section(".text"); t != nil {
+			text = t.Addr
+		}
+		data, err := sect.Data()
+		if err == nil {
+			err = obj.addPclntab(data, obj.goTextAddr(text))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: reading .gopclntab: %v", file, err)
+		}
+	}
+
+	if withDWARF {
+		obj.dwarf, _ = f.DWARF() // nil if there is none
+	}
+
+	obj.syms.sort()
+	obj.funcs.sort()
+	obj.sects.sort()
+	return obj, nil
+}
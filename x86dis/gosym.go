@@ -0,0 +1,77 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Use of the Go runtime's function table (pclntab).
+
+package main
+
+import (
+	"bytes"
+	"debug/gosym"
+)
+
+// addPclntab adds the functions listed in pclntab, the Go runtime's
+// function table, to obj, and uses its line table to map addresses
+// to source lines. Text is the address of runtime.text.
+// Since the runtime needs the table, it is present even in
+// stripped binaries.
+func (obj *objFile) addPclntab(pclntab []byte, text uint64) error {
+	tab, err := gosym.NewTable(nil, gosym.NewLineTable(pclntab, text))
+	if err != nil {
+		return err
+	}
+	for _, fn := range tab.Funcs {
+		s := sym{fn.Name, fn.Entry, fn.End - fn.Entry}
+		obj.syms = append(obj.syms, s)
+		obj.funcs = append(obj.funcs, s)
+	}
+	obj.lineOf = func(pc uint64) (string, int) {
+		file, line, fn := tab.PCToLine(pc)
+		if fn == nil {
+			return "", 0
+		}
+		return file, line
+	}
+	return nil
+}
+
+// goTextAddr returns the address of runtime.text, or else def.
+// The two differ only in binaries linked with C code.
+func (obj *objFile) goTextAddr(def uint64) uint64 {
+	for _, s := range obj.syms {
+		if s.name == "runtime.text" {
+			return s.addr
+		}
+	}
+	return def
+}
+
+// pclntabMagic lists the magic numbers that begin each version of the
+// pclntab, in little-endian byte order: Go 1.2, 1.16, 1.18, and 1.20.
+var pclntabMagic = [][]byte{
+	{0xfb, 0xff, 0xff, 0xff},
+	{0xfa, 0xff, 0xff, 0xff},
+	{0xf0, 0xff, 0xff, 0xff},
+	{0xf1, 0xff, 0xff, 0xff},
+}
+
+// findPclntab returns the pclntab in data, a read-only data section,
+// for file formats that do not give the table a section or symbol
+// of its own after stripping.
+// It returns nil if there is no pclntab.
+func findPclntab(data []byte) []byte {
+	for off := 0; off+8 <= len(data); off += 4 {
+		d := data[off:]
+		// Magic, two zero bytes, instruction size quantum, pointer size.
+		if d[4] != 0 || d[5] != 0 || (d[6] != 1 && d[6] != 2 && d[6] != 4) || (d[7] != 4 && d[7] != 8) {
+			continue
+		}
+		for _, m := range pclntabMagic {
+			if bytes.HasPrefix(d, m) {
+				return d
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Parsing of Mach-O executables (macOS).
+
+package main
+
+import (
+	"debug/macho"
+	"fmt"
+)
+
+const (
+	machoSectionType       = 0xff       // SECTION_TYPE
+	machoZerofill          = 0x1        // S_ZEROFILL
+	machoPureInstructions  = 0x80000000 // S_ATTR_PURE_INSTRUCTIONS
+	machoSomeInstructions  = 0x400      // S_ATTR_SOME_INSTRUCTIONS
+	machoStab              = 0xe0       // N_STAB
+	machoTypeMask          = 0x0e       // N_TYPE
+	machoSectionDefined    = 0x0e       // N_SECT
+	machoNoSection         = 0          // NO_SECT
+	machoInstructionsAttrs = machoPureInstructions | machoSomeInstructions
+)
+
+// openMachO reads the code and symbols of the Mach-O file,
+// and with withDWARF its DWARF information.
+func openMachO(file string, withDWARF bool) (*objFile, error) {
+	f, err := macho.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	obj := new(objFile)
+	switch f.Cpu {
+	case macho.CpuAmd64:
+		obj.mode = 64
+	case macho.Cpu386:
+		obj.mode = 32
+	default:
+		return nil, fmt.Errorf("%s: not an x86 binary (cpu %v)", file, f.Cpu)
+	}
+
+	for _, sect := range f.Sections {
+		if !isMachOCode(sect) {
+			continue
+		}
+		data, err := sect.Data()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", file, err)
+		}
+		obj.text = append(obj.text, section{name: sect.Name, addr: sect.Addr, data: data})
+	}
+
+	if f.Symtab != nil {
+		for _, s := range f.Symtab.Syms {
+			if s.Type&machoStab != 0 || s.Type&machoTypeMask != machoSectionDefined || s.Sect == machoNoSection {
+				continue
+			}
+			if s.Name == "" || int(s.Sect) > len(f.Sections) {
+				continue
+			}
+			obj.addSym(s.Name, s.Value, isMachOCode(f.Sections[s.Sect-1]))
+		}
+	}
+
+	if sect := f.Section("__gopclntab"); sect != nil {
+		var text uint64
+		if t := f.Section("__text"); t != nil {
+			text = t.Addr
+		}
+		data, err := sect.Data()
+		if err == nil {
+			err = obj.addPclntab(data, obj.goTextAddr(text))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: reading __gopclntab: %v", file, err)
+		}
+	}
+
+	if withDWARF {
+		obj.dwarf, _ = f.DWARF() // nil if there is none
+	}
+
+	obj.syms.sort()
+	obj.funcs.sort()
+	obj.syms.fillSizes()
+	obj.funcs.fillSizes()
+	return obj, nil
+}
+
+// isMachOCode reports whether sect holds executable code.
+func isMachOCode(sect *macho.Section) bool {
+	return sect.Flags&machoSectionType != machoZerofill && sect.Flags&machoInstructionsAttrs != 0
+}
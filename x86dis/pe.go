@@ -0,0 +1,226 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Parsing of PE executables (Microsoft Windows).
+
+package main
+
+import (
+	"debug/pe"
+	"encoding/binary"
+	"fmt"
+)
+
+// openPE reads the code and symbols of the PE file,
+// and with withDWARF its DWARF information.
+// Addresses are virtual addresses: the image base plus the RVA.
+func openPE(file string, withDWARF bool) (*objFile, error) {
+	f, err := pe.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	obj := new(objFile)
+	switch f.Machine {
+	case pe.IMAGE_FILE_MACHINE_AMD64:
+		obj.mode = 64
+	case pe.IMAGE_FILE_MACHINE_I386:
+		obj.mode = 32
+	default:
+		return nil, fmt.Errorf("%s: not an x86 binary (machine %#x)", file, f.Machine)
+	}
+
+	var base uint64
+	var exports pe.DataDirectory
+	switch oh := f.OptionalHeader.(type) {
+	case *pe.OptionalHeader32:
+		base = uint64(oh.ImageBase)
+		if oh.NumberOfRvaAndSizes > pe.IMAGE_DIRECTORY_ENTRY_EXPORT {
+			exports = oh.DataDirectory[pe.IMAGE_DIRECTORY_ENTRY_EXPORT]
+		}
+	case *pe.OptionalHeader64:
+		base = oh.ImageBase
+		if oh.NumberOfRvaAndSizes > pe.IMAGE_DIRECTORY_ENTRY_EXPORT {
+			exports = oh.DataDirectory[pe.IMAGE_DIRECTORY_ENTRY_EXPORT]
+		}
+	}
+
+	for _, sect := range f.Sections {
+		if !isPECode(sect) {
+			continue
+		}
+		data, err := sect.Data()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", file, err)
+		}
+		// The raw data is padded to the file alignment.
+		if sect.VirtualSize != 0 && int64(sect.VirtualSize) < int64(len(data)) {
+			data = data[:sect.VirtualSize]
+		}
+		obj.text = append(obj.text, section{name: sect.Name, addr: base + uint64(sect.VirtualAddress), data: data})
+	}
+
+	// COFF symbol values are offsets in their sections.
+	for _, s := range f.Symbols {
+		if s.SectionNumber <= 0 || int(s.SectionNumber) > len(f.Sections) {
+			continue
+		}
+		sect := f.Sections[s.SectionNumber-1]
+		if s.Name == "" || s.Name == sect.Name {
+			continue // section symbol
+		}
+		obj.addSym(s.Name, base+uint64(sect.VirtualAddress)+uint64(s.Value), isPECode(sect))
+	}
+
+	if exports.Size > 0 {
+		if err := obj.addPEExports(f, base, exports); err != nil {
+			return nil, fmt.Errorf("%s: reading exports: %v", file, err)
+		}
+	}
+
+	if err := obj.addPEPclntab(f, base); err != nil {
+		return nil, fmt.Errorf("%s: reading pclntab: %v", file, err)
+	}
+
+	if withDWARF {
+		obj.dwarf, _ = f.DWARF() // nil if there is none
+	}
+
+	obj.syms.sort()
+	obj.funcs.sort()
+	obj.syms.fillSizes()
+	obj.funcs.fillSizes()
+	return obj, nil
+}
+
+// isPECode reports whether sect holds executable code.
+func isPECode(sect *pe.Section) bool {
+	return sect.Characteristics&(pe.IMAGE_SCN_CNT_CODE|pe.IMAGE_SCN_MEM_EXECUTE) != 0
+}
+
+// addPEExports adds the names in the export directory dir of f as symbols.
+func (obj *objFile) addPEExports(f *pe.File, base uint64, dir pe.DataDirectory) error {
+	ed, err := peRead(f, dir.VirtualAddress, 40)
+	if err != nil {
+		return err
+	}
+	le := binary.LittleEndian
+	nname := le.Uint32(ed[24:])
+	funcs := le.Uint32(ed[28:])
+	names := le.Uint32(ed[32:])
+	ords := le.Uint32(ed[36:])
+	for i := uint32(0); i < nname; i++ {
+		b, err := peRead(f, names+4*i, 4)
+		if err != nil {
+			return err
+		}
+		name, err := peString(f, le.Uint32(b))
+		if err != nil {
+			return err
+		}
+		if b, err = peRead(f, ords+2*i, 2); err != nil {
+			return err
+		}
+		if b, err = peRead(f, funcs+4*uint32(le.Uint16(b)), 4); err != nil {
+			return err
+		}
+		rva := le.Uint32(b)
+		if dir.VirtualAddress <= rva && rva < dir.VirtualAddress+dir.Size {
+			continue // forwarded to another DLL
+		}
+		if sect := peSection(f, rva); sect != nil {
+			obj.addSym(name, base+uint64(rva), isPECode(sect))
+		}
+	}
+	return nil
+}
+
+// addPEPclntab adds the functions and lines in the Go pclntab of f, if any.
+// PE files have no section for the table: it is in .rdata, bounded by
+// the symbols runtime.pclntab and runtime.epclntab, or, in a stripped
+// binary, found by its header.
+func (obj *objFile) addPEPclntab(f *pe.File, base uint64) error {
+	var text uint64
+	if t := f.Section(".text"); t != nil {
+		text = base + uint64(t.VirtualAddress)
+	}
+	text = obj.goTextAddr(text)
+
+	var start, end uint64
+	for _, s := range obj.syms {
+		switch s.name {
+		case "runtime.pclntab":
+			start = s.addr
+		case "runtime.epclntab":
+			end = s.addr
+		}
+	}
+	if start != 0 && end > start {
+		data, err := peRead(f, uint32(start-base), uint32(end-start))
+		if err != nil {
+			return err
+		}
+		return obj.addPclntab(data, text)
+	}
+
+	if len(obj.syms) > 0 {
+		return nil // not a Go binary
+	}
+	sect := f.Section(".rdata")
+	if sect == nil {
+		return nil
+	}
+	data, err := sect.Data()
+	if err != nil {
+		return err
+	}
+	if tab := findPclntab(data); tab != nil {
+		obj.addPclntab(tab, text) // a failure means this was not the pclntab
+	}
+	return nil
+}
+
+// peSection returns the section of f containing the RVA, or nil.
+func peSection(f *pe.File, rva uint32) *pe.Section {
+	for _, sect := range f.Sections {
+		size := sect.VirtualSize
+		if size == 0 {
+			size = sect.Size
+		}
+		if sect.VirtualAddress <= rva && rva < sect.VirtualAddress+size {
+			return sect
+		}
+	}
+	return nil
+}
+
+// peRead reads n bytes of f at the RVA,
+// from the file offset the RVA maps to.
+func peRead(f *pe.File, rva, n uint32) ([]byte, error) {
+	sect := peSection(f, rva)
+	if sect == nil {
+		return nil, fmt.Errorf("RVA %#x not in any section", rva)
+	}
+	b := make([]byte, n)
+	if _, err := sect.ReadAt(b, int64(rva-sect.VirtualAddress)); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// peString reads the NUL-terminated string in f at the RVA.
+func peString(f *pe.File, rva uint32) (string, error) {
+	var s []byte
+	for {
+		b, err := peRead(f, rva+uint32(len(s)), 1)
+		if err != nil {
+			return "", err
+		}
+		if b[0] == 0 {
+			return string(s), nil
+		}
+		s = append(s, b[0])
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Relocations in unlinked object files.
+
+package main
+
+import (
+	"debug/elf"
+	"fmt"
+	"sort"
+	"strings"
+
+	"rsc.io/x86/x86asm"
+)
+
+// relocBase is the address at which the sections of a relocatable file
+// are laid out. Their addresses in the file are all 0, but addresses
+// must identify one place for symbol lookups, and x86asm formats
+// branches at pc 0 as relative to ".".
+const relocBase = 0x10000
+
+// A reloc is a relocation of a field in an executable section.
+type reloc struct {
+	off    uint64 // address of the field
+	pcrel  bool   // field holds the symbol's address relative to the field
+	name   string // symbol, with any suffix such as @GOTPCREL
+	addend int64
+}
+
+// A relocType describes how a relocation type changes its field.
+type relocType struct {
+	size   int
+	pcrel  bool
+	suffix string // assembler's operator for the type, such as @GOTPCREL
+}
+
+var relocTypesAMD64 = map[elf.R_X86_64]relocType{
+	elf.R_X86_64_8:             {1, false, ""},
+	elf.R_X86_64_16:            {2, false, ""},
+	elf.R_X86_64_32:            {4, false, ""},
+	elf.R_X86_64_32S:           {4, false, ""},
+	elf.R_X86_64_64:            {8, false, ""},
+	elf.R_X86_64_PC8:           {1, true, ""},
+	elf.R_X86_64_PC16:          {2, true, ""},
+	elf.R_X86_64_PC32:          {4, true, ""},
+	elf.R_X86_64_PC64:          {8, true, ""},
+	elf.R_X86_64_PLT32:         {4, true, ""},
+	elf.R_X86_64_GOTPC32:       {4, true, ""},
+	elf.R_X86_64_GOT32:         {4, false, "@GOT"},
+	elf.R_X86_64_GOTOFF64:      {8, false, "@GOTOFF"},
+	elf.R_X86_64_GOTPCREL:      {4, true, "@GOTPCREL"},
+	elf.R_X86_64_GOTPCRELX:     {4, true, "@GOTPCREL"},
+	elf.R_X86_64_REX_GOTPCRELX: {4, true, "@GOTPCREL"},
+	elf.R_X86_64_GOTTPOFF:      {4, true, "@GOTTPOFF"},
+	elf.R_X86_64_TLSGD:         {4, true, "@TLSGD"},
+	elf.R_X86_64_TLSLD:         {4, true, "@TLSLD"},
+	elf.R_X86_64_TPOFF32:       {4, false, "@TPOFF"},
+	elf.R_X86_64_DTPOFF32:      {4, false, "@DTPOFF"},
+}
+
+var relocTypes386 = map[elf.R_386]relocType{
+	elf.R_386_8:          {1, false, ""},
+	elf.R_386_16:         {2, false, ""},
+	elf.R_386_32:         {4, false, ""},
+	elf.R_386_PC8:        {1, true, ""},
+	elf.R_386_PC16:       {2, true, ""},
+	elf.R_386_PC32:       {4, true, ""},
+	elf.R_386_PLT32:      {4, true, ""},
+	elf.R_386_GOTPC:      {4, true, ""},
+	elf.R_386_GOT32:      {4, false, "@GOT"},
+	elf.R_386_GOT32X:     {4, false, "@GOT"},
+	elf.R_386_GOTOFF:     {4, false, "@GOTOFF"},
+	elf.R_386_TLS_GD:     {4, false, "@TLSGD"},
+	elf.R_386_TLS_LDM:    {4, false, "@TLSLDM"},
+	elf.R_386_TLS_LDO_32: {4, false, "@DTPOFF"},
+	elf.R_386_TLS_IE:     {4, false, "@INDNTPOFF"},
+	elf.R_386_TLS_GOTIE:  {4, false, "@GOTNTPOFF"},
+	elf.R_386_TLS_LE:     {4, false, "@NTPOFF"},
+}
+
+// readELFRelocs returns the relocations that rs, a SHT_REL or SHT_RELA
+// section, applies to code, the contents of a section laid out at addr.
+// Syms is the result of f.Symbols().
+func readELFRelocs(f *elf.File, rs *elf.Section, code []byte, addr uint64, syms []elf.Symbol) ([]reloc, error) {
+	data, err := rs.Data()
+	if err != nil {
+		return nil, err
+	}
+	bo := f.ByteOrder
+	rela := rs.Type == elf.SHT_RELA
+	size := 8
+	switch {
+	case f.Class == elf.ELFCLASS64 && rela:
+		size = 24
+	case f.Class == elf.ELFCLASS64:
+		size = 16
+	case rela:
+		size = 12
+	}
+
+	var relocs []reloc
+	for ; len(data) >= size; data = data[size:] {
+		var off, symIndex uint64
+		var typ uint32
+		var addend int64
+		if f.Class == elf.ELFCLASS64 {
+			off = bo.Uint64(data)
+			info := bo.Uint64(data[8:])
+			symIndex, typ = info>>32, uint32(info)
+			if rela {
+				addend = int64(bo.Uint64(data[16:]))
+			}
+		} else {
+			off = uint64(bo.Uint32(data))
+			info := bo.Uint32(data[4:])
+			symIndex, typ = uint64(info>>8), info&0xff
+			if rela {
+				addend = int64(int32(bo.Uint32(data[8:])))
+			}
+		}
+
+		var t relocType
+		var ok bool
+		if f.Machine == elf.EM_X86_64 {
+			t, ok = relocTypesAMD64[elf.R_X86_64(typ)]
+		} else {
+			t, ok = relocTypes386[elf.R_386(typ)]
+		}
+		if !ok || off+uint64(t.size) > uint64(len(code)) {
+			continue
+		}
+		if !rela {
+			// The addend is in the field itself.
+			switch t.size {
+			case 1:
+				addend = int64(int8(code[off]))
+			case 2:
+				addend = int64(int16(bo.Uint16(code[off:])))
+			case 4:
+				addend = int64(int32(bo.Uint32(code[off:])))
+			case 8:
+				addend = int64(bo.Uint64(code[off:]))
+			}
+		}
+
+		name := "?"
+		if 1 <= symIndex && symIndex <= uint64(len(syms)) {
+			s := syms[symIndex-1]
+			name = s.Name
+			if elf.ST_TYPE(s.Info) == elf.STT_SECTION && int(s.Section) < len(f.Sections) {
+				name = f.Sections[s.Section].Name
+			}
+		}
+		relocs = append(relocs, reloc{addr + off, t.pcrel, name + t.suffix, addend})
+	}
+	sort.Slice(relocs, func(i, j int) bool { return relocs[i].off < relocs[j].off })
+	return relocs, nil
+}
+
+// instSyms returns the symbol lookup to format inst at pc in sect with,
+// which names the target of a relocated PC-relative field,
+// along with the relocations of other fields, which the formatted
+// instruction cannot show, as symbol plus offset.
+func (obj *objFile) instSyms(sect *section, pc uint64, inst x86asm.Inst) (x86asm.SymLookup, []string) {
+	relocs := sect.relocs
+	i := sort.Search(len(relocs), func(i int) bool { return relocs[i].off >= pc })
+	end := pc + uint64(inst.Len)
+	if i == len(relocs) || relocs[i].off >= end {
+		return obj.lookup, nil
+	}
+
+	var (
+		notes  []string
+		target uint64 // address the formatter computes for the PC-relative field
+		name   string
+		base   uint64
+	)
+	for ; i < len(relocs) && relocs[i].off < end; i++ {
+		r := relocs[i]
+		if r.pcrel && inst.PCRel > 0 && r.off == pc+uint64(inst.PCRelOff) && name == "" {
+			if t, ok := pcrelTarget(inst, pc); ok {
+				// The processor adds the field to the address of the next
+				// instruction, not of the field, so the target is offset
+				// from the symbol by the addend plus the difference.
+				target, name = t, r.name
+				base = t - uint64(r.addend+int64(end-r.off))
+				continue
+			}
+		}
+		notes = append(notes, symOffset(r.name, r.addend))
+	}
+	if name == "" {
+		return obj.lookup, notes
+	}
+	return func(addr uint64) (string, uint64) {
+		if addr == target {
+			return name, base
+		}
+		return obj.lookup(addr)
+	}, notes
+}
+
+// pcrelTarget returns the target address of the PC-relative argument of
+// inst at pc, as the x86asm formatters compute it.
+func pcrelTarget(inst x86asm.Inst, pc uint64) (uint64, bool) {
+	for _, a := range inst.Args {
+		switch a := a.(type) {
+		case x86asm.Rel:
+			return pc + uint64(inst.Len) + uint64(a), true
+		case x86asm.Mem:
+			if a.Base == x86asm.RIP {
+				return pc + uint64(inst.Len) + uint64(a.Disp), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// symOffset returns name followed by off, if not zero.
+func symOffset(name string, off int64) string {
+	switch {
+	case off > 0:
+		return fmt.Sprintf("%s+%#x", name, off)
+	case off < 0:
+		return fmt.Sprintf("%s-%#x", name, -off)
+	}
+	return name
+}
+
+// commentPrefix gives the comment syntax of each assembler syntax.
+var commentPrefix = map[x86asm.Syntax]string{
+	x86asm.SyntaxGNU:   "# ",
+	x86asm.SyntaxIntel: "# ",
+	x86asm.SyntaxGo:    "// ",
+	x86asm.SyntaxNASM:  "; ",
+	x86asm.SyntaxMASM:  "; ",
+}
+
+// relocComment returns the comment listing notes from instSyms,
+// to append to an instruction in the given syntax.
+func relocComment(syntax x86asm.Syntax, notes []string) string {
+	if len(notes) == 0 {
+		return ""
+	}
+	return "  " + commentPrefix[syntax] + strings.Join(notes, ", ")
+}
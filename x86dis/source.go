@@ -0,0 +1,148 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Reading of source files, for -source.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// A remap replaces a prefix of source file paths,
+// for programs built in a directory other than the local one.
+type remap struct {
+	old, new string
+}
+
+// A remapList is a list of remaps, as a flag.Value.
+type remapList []remap
+
+func (l *remapList) String() string {
+	var s []string
+	for _, r := range *l {
+		s = append(s, r.old+"="+r.new)
+	}
+	return strings.Join(s, ",")
+}
+
+func (l *remapList) Set(s string) error {
+	i := strings.Index(s, "=")
+	if i < 0 {
+		return fmt.Errorf("%q is not of the form old=new", s)
+	}
+	*l = append(*l, remap{s[:i], s[i+1:]})
+	return nil
+}
+
+// A sourceCache holds the source files read for -source.
+type sourceCache struct {
+	remap remapList
+	files map[string][]string // lines of each file, nil if unreadable
+}
+
+// path returns the local path of the source file,
+// after applying the first remap with a matching prefix.
+func (c *sourceCache) path(file string) string {
+	for _, r := range c.remap {
+		if strings.HasPrefix(file, r.old) {
+			return r.new + file[len(r.old):]
+		}
+	}
+	return file
+}
+
+// line returns the text of the given line of the source file,
+// or false if the file cannot be read or is too short.
+func (c *sourceCache) line(file string, n int) (string, bool) {
+	lines, ok := c.files[file]
+	if !ok {
+		if data, err := ioutil.ReadFile(c.path(file)); err == nil {
+			lines = strings.Split(string(data), "\n")
+		}
+		if c.files == nil {
+			c.files = map[string][]string{}
+		}
+		c.files[file] = lines
+	}
+	if n < 1 || n > len(lines) {
+		return "", false
+	}
+	return strings.TrimSuffix(lines[n-1], "\r"), true
+}
+
+// A sourceView tracks the source position of the instructions
+// being printed, for -source.
+type sourceView struct {
+	lineOf func(pc uint64) (file string, line int)
+	starts map[uint64][]inline // inlined calls, by start address
+	ends   map[uint64][]inline // inlined calls, by end address
+	cache  sourceCache
+
+	file string // position of the last instruction
+	line int
+}
+
+// newSourceView returns a sourceView for obj, using its DWARF
+// line table if it has one and otherwise its Go line table.
+func newSourceView(obj *objFile, remap remapList) (*sourceView, error) {
+	v := &sourceView{
+		lineOf: obj.lineOf,
+		starts: map[uint64][]inline{},
+		ends:   map[uint64][]inline{},
+		cache:  sourceCache{remap: remap},
+	}
+	if obj.dwarf != nil {
+		lines, inls, err := readDWARF(obj.dwarf)
+		if err != nil {
+			return nil, err
+		}
+		if len(lines) > 0 {
+			v.lineOf = lines.lookup
+		}
+		for _, inl := range inls {
+			v.starts[inl.start] = append(v.starts[inl.start], inl)
+			v.ends[inl.end] = append(v.ends[inl.end], inl)
+		}
+	}
+	return v, nil
+}
+
+// reset forgets the position of the last instruction,
+// so that the next one's is printed in full.
+func (v *sourceView) reset() {
+	v.file, v.line = "", 0
+}
+
+// annotate returns the lines to print before the instruction at pc:
+// the ends and starts of inlined calls at pc, and the source line,
+// if it differs from the last instruction's.
+func (v *sourceView) annotate(pc uint64) []string {
+	var notes []string
+	for _, inl := range v.ends[pc] {
+		notes = append(notes, fmt.Sprintf("end of inlined %s", inl.name))
+	}
+	for _, inl := range v.starts[pc] {
+		note := "inlined " + inl.name
+		if inl.callFile != "" {
+			note += fmt.Sprintf(", called at %s:%d", inl.callFile, inl.callLine)
+		}
+		notes = append(notes, note)
+	}
+	if v.lineOf == nil {
+		return notes
+	}
+	file, line := v.lineOf(pc)
+	if file == "" || file == v.file && line == v.line && len(notes) == 0 {
+		return notes
+	}
+	v.file, v.line = file, line
+	notes = append(notes, fmt.Sprintf("%s:%d", file, line))
+	if text, ok := v.cache.line(file, line); ok {
+		notes = append(notes, text)
+	}
+	return notes
+}
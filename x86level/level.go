@@ -0,0 +1,279 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// X86level reports the x86-64 microarchitecture level needed to run
+// an ELF binary: the lowest GOAMD64 setting (v1, v2, v3, or v4, as
+// defined by the x86-64 psABI) whose processors support every
+// instruction in the binary's executable sections.
+//
+// Usage:
+//
+//	x86level [-target=level] [-ignore=regexp] file...
+//
+// X86level decodes each executable section from start to end and
+// assigns each instruction the level that introduced the CPUID features
+// it requires. It prints the highest level found in each file, and for
+// each function containing instructions above the target level
+// (default v1), it lists those instructions and their features.
+// The exit status is 1 if any file needs more than the target level.
+//
+// Programs often contain code for newer processors that they only run
+// after checking CPUID; the Go runtime's memmove and the crypto
+// packages are examples. Such code is reported like any other, which is
+// why the offending functions are listed. The -ignore flag skips
+// functions whose names match the given regular expression.
+//
+// Features outside the psABI levels, such as AES or RDRAND, are listed
+// separately and do not affect the level.
+package main
+
+import (
+	"debug/elf"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+
+	"rsc.io/x86/x86asm"
+)
+
+var (
+	target = flag.String("target", "v1", "report instructions above `level`")
+	ignore = flag.String("ignore", "", "skip functions matching `regexp`")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: x86level [-target=level] [-ignore=regexp] file...\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+// levelFeatures lists the CPUID features added at each level.
+// Level 1 is the original x86-64 instruction set, which
+// includes MMX, SSE, and SSE2.
+var levelFeatures = [...]x86asm.FeatureSet{
+	2: x86asm.NewFeatureSet(
+		x86asm.FeatureCX16,
+		x86asm.FeatureLAHF_LM,
+		x86asm.FeaturePOPCNT,
+		x86asm.FeatureSSE3,
+		x86asm.FeatureSSSE3,
+		x86asm.FeatureSSE4_1,
+		x86asm.FeatureSSE4_2,
+	),
+	3: x86asm.NewFeatureSet(
+		x86asm.FeatureAVX,
+		x86asm.FeatureAVX2,
+		x86asm.FeatureBMI1,
+		x86asm.FeatureBMI2,
+		x86asm.FeatureF16C,
+		x86asm.FeatureFMA,
+		x86asm.FeatureLZCNT,
+		x86asm.FeatureMOVBE,
+	),
+	4: x86asm.NewFeatureSet(
+		x86asm.FeatureAVX512BW,
+		x86asm.FeatureAVX512CD,
+		x86asm.FeatureAVX512DQ,
+		x86asm.FeatureAVX512F,
+		x86asm.FeatureAVX512VL,
+	),
+}
+
+// baseFeatures is the set of features available at level 1.
+var baseFeatures = x86asm.NewFeatureSet(
+	x86asm.FeatureMMX,
+	x86asm.FeatureSSE,
+	x86asm.FeatureSSE2,
+)
+
+// level returns the level needed to execute inst,
+// along with any features it requires that no level provides.
+func level(inst *x86asm.Inst) (int, x86asm.FeatureSet) {
+	lev := 1
+	other := inst.Features &^ baseFeatures
+	for l, f := range levelFeatures {
+		if inst.Features&f != 0 && l > lev {
+			lev = l
+		}
+		other &^= f
+	}
+	return lev, other
+}
+
+// parseLevel parses a level name like v3.
+func parseLevel(s string) (int, bool) {
+	switch s {
+	case "v1", "v2", "v3", "v4":
+		return int(s[1] - '0'), true
+	}
+	return 0, false
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("x86level: ")
+
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+	}
+
+	max, ok := parseLevel(*target)
+	if !ok {
+		log.Fatalf("invalid -target %q: must be v1, v2, v3, or v4", *target)
+	}
+	var skip *regexp.Regexp
+	if *ignore != "" {
+		var err error
+		skip, err = regexp.Compile(*ignore)
+		if err != nil {
+			log.Fatalf("invalid -ignore: %v", err)
+		}
+	}
+
+	exit := 0
+	for _, file := range flag.Args() {
+		lev, err := check(file, max, skip)
+		if err != nil {
+			log.Print(err)
+			exit = 2
+			continue
+		}
+		if lev > max && exit == 0 {
+			exit = 1
+		}
+	}
+	os.Exit(exit)
+}
+
+// A use is an instruction above the target level.
+type use struct {
+	pc    uint64
+	inst  x86asm.Inst
+	level int
+}
+
+// check decodes the executable sections of the ELF file,
+// prints its report, and returns the level the file needs.
+func check(file string, max int, skip *regexp.Regexp) (int, error) {
+	f, err := elf.Open(file)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	var mode int
+	switch f.Machine {
+	case elf.EM_X86_64:
+		mode = 64
+	case elf.EM_386:
+		mode = 32
+	default:
+		return 0, fmt.Errorf("%s: not an x86 binary (machine %v)", file, f.Machine)
+	}
+
+	syms := funcSyms(f)
+	lev := 1
+	var other x86asm.FeatureSet
+	uses := make(map[string][]use)
+	for _, sect := range f.Sections {
+		if sect.Type != elf.SHT_PROGBITS || sect.Flags&elf.SHF_EXECINSTR == 0 {
+			continue
+		}
+		code, err := sect.Data()
+		if err != nil {
+			return 0, fmt.Errorf("%s: %v", file, err)
+		}
+		for off := 0; off < len(code); {
+			pc := sect.Addr + uint64(off)
+			inst, err := x86asm.Decode(code[off:], mode)
+			if err != nil {
+				// Skip a byte and try again, as objdump does.
+				off++
+				continue
+			}
+			off += inst.Len
+
+			l, o := level(&inst)
+			if l <= 1 && o == 0 {
+				continue
+			}
+			name := syms.lookup(pc)
+			if name == "" {
+				name = sect.Name
+			}
+			if skip != nil && skip.MatchString(name) {
+				continue
+			}
+			other |= o
+			if l > lev {
+				lev = l
+			}
+			if l > max {
+				uses[name] = append(uses[name], use{pc, inst, l})
+			}
+		}
+	}
+
+	fmt.Printf("%s: v%d\n", file, lev)
+	if other != 0 {
+		fmt.Printf("%s: also uses %v\n", file, other)
+	}
+
+	var names []string
+	for name := range uses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fl := 0
+		for _, u := range uses[name] {
+			if u.level > fl {
+				fl = u.level
+			}
+		}
+		fmt.Printf("%s: v%d\n", name, fl)
+		for _, u := range uses[name] {
+			fmt.Printf("\t%#x\tv%d %-10v\t%s\n", u.pc, u.level, u.inst.Features, x86asm.GNUSyntax(u.inst, 0, nil))
+		}
+	}
+	return lev, nil
+}
+
+// A symtab is a list of function symbols sorted by address.
+type symtab []elf.Symbol
+
+// funcSyms returns the function symbols in f, from the static
+// symbol table if present and otherwise from the dynamic one.
+func funcSyms(f *elf.File) symtab {
+	syms, err := f.Symbols()
+	if err != nil || len(syms) == 0 {
+		syms, _ = f.DynamicSymbols()
+	}
+	var t symtab
+	for _, s := range syms {
+		if elf.ST_TYPE(s.Info) == elf.STT_FUNC && s.Value != 0 {
+			t = append(t, s)
+		}
+	}
+	sort.Slice(t, func(i, j int) bool { return t[i].Value < t[j].Value })
+	return t
+}
+
+// lookup returns the name of the function containing pc,
+// or "" if there is none.
+func (t symtab) lookup(pc uint64) string {
+	i := sort.Search(len(t), func(i int) bool { return t[i].Value > pc }) - 1
+	if i >= 0 {
+		s := t[i]
+		if pc < s.Value+s.Size || s.Size == 0 {
+			return s.Name
+		}
+	}
+	return ""
+}
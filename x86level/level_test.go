@@ -0,0 +1,52 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"rsc.io/x86/x86asm"
+)
+
+var levelTests = []struct {
+	code  string
+	mode  int
+	level int
+	other string
+}{
+	{"0000", 64, 1, ""},            // add [rax], al
+	{"660ffcc0", 64, 1, ""},        // paddb xmm0, xmm0
+	{"9f", 32, 1, ""},              // lahf
+	{"9f", 64, 2, ""},              // lahf
+	{"480fc70e", 64, 2, ""},        // cmpxchg16b [rsi]
+	{"f30fb8c1", 64, 2, ""},        // popcnt eax, ecx
+	{"f20f38f1c1", 64, 2, ""},      // crc32 eax, ecx
+	{"660f3800c1", 64, 2, ""},      // pshufb xmm0, xmm1
+	{"0f38f006", 64, 3, ""},        // movbe eax, [rsi]
+	{"c5fdfec0", 64, 3, ""},        // vpaddd ymm0, ymm0, ymm0
+	{"c4e279dcc0", 64, 3, "AES"},   // vaesenc xmm0, xmm0, xmm0
+	{"62f1fd4858c0", 64, 4, ""},    // vaddpd zmm0, zmm0, zmm0
+	{"0fc7f0", 64, 1, "RDRAND"},    // rdrand eax
+	{"660f3adfc100", 64, 1, "AES"}, // aeskeygenassist xmm0, xmm1, 0
+}
+
+func TestLevel(t *testing.T) {
+	for _, tt := range levelTests {
+		code, err := hex.DecodeString(tt.code)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inst, err := x86asm.Decode(code, tt.mode)
+		if err != nil {
+			t.Errorf("Decode(%s, %d): %v", tt.code, tt.mode, err)
+			continue
+		}
+		lev, other := level(&inst)
+		if lev != tt.level || other.String() != tt.other {
+			t.Errorf("level(%v) in mode %d = v%d, %v, want v%d, %v", inst, tt.mode, lev, other, tt.level, tt.other)
+		}
+	}
+}
@@ -11,6 +11,7 @@
 //
 //  text (default) - print decoding tree in text form
 //  decoder - print decoding tables for the x86asm package
+//  encoder - print encoding tables for the x86asm package
 //  scanner - print scanning tables for x86scan package
 package main
 
@@ -28,10 +29,56 @@ import (
 	"strings"
 )
 
-var format = flag.String("fmt", "text", "output format: text, decoder")
+var format = flag.String("fmt", "text", "output format: text, decoder, encoder, scanner")
 
 var inputFile string
 
+// vexOps records the instructions that have a VEX encoding.
+var vexOps = map[string]bool{}
+
+// features records the CPUID features named in the CSV.
+var features = map[string]bool{}
+
+// accessForms lists the distinct operand access forms in the CSV,
+// each the action and implicit columns joined by a semicolon.
+// Index 0 is reserved for instructions with no access information,
+// and accessIndex maps each form back to its index.
+var (
+	accessForms = []string{""}
+	accessIndex = map[string]int{}
+)
+
+// flagForms lists the distinct EFLAGS effects in the CSV,
+// each the four flags columns joined by semicolons.
+// As with accessForms, index 0 means no effects.
+var (
+	flagForms = []string{""}
+	flagIndex = map[string]int{}
+)
+
+// encForms records the encoding of each instruction form
+// added to the decoding tree, for printing the encoder tables.
+var encForms []encForm
+
+// An encForm is the encoding of a single instruction form,
+// recorded with the same keys used to walk the decoding tree.
+type encForm struct {
+	op       string
+	vex      string
+	prefix   string
+	code     []string
+	plus     bool
+	modrm    string
+	is64     string
+	addrsize string
+	datasize string
+	vexL     string
+	vexW     string
+	ismem    string
+	read     []string
+	args     []string
+}
+
 func usage() {
 	fmt.Fprintf(os.Stderr, "usage: x86map [-fmt=format] x86.csv\n")
 	os.Exit(2)
@@ -57,6 +104,8 @@ func main() {
 		print = printText
 	case "decoder":
 		print = printDecoder
+	case "encoder":
+		print = printEncoder
 	case "scanner":
 		print = printScanner
 	}
@@ -109,7 +158,15 @@ func readCSV(file string) (*Prog, error) {
 
 	p := &Prog{}
 	for _, row := range table {
-		add(p, row[0], row[1], row[2], row[3], row[4], row[5])
+		action, implicit := "", ""
+		if len(row) >= 8 {
+			action, implicit = row[6], row[7]
+		}
+		var flags [4]string
+		if len(row) >= 12 {
+			copy(flags[:], row[8:12])
+		}
+		add(p, row[0], row[1], row[2], row[3], row[4], row[5], action, implicit, flags)
 	}
 
 	check(p)
@@ -184,7 +241,7 @@ func (p *Prog) walk(action, key, text, opcode string) *Prog {
 // add adds a single instructions to the tree rooted at root.
 // The string arguments match the CSV: instruction mnemonic,
 // opcode encoding, validity in 32- and 64-bit modes, CPUID
-// feature set (ignored), and additional tags.
+// feature set, and additional tags.
 //
 // In effect, add adds a new path through the tree leading to
 // the given instruction, but it reuses as much of the existing
@@ -193,8 +250,9 @@ func (p *Prog) walk(action, key, text, opcode string) *Prog {
 // also starts with 0F, that 0F subtree node is reused instead of
 // allocating a parallel one. To maximize the reuse, the check action
 // sequence along the path being added is the same for every instruction:
-// encoding pieces needed to make a decision, 64-bit mode check,
-// rex check, prefix check, address size check, data size check,
+// VEX opcode map check, encoding pieces needed to make a decision,
+// 64-bit mode check, rex check, prefix check, address size check,
+// data size check, VEX.L and VEX.W checks (VEX and EVEX instructions only),
 // register vs memory argument check. Once all those checks have
 // been applied, the assumption is that we have uniquely identified
 // an instruction, and at that point it is okay to diverge from the
@@ -210,7 +268,7 @@ func (p *Prog) walk(action, key, text, opcode string) *Prog {
 // adding complexity to the decoder execution, the 'check' function
 // removes this case by merging "any" trees into specific keys when
 // present.
-func add(root *Prog, text, opcode, valid32, valid64, cpuid, tags string) {
+func add(root *Prog, text, opcode, valid32, valid64, cpuid, tags, action, implicit string, flags [4]string) {
 	// These are not real instructions: they are either
 	// prefixes for other instructions, composite instructions
 	// built from multiple individual instructions, or alternate
@@ -248,7 +306,7 @@ func add(root *Prog, text, opcode, valid32, valid64, cpuid, tags string) {
 				ops = append(ops, tag)
 			} else {
 				if strings.Contains(tag, "operand") {
-					log.Fatal("unknown tag %q", tag)
+					log.Fatalf("unknown tag %q", tag)
 				}
 				f[w] = tag
 				w++
@@ -256,30 +314,88 @@ func add(root *Prog, text, opcode, valid32, valid64, cpuid, tags string) {
 		}
 		f = f[:w]
 		for _, op := range ops {
-			add(root, text, opcode, valid32, valid64, cpuid, strings.Join(append(f, op), ","))
+			add(root, text, opcode, valid32, valid64, cpuid, strings.Join(append(f, op), ","), action, implicit, flags)
 		}
 		return
 	}
 
-	// Ignore VEX instructions for now.
-	if strings.HasPrefix(opcode, "VEX") {
-		return
+	// A VEX-encoded instruction begins with a word like VEX.NDS.128.66.0F38.W0
+	// giving the implied prefix and opcode map along with the required
+	// values of the VEX.L and VEX.W bits. The prefix and map together
+	// select the decoding tree to use; legacy instructions use tree "0".
+	// Operand size tags take the place of the W check, as for REX.W above.
+	// EVEX-encoded instructions begin with a similar EVEX word and
+	// use separate trees, keyed like "EVEX.66.0F38". Their vector length
+	// EVEX.L'L is recorded in the same way as VEX.L, with 512 as "2".
+	vex := "0"
+	var vexL, vexW string
+	if strings.HasPrefix(opcode, "VEX.") || strings.HasPrefix(opcode, "EVEX.") {
+		f := strings.Fields(opcode)
+		words := strings.Split(f[0], ".")
+		var pp, m string
+		vexL, vexW = "any", "any"
+		for _, field := range words[1:] {
+			switch field {
+			case "NDS", "NDD", "DDS":
+				// Use of VEX.vvvv is recorded in the operand names.
+			case "128", "LZ", "L0":
+				vexL = "0"
+			case "256", "L1":
+				vexL = "1"
+			case "512":
+				vexL = "2"
+			case "LIG":
+				vexL = "any"
+			case "66", "F2", "F3":
+				pp = field
+			case "0F", "0F38", "0F3A":
+				m = field
+			case "W0":
+				vexW = "0"
+			case "W1":
+				vexW = "1"
+			case "WIG":
+				vexW = "any"
+			default:
+				log.Printf("%s %s: unknown VEX field %s", text, opcode, field)
+				return
+			}
+		}
+		if m == "" {
+			log.Printf("%s %s: missing VEX opcode map", text, opcode)
+			return
+		}
+		vex = m
+		if pp != "" {
+			vex = pp + "." + m
+		}
+		if words[0] == "EVEX" {
+			vex = "EVEX." + vex
+		} else {
+			vexOps[strings.Fields(text)[0]] = true
+		}
+		if strings.Contains(tags, "operand") {
+			vexW = "any"
+		}
+		opcode = strings.Join(f[1:], " ")
 	}
 
 	var rex, prefix string
 	encoding := strings.Fields(opcode)
-	if len(encoding) > 0 && strings.HasPrefix(encoding[0], "REX") {
+	if vex != "0" {
+		// Prefix and REX bits are encoded in the VEX prefix.
+	} else if len(encoding) > 0 && strings.HasPrefix(encoding[0], "REX") {
 		rex = encoding[0]
 		encoding = encoding[1:]
 		if len(encoding) > 0 && encoding[0] == "+" {
 			encoding = encoding[1:]
 		}
 	}
-	if len(encoding) > 0 && isPrefix[encoding[0]] {
+	if vex == "0" && len(encoding) > 0 && isPrefix[encoding[0]] {
 		prefix = encoding[0]
 		encoding = encoding[1:]
 	}
-	if rex == "" && len(encoding) > 0 && strings.HasPrefix(encoding[0], "REX") {
+	if vex == "0" && rex == "" && len(encoding) > 0 && strings.HasPrefix(encoding[0], "REX") {
 		rex = encoding[0]
 		if rex == "REX" {
 			log.Printf("REX without REX.W: %s %s", text, opcode)
@@ -289,16 +405,20 @@ func add(root *Prog, text, opcode, valid32, valid64, cpuid, tags string) {
 			encoding = encoding[1:]
 		}
 	}
-	if len(encoding) > 0 && isPrefix[encoding[0]] {
+	if vex == "0" && len(encoding) > 0 && isPrefix[encoding[0]] {
 		log.Printf("%s %s: too many prefixes", text, opcode)
 		return
 	}
 
 	p := root
+	var form encForm
 	walk := func(action, item string) {
 		p = p.walk(action, item, text, opcode)
+		form.add(action, item)
 	}
 
+	walk("vex", vex)
+
 	var haveModRM, havePlus bool
 	var usedReg string
 	for len(encoding) > 0 && (isHex(encoding[0]) || isSlashNum(encoding[0])) {
@@ -351,6 +471,11 @@ func add(root *Prog, text, opcode, valid32, valid64, cpuid, tags string) {
 		walk("datasize", "any")
 	}
 
+	if vexL != "" {
+		walk("vexl", vexL)
+		walk("vexw", vexW)
+	}
+
 	if len(encoding) > 0 && encoding[0] == "/r" {
 		haveModRM = true
 	}
@@ -366,13 +491,47 @@ func add(root *Prog, text, opcode, valid32, valid64, cpuid, tags string) {
 
 	walk("op", strings.Fields(text)[0])
 
+	feature := "0"
+	if f := cpuidFeatures(cpuid); len(f) > 0 {
+		for _, name := range f {
+			features[name] = true
+		}
+		feature = strings.Join(f, ",")
+	}
+	walk("feature", feature)
+
+	access := "0"
+	if action != "" || implicit != "" {
+		access = action + ";" + implicit
+		if accessIndex[access] == 0 {
+			accessForms = append(accessForms, access)
+			accessIndex[access] = len(accessForms) - 1
+		}
+	}
+	walk("access", access)
+
+	flagKey := "0"
+	if flags != [4]string{} {
+		flagKey = strings.Join(flags[:], ";")
+		if flagIndex[flagKey] == 0 {
+			flagForms = append(flagForms, flagKey)
+			flagIndex[flagKey] = len(flagForms) - 1
+		}
+	}
+	walk("flags", flagKey)
+
 	for _, field := range encoding {
+		if field == "/is4" {
+			// The register in imm8[7:4] is decoded as an argument.
+			field = "ib"
+		}
 		walk("read", field)
 	}
 
 	var usedRM string
 	for _, arg := range strings.Fields(text)[1:] {
 		arg = strings.TrimRight(arg, ",")
+		arg, decor := splitDecorations(arg)
 		if usesReg[arg] && !haveModRM && !havePlus {
 			log.Printf("%s %s: no modrm field to use for %s", text, opcode, arg)
 			continue
@@ -396,17 +555,115 @@ func add(root *Prog, text, opcode, valid32, valid64, cpuid, tags string) {
 			usedRM = arg
 		}
 		walk("arg", arg)
+		for _, d := range decor {
+			walk("arg", d)
+		}
 	}
 
 	walk("match", "!")
 }
 
+// add records the decoding tree walk step action=key in the form.
+// The final "match" step adds the finished form to encForms.
+func (f *encForm) add(action, key string) {
+	switch action {
+	case "vex":
+		f.vex = key
+	case "decode":
+		if isSlashNum(key) {
+			f.modrm = key
+			break
+		}
+		if strings.HasSuffix(key, "+") {
+			f.plus = true
+			key = key[:len(key)-1]
+		}
+		f.code = append(f.code, key)
+	case "is64":
+		f.is64 = key
+	case "prefix":
+		f.prefix = key
+	case "addrsize":
+		f.addrsize = key
+	case "datasize":
+		f.datasize = key
+	case "vexl":
+		f.vexL = key
+	case "vexw":
+		f.vexW = key
+	case "ismem":
+		f.ismem = key
+	case "op":
+		f.op = key
+	case "read":
+		if key == "/r" {
+			f.modrm = key
+			break
+		}
+		f.read = append(f.read, key)
+	case "arg":
+		f.args = append(f.args, key)
+	case "match":
+		encForms = append(encForms, *f)
+	}
+}
+
+// cpuidFeatures returns the feature names listed in a CSV feature column,
+// like "AVX512VL AVX512F" or "Both AES and AVX flags".
+// A feature column like "HLE or RTM" offering a choice is recorded as RTM,
+// because a FeatureSet cannot express alternatives. XTEST is the only such
+// instruction; the FeatureSet documentation in x86asm describes the effect.
+func cpuidFeatures(cpuid string) []string {
+	if strings.Contains(cpuid, " or ") {
+		return []string{"RTM"}
+	}
+	var f []string
+	for _, name := range strings.Fields(cpuid) {
+		switch name {
+		case "Both", "and", "flags":
+			continue
+		}
+		f = append(f, name)
+	}
+	sort.Strings(f)
+	return f
+}
+
+// splitDecorations splits an EVEX operand like zmm1{k1}{z} or
+// zmm2/m512/m64bcst{er} into the operand itself (zmm1, zmm2/m512)
+// and its decorations ({k1}, {z}, m64bcst, {er}). The decorations are
+// decoded as pseudo-arguments following the operand they modify.
+// The opmask decoration is written {k2} when the destination is k1,
+// but it means the same thing.
+func splitDecorations(arg string) (string, []string) {
+	var decor []string
+	if i := strings.Index(arg, "{"); i >= 0 {
+		for _, d := range strings.SplitAfter(arg[i:], "}") {
+			if d == "{k2}" {
+				d = "{k1}"
+			}
+			if d != "" {
+				decor = append(decor, d)
+			}
+		}
+		arg = arg[:i]
+	}
+	if strings.HasSuffix(arg, "bcst") {
+		i := strings.LastIndex(arg, "/")
+		decor = append([]string{arg[i+1:]}, decor...)
+		arg = arg[:i]
+	}
+	return arg, decor
+}
+
 // allKeys records the list of all possible child keys for actions that support "any".
 var allKeys = map[string][]string{
 	"is64":     {"0", "1"},
 	"ismem":    {"0", "1"},
 	"addrsize": {"16", "32", "64"},
 	"datasize": {"16", "32", "64"},
+	"vexl":     {"0", "1", "2"},
+	"vexw":     {"0", "1"},
 }
 
 // check checks that the program tree is well-formed.
@@ -437,7 +694,7 @@ func check(p *Prog) {
 	}
 
 	switch p.Action {
-	case "op", "read", "arg":
+	case "op", "feature", "access", "flags", "read", "arg":
 		if len(p.Child) > 1 {
 			log.Printf("%s: multiple children for action=%s: %v", p.Path, p.Action, p.keys())
 		}
@@ -654,9 +911,256 @@ func printDecoder(p *Prog) {
 	for _, op := range ops {
 		fmt.Printf("\t%s: \"%s\",\n", op, op)
 	}
+	fmt.Printf("}\n\n")
+
+	fmt.Printf("// vexOps records the instructions that have a VEX encoding.\n")
+	fmt.Printf("var vexOps = [maxOp + 1]bool{\n")
+	for _, op := range ops {
+		if vexOps[op] {
+			fmt.Printf("\t%s: true,\n", op)
+		}
+	}
+	fmt.Printf("}\n\n")
+
+	var names []string
+	for name := range features {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Printf("const (\n")
+	fmt.Printf("\t_ Feature = iota\n\n")
+	for _, name := range names {
+		fmt.Printf("\tFeature%s\n", name)
+	}
+	fmt.Printf(")\n\n")
+	fmt.Printf("const maxFeature = Feature%s\n\n", names[len(names)-1])
+
+	fmt.Printf("var featureNames = [...]string{\n")
+	for _, name := range names {
+		fmt.Printf("\tFeature%s: \"%s\",\n", name, name)
+	}
+	fmt.Printf("}\n\n")
+
+	fmt.Printf("var accessForms = [...]accessForm{\n")
+	fmt.Printf("\t{},\n")
+	for _, form := range accessForms[1:] {
+		fmt.Printf("\t{%s},\n", accessFormLiteral(form))
+	}
+	fmt.Printf("}\n\n")
+
+	fmt.Printf("var flagForms = [...]FlagEffects{\n")
+	fmt.Printf("\t{},\n")
+	for _, form := range flagForms[1:] {
+		fmt.Printf("\t{%s},\n", flagFormLiteral(form))
+	}
 	fmt.Printf("}\n")
 }
 
+// printEncoder prints the encoding tables for the x86asm package:
+// one encForm for each instruction form, sorted by opcode name,
+// which is also the order of the Op constants printed by printDecoder.
+func printEncoder(p *Prog) {
+	forms := append([]encForm(nil), encForms...)
+	sort.SliceStable(forms, func(i, j int) bool {
+		return forms[i].op < forms[j].op
+	})
+
+	fmt.Printf("// DO NOT EDIT\n")
+	fmt.Printf("// generated by: x86map -fmt=encoder %s\n", inputFile)
+	fmt.Printf("\n")
+	fmt.Printf("package x86asm\n\n")
+	fmt.Printf("var encoder = [...]encForm{\n")
+	seen := map[string]bool{}
+	for _, f := range forms {
+		lit := encFormLiteral(f)
+		if seen[lit] {
+			continue
+		}
+		seen[lit] = true
+		fmt.Printf("\t{%s},\n", lit)
+	}
+	fmt.Printf("}\n")
+}
+
+// encFormLiteral returns the Go composite literal fields for f.
+func encFormLiteral(f encForm) string {
+	fields := []string{"op: " + f.op}
+	if f.vex != "0" {
+		fields = append(fields, fmt.Sprintf("vex: %#x", vexKey(f.vex)))
+	}
+	if f.prefix != "0" {
+		fields = append(fields, "prefix: 0x"+f.prefix)
+	}
+	code := ""
+	for _, key := range f.code {
+		code += `\x` + strings.ToLower(key)
+	}
+	fields = append(fields, `code: "`+code+`"`)
+	if f.plus {
+		fields = append(fields, "plus: true")
+	}
+	switch {
+	case f.modrm == "":
+		fields = append(fields, "modrm: encNoModRM")
+	case f.modrm == "/r":
+		fields = append(fields, "modrm: encSlashR")
+	default:
+		fields = append(fields, "modrm: "+f.modrm[1:])
+	}
+	switch f.is64 {
+	case "1":
+		fields = append(fields, "is64: 1")
+	case "0":
+		fields = append(fields, "is64: -1")
+	}
+	if f.addrsize != "any" {
+		fields = append(fields, "addrSize: "+f.addrsize)
+	}
+	if f.datasize != "any" {
+		fields = append(fields, "dataSize: "+f.datasize)
+	}
+	if f.vex != "0" {
+		fields = append(fields, "vexL: "+anyKey(f.vexL), "vexW: "+anyKey(f.vexW))
+	}
+	switch f.ismem {
+	case "1":
+		fields = append(fields, "isMem: 1")
+	case "0":
+		fields = append(fields, "isMem: -1")
+	}
+	if len(f.read) > 0 {
+		var ops []string
+		for _, key := range f.read {
+			ops = append(ops, "xRead"+xOp(key))
+		}
+		fields = append(fields, "read: []decodeOp{"+strings.Join(ops, ", ")+"}")
+	}
+	if len(f.args) > 0 {
+		var ops []string
+		for _, key := range f.args {
+			ops = append(ops, "xArg"+xOp(key))
+		}
+		fields = append(fields, "args: []decodeOp{"+strings.Join(ops, ", ")+"}")
+	}
+	return strings.Join(fields, ", ")
+}
+
+// anyKey returns the encForm value for a vexl or vexw key:
+// the key itself, or -1 for "any".
+func anyKey(key string) string {
+	if key == "any" {
+		return "-1"
+	}
+	return key
+}
+
+// flagFields are the names of the x86asm FlagEffects fields
+// corresponding to the four flags columns in the CSV.
+var flagFields = [4]string{"Tested", "Set", "Cleared", "Undefined"}
+
+// flagNames are the flags that may appear in the flags columns.
+var flagNames = map[string]bool{
+	"CF": true,
+	"PF": true,
+	"AF": true,
+	"ZF": true,
+	"SF": true,
+	"OF": true,
+	"DF": true,
+}
+
+// flagFormLiteral returns the Go composite literal fields
+// for the x86asm FlagEffects described by form.
+func flagFormLiteral(form string) string {
+	var out []string
+	for i, col := range strings.Split(form, ";") {
+		if col == "" {
+			continue
+		}
+		var names []string
+		for _, f := range strings.Fields(col) {
+			if !flagNames[f] {
+				log.Fatalf("unknown flag %q in %q", f, form)
+			}
+			names = append(names, "Flag"+f)
+		}
+		out = append(out, fmt.Sprintf("%s: %s", flagFields[i], strings.Join(names, " | ")))
+	}
+	return strings.Join(out, ", ")
+}
+
+// accessNames maps the access codes in the CSV to x86asm constants.
+var accessNames = map[string]string{
+	"-":  "0",
+	"r":  "AccessRead",
+	"w":  "AccessWrite",
+	"rw": "AccessReadWrite",
+}
+
+// implicitNames maps the implicit register names in the CSV that
+// are not also x86asm register names. The sized names are mapped
+// to their 64-bit form and resized by the decoder.
+var implicitNames = map[string]string{
+	"ST(0)": "F0",
+	"ST(1)": "F1",
+	"XMM0":  "X0",
+	"rSP":   "RSP, %s, sizeMode",
+	"rBP":   "RBP, %s, sizeMode",
+	"rAX":   "RAX, %s, sizeAddr",
+	"rCX":   "RCX, %s, sizeAddr",
+	"rSI":   "RSI, %s, sizeAddr",
+	"rDI":   "RDI, %s, sizeAddr",
+}
+
+// accessFormLiteral returns the Go composite literal fields
+// for the x86asm accessForm described by form.
+func accessFormLiteral(form string) string {
+	i := strings.Index(form, ";")
+	action, implicit := form[:i], form[i+1:]
+	var out []string
+	if action != "" {
+		var args []string
+		for _, a := range strings.Split(action, ",") {
+			name, ok := accessNames[a]
+			if !ok {
+				log.Fatalf("unknown access %q in %q", a, form)
+			}
+			args = append(args, name)
+		}
+		out = append(out, fmt.Sprintf("args: [4]Access{%s}", strings.Join(args, ", ")))
+	}
+	if implicit != "" {
+		var regs []string
+		for _, f := range strings.Fields(implicit) {
+			j := strings.LastIndex(f, ":")
+			if j < 0 {
+				log.Fatalf("malformed implicit register %q in %q", f, form)
+			}
+			reg, a := f[:j], f[j+1:]
+			acc, ok := accessNames[a]
+			if !ok || acc == "0" {
+				log.Fatalf("unknown access %q in %q", a, form)
+			}
+			if strings.HasPrefix(reg, "YMM") {
+				reg = "Y" + reg[3:]
+			}
+			name, ok := implicitNames[reg]
+			switch {
+			case !ok:
+				name = reg + ", " + acc + ", 0"
+			case strings.Contains(name, "%s"):
+				name = fmt.Sprintf(name, acc)
+			default:
+				name += ", " + acc + ", 0"
+			}
+			regs = append(regs, "{"+name+"}")
+		}
+		out = append(out, fmt.Sprintf("implicit: []implicitReg{%s}", strings.Join(regs, ", ")))
+	}
+	return strings.Join(out, ", ")
+}
+
 // printScanner prints the decoding table for a scanner.
 // The scanner can identify instruction boundaries but does not do
 // full decoding. It is meant to be lighter weight than the x86asm
@@ -756,6 +1260,10 @@ func printScanner(p *Prog) {
 }
 
 func walkScanTree(p *Prog, is64 int) {
+	if p.Action == "vex" {
+		// The scanner does not handle VEX-encoded instructions.
+		*p = *p.Child["0"]
+	}
 	keys := p.keys()
 	for _, key := range keys {
 		if p.Action == "is64" {
@@ -779,7 +1287,7 @@ func walkScanTree(p *Prog, is64 int) {
 			p.Child = map[string]*Prog{"/r": p.Child[keys[0]]}
 			return
 		}
-	case "op", "arg":
+	case "op", "feature", "access", "flags", "arg":
 		// drop
 		*p = *p.Child[keys[0]]
 		return
@@ -1171,6 +1679,53 @@ func printDecoderPass(p *Prog, pc int, printing bool, ops map[string]bool) int {
 			pc += 1 + 8
 		}
 
+	case "vex":
+		// Decode based on VEX prefix: opcode map and implied prefix.
+		// The "0" key means no VEX prefix, so if there's nothing else,
+		// no check is needed.
+		if len(keys) == 1 && keys[0] == "0" {
+			next = p.Child["0"]
+			break
+		}
+
+		if printing {
+			fmt.Printf("/*%d*/\tuint16(xCondVex), %d,\n", pc, len(keys))
+			for _, key := range keys {
+				fmt.Printf("\t%#x, %d,\n", vexKey(key), p.Child[key].PC)
+			}
+		}
+		pc += 2 + 2*len(keys)
+
+	case "vexl":
+		// Decode based on VEX.L or EVEX.L'L: 128-, 256-, or 512-bit vector length.
+		if len(keys) == 1 && keys[0] == "any" {
+			next = p.Child["any"]
+			break
+		}
+		if p.Child["any"] != nil {
+			log.Printf("%s: mixed vexl keys: %v", p.Path, keys)
+		}
+
+		if printing {
+			fmt.Printf("/*%d*/\tuint16(xCondVexL), %d, %d, %d,\n", pc, p.childPC("0"), p.childPC("1"), p.childPC("2"))
+		}
+		pc += 4
+
+	case "vexw":
+		// Decode based on VEX.W.
+		if len(keys) == 1 && keys[0] == "any" {
+			next = p.Child["any"]
+			break
+		}
+		if p.Child["any"] != nil {
+			log.Printf("%s: mixed vexw keys: %v", p.Path, keys)
+		}
+
+		if printing {
+			fmt.Printf("/*%d*/\tuint16(xCondVexW), %d, %d,\n", pc, p.childPC("0"), p.childPC("1"))
+		}
+		pc += 3
+
 	case "is64":
 		// Decode based on processor mode: 64-bit or not.
 		if len(keys) == 1 && keys[0] == "any" {
@@ -1264,6 +1819,44 @@ func printDecoderPass(p *Prog, pc int, printing bool, ops map[string]bool) int {
 		next = p.Child[keys[0]]
 		pc += 2
 
+	case "feature":
+		// Record required CPUID features.
+		// The "0" key means no features, so no code is needed.
+		next = p.Child[keys[0]]
+		if keys[0] == "0" {
+			break
+		}
+		for _, name := range strings.Split(keys[0], ",") {
+			if printing {
+				fmt.Printf("/*%d*/\tuint16(xRequire), uint16(Feature%s),\n", pc, name)
+			}
+			pc += 2
+		}
+
+	case "access":
+		// Record operand access form.
+		// The "0" key means no information, so no code is needed.
+		next = p.Child[keys[0]]
+		if keys[0] == "0" {
+			break
+		}
+		if printing {
+			fmt.Printf("/*%d*/\tuint16(xSetAccess), %d,\n", pc, accessIndex[keys[0]])
+		}
+		pc += 2
+
+	case "flags":
+		// Record EFLAGS effects.
+		// The "0" key means no effects, so no code is needed.
+		next = p.Child[keys[0]]
+		if keys[0] == "0" {
+			break
+		}
+		if printing {
+			fmt.Printf("/*%d*/\tuint16(xSetFlags), %d,\n", pc, flagIndex[keys[0]])
+		}
+		pc += 2
+
 	case "read":
 		// Read argument bytes.
 		if printing {
@@ -1303,6 +1896,35 @@ func printDecoderPass(p *Prog, pc int, printing bool, ops map[string]bool) int {
 	return pc
 }
 
+// vexKey returns the xCondVex encoding of a vex key like "66.0F38":
+// the implied prefix byte in the high 8 bits and the VEX.mmmmm
+// opcode map number in the low 8 bits. EVEX keys like "EVEX.66.0F38"
+// also set the 0x20 bit, which is outside the range of VEX.mmmmm.
+// The legacy key "0" is 0.
+func vexKey(key string) int {
+	if key == "0" {
+		return 0
+	}
+	if strings.HasPrefix(key, "EVEX.") {
+		return vexKey(key[len("EVEX."):]) | 0x20
+	}
+	pp := 0
+	if i := strings.Index(key, "."); i >= 0 {
+		pp = decodeKey(key[:i])
+		key = key[i+1:]
+	}
+	switch key {
+	case "0F":
+		return pp<<8 | 1
+	case "0F38":
+		return pp<<8 | 2
+	case "0F3A":
+		return pp<<8 | 3
+	}
+	log.Fatalf("unexpected vex key %q", key)
+	return 0
+}
+
 // childPC returns the PC for the given child key.
 // If the key is not present, it returns PC 0,
 // which is known to be an xFail instruction.
@@ -1351,4 +1973,8 @@ var argFix = strings.NewReplacer(
 	"ST(0)", "ST",
 	"ST(I)", "STi",
 	"ST(I)+Op", "STi",
+	"{K1}", "Mask",
+	"{Z}", "Z",
+	"{Er}", "ER",
+	"{Sae}", "SAE",
 )